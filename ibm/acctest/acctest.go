@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -1202,6 +1203,14 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func TestAccPreCheck(t *testing.T) {
+	if recorder, _ := conns.HTTPRecorder(); recorder != nil && recorder.Mode() == conns.RecorderModeReplay {
+		// Replayed tests never reach IBM Cloud, so any credentials will do
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, "replay")
+			}
+		}
+	}
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
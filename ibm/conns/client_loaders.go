@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "sync"
+
+// clientLoaders builds the service clients of a clientSession on demand. ClientSession
+// defines a loader per service; the loader runs the first time one of the accessors of
+// the service is called and sets the client and error fields of the session.
+type clientLoaders map[string][]*clientLoader
+
+type clientLoader struct {
+	once sync.Once
+	load func()
+}
+
+// define registers load for the given accessors of the session. The loaders must all be
+// defined before the session is used.
+func (l clientLoaders) define(load func(), accessors ...string) {
+	loader := &clientLoader{load: load}
+	for _, accessor := range accessors {
+		l[accessor] = append(l[accessor], loader)
+	}
+}
+
+// load runs, at most once, the loaders defined for the accessor.
+func (l clientLoaders) load(accessor string) {
+	for _, loader := range l[accessor] {
+		loader.once.Do(loader.load)
+	}
+}
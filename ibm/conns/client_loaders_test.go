@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestClientLoaders(t *testing.T) {
+	loaders := clientLoaders{}
+	var kp, kms int
+	loaders.define(func() { kp++ }, "KeyProtectAPI", "KeyManagementAPI")
+	loaders.define(func() { kms++ }, "KeyManagementAPI")
+
+	if kp != 0 || kms != 0 {
+		t.Fatalf("expected no client to be built before use, got %d and %d", kp, kms)
+	}
+	loaders.load("KeyProtectAPI")
+	if kp != 1 || kms != 0 {
+		t.Fatalf("expected only the Key Protect client to be built, got %d and %d", kp, kms)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			loaders.load("KeyManagementAPI")
+		}()
+	}
+	wg.Wait()
+	if kp != 1 || kms != 1 {
+		t.Fatalf("expected each client to be built once, got %d and %d", kp, kms)
+	}
+
+	loaders.load("VpcV1API")
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "sync"
+
+// CloudDataCache holds the cloud data looked up to validate the plans of a provider, such as the
+// zones of its region or the volume profiles. Every lookup is done at most once for the run of the
+// provider, a failed one included, so that a failing API is not called again for every resource.
+type CloudDataCache struct {
+	lock    sync.Mutex
+	results map[string]cloudDataResult
+}
+
+type cloudDataResult struct {
+	values []string
+	err    error
+}
+
+// NewCloudDataCache returns an empty cache.
+func NewCloudDataCache() *CloudDataCache {
+	return &CloudDataCache{results: map[string]cloudDataResult{}}
+}
+
+// Get returns the values cached for key, looked up with lookup the first time. A nil cache looks
+// the values up on every call.
+func (c *CloudDataCache) Get(key string, lookup func() ([]string, error)) ([]string, error) {
+	if c == nil {
+		return lookup()
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if result, ok := c.results[key]; ok {
+		return result.values, result.err
+	}
+	values, err := lookup()
+	c.results[key] = cloudDataResult{values: values, err: err}
+	return values, err
+}
@@ -5,12 +5,9 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
@@ -66,6 +63,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamaccessgroups "github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
@@ -83,6 +81,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/common"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt"
 	slsession "github.com/softlayer/softlayer-go/session"
 
@@ -105,7 +104,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -119,9 +117,6 @@ import (
 	"github.com/IBM/scc-go-sdk/v4/posturemanagementv1"
 )
 
-// RetryAPIDelay - retry api delay
-const RetryAPIDelay = 5 * time.Second
-
 // BluemixRegion ...
 var BluemixRegion string
 
@@ -163,12 +158,11 @@ type Config struct {
 	SoftLayerAPIKey string
 
 	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	//Constant Retry Delay for API calls
+	//Delay before the first retry of an API call, doubled on every further retry
 	RetryDelay time.Duration
+	//Maximum delay between two retries of an API call
+	RetryMaxDelay time.Duration
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -185,9 +179,24 @@ type Config struct {
 	//TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	//Compute resource token file exchanged for a token of IAMTrustedProfileID
+	IAMCRTokenFile string
+
+	//Exchange the identity token of the VPC instance for a token of IAMTrustedProfileID
+	IAMVPCInstanceMetadata bool
+
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	//Trusted profile the provider operates as, instead of the identity of its credentials
+	AssumeProfile *AssumeProfile
+
+	//Client side rate limits per service family
+	RateLimits map[string]RateLimit
+
+	//Default and ignored tags of the taggable resources
+	Tags TagsConfig
+
 	// Zone
 	Zone          string
 	Visibility    string
@@ -201,6 +210,16 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// endpointsFile is the checked content of the endpoints file, if one is set
+	endpointsFile EndpointsFile
+
+	// config is the configuration the session was built with. When a trusted profile is assumed
+	// its credentials are replaced with the token of the profile.
+	config *Config
+
+	// tokens renews the IAM token of the session, unless it was configured with an IAM token alone
+	tokens *tokenManager
 }
 
 // ClientSession ...
@@ -218,6 +237,7 @@ type ClientSession interface {
 	GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error)
 	GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error)
 	GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error)
+	GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error)
 	ICDAPI() (icdv4.ICDServiceAPI, error)
 	CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error)
 	IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error)
@@ -295,11 +315,22 @@ type ClientSession interface {
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
 	CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error)
 	CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error)
+	TagsConfig() TagsConfig
+	TagCache() *TagCache
+	CloudDataCache() *CloudDataCache
 }
 
 type clientSession struct {
 	session *Session
 
+	// loaders build the service clients the first time they are requested
+	loaders clientLoaders
+
+	// tagCache serves the tags of the resources read during the run
+	tagCache *TagCache
+	// cloudDataCache holds the cloud data looked up to validate the plans
+	cloudDataCache *CloudDataCache
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -348,6 +379,9 @@ type clientSession struct {
 	globalSearchConfigErrV2  error
 	globalSearchServiceAPIV2 searchv2.GlobalSearchV2
 
+	globalCatalogConfigErr error
+	globalCatalogAPI       *globalcatalogv1.GlobalCatalogV1
+
 	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
 	ibmCloudShellClientErr error
 
@@ -614,51 +648,59 @@ type clientSession struct {
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.loaders.load("AppIDAPI")
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.loaders.load("CatalogManagementV1")
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.loaders.load("BluemixAcccountAPI")
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.loaders.load("BluemixAcccountv1API")
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.loaders.load("ContainerAPI")
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.loaders.load("VpcContainerAPI")
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.loaders.load("ContainerRegistryV1")
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.loaders.load("SchematicsV1")
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -667,127 +709,172 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.loaders.load("GlobalSearchAPI")
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.loaders.load("GlobalTaggingAPI")
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.loaders.load("GlobalTaggingAPIv1")
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.loaders.load("GlobalSearchAPIV2")
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
+// GlobalCatalogV1API provides Platform-go Global Catalog APIs ...
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.loaders.load("GlobalCatalogV1API")
+	return sess.globalCatalogAPI, sess.globalCatalogConfigErr
+}
+
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.loaders.load("HpcsEndpointAPI")
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.loaders.load("UkoV4")
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.loaders.load("UserManagementAPI")
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.loaders.load("IAMPolicyManagementV1API")
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.loaders.load("IAMAccessGroupsV2")
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.loaders.load("IBMCloudShellV1")
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.loaders.load("ICDAPI")
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.loaders.load("CloudDatabasesV5")
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.loaders.load("MccpAPI")
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.loaders.load("ResourceCatalogAPI")
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.loaders.load("ResourceManagementAPIv2")
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.loaders.load("ResourceControllerAPI")
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.loaders.load("ResourceControllerAPIV2")
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
+// TagsConfig returns the default and ignored tags of the provider
+func (sess *clientSession) TagsConfig() TagsConfig {
+	return sess.session.config.Tags
+}
+
+// TagCache returns the tags of the resources of the account loaded for the run
+func (sess *clientSession) TagCache() *TagCache {
+	return sess.tagCache
+}
+
+// CloudDataCache returns the cloud data looked up for the run
+func (sess *clientSession) CloudDataCache() *CloudDataCache {
+	return sess.cloudDataCache
+}
+
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.loaders.load("CertificateManagerAPI")
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 // apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.loaders.load("APIGateway")
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.loaders.load("PushServiceV1")
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.loaders.load("EventNotificationsApiV1")
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.loaders.load("AppConfigurationV1")
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.loaders.load("KeyProtectAPI")
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.loaders.load("KeyManagementAPI")
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -815,44 +902,53 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.loaders.load("VpcV1API")
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.loaders.load("DirectlinkV1API")
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.loaders.load("DirectlinkProviderV2API")
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.loaders.load("CosConfigV1API")
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.loaders.load("TransitGatewayV1API")
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.loaders.load("IBMPISession")
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.loaders.load("PrivateDNSClientSession")
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.loaders.load("FunctionIAMNamespaceAPI")
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.loaders.load("CisZonesV1ClientSession")
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -860,7 +956,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.loaders.load("CisDNSRecordClientSession")
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -868,7 +965,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.loaders.load("CisDNSRecordBulkClientSession")
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -876,7 +974,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.loaders.load("CisGLBPoolClientSession")
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -884,7 +983,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.loaders.load("CisGLBClientSession")
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -892,7 +992,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.loaders.load("CisGLBHealthCheckClientSession")
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -900,7 +1001,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.loaders.load("CisRLClientSession")
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -908,7 +1010,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.loaders.load("CisIPClientSession")
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -916,7 +1019,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.loaders.load("CisPageRuleClientSession")
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -924,7 +1028,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.loaders.load("CisEdgeFunctionClientSession")
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -932,7 +1037,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.loaders.load("CisSSLClientSession")
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -940,7 +1046,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.loaders.load("CisWAFPackageClientSession")
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -948,7 +1055,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.loaders.load("CisDomainSettingsClientSession")
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -956,7 +1064,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.loaders.load("CisAlertsSession")
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -964,7 +1073,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.loaders.load("CisRoutingClientSession")
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -972,7 +1082,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.loaders.load("CisWAFGroupClientSession")
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -980,7 +1091,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.loaders.load("CisCacheClientSession")
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -988,7 +1100,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.loaders.load("CisCustomPageClientSession")
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -996,7 +1109,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.loaders.load("CisAccessRuleClientSession")
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1004,7 +1118,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.loaders.load("CisUARuleClientSession")
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1012,7 +1127,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.loaders.load("CisLockdownClientSession")
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1020,7 +1136,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.loaders.load("CisRangeAppClientSession")
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1028,7 +1145,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.loaders.load("CisWAFRuleClientSession")
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1036,7 +1154,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.loaders.load("CisOrigAuthSession")
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1044,43 +1163,51 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.loaders.load("IAMIdentityV1API")
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.loaders.load("ResourceManagerV2API")
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.loaders.load("EnterpriseManagementV1")
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.loaders.load("ResourceControllerV2API")
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.loaders.load("SecretsManagerV1")
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.loaders.load("SatellitLinkClientSession")
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.loaders.load("SatelliteClientSession")
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.loaders.load("CisLogpushJobsSession")
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1088,7 +1215,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.loaders.load("CisMtlsSession")
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1096,7 +1224,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.loaders.load("CisWebhookSession")
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1104,7 +1233,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.loaders.load("CisFiltersSession")
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1112,7 +1242,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.loaders.load("CisFirewallRulesSession")
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1120,29 +1251,35 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+func (session *clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+	session.loaders.load("AtrackerV1")
 	return session.atrackerClient, session.atrackerClientErr
 }
 
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.loaders.load("AtrackerV2")
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.loaders.load("ESschemaRegistrySession")
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+func (session *clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+	session.loaders.load("AdminServiceApiV1")
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
 }
 
-func (session clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+func (session *clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+	session.loaders.load("ConfigurationGovernanceV1")
 	return session.configServiceApiClient, session.configServiceApiClientErr
 }
 
 // Security and Compliance center Posture Management
-func (session clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+func (session *clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+	session.loaders.load("PostureManagementV1")
 	if session.postureManagementClientErr != nil {
 		return session.postureManagementClient, session.postureManagementClientErr
 	}
@@ -1150,7 +1287,8 @@ func (session clientSession) PostureManagementV1() (*posturemanagementv1.Posture
 }
 
 // Security and Compliance center Posture Management v2
-func (session clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+func (session *clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+	session.loaders.load("PostureManagementV2")
 	if session.postureManagementClientErrv2 != nil {
 		return session.postureManagementClientv2, session.postureManagementClientErrv2
 	}
@@ -1158,17 +1296,20 @@ func (session clientSession) PostureManagementV2() (*posturemanagementv2.Posture
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.loaders.load("ContextBasedRestrictionsV1")
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.loaders.load("CdToolchainV2")
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.loaders.load("CdTektonPipelineV2")
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
@@ -1178,10 +1319,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	c = sess.config
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:        sess,
+		loaders:        clientLoaders{},
+		cloudDataCache: NewCloudDataCache(),
 	}
+	session.tagCache = NewTagCache(func(options *searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error) {
+		client, err := session.GlobalSearchAPIV2()
+		if err != nil {
+			return nil, nil, err
+		}
+		return client.Search(options)
+	})
+	retryPolicy := c.RetryPolicy()
+	retryPolicy.tokens = sess.tokens
+	limiters := c.rateLimiters()
 
 	if sess.BluemixSession == nil {
 		//Can be nil only  if bluemix_api_key is not provided
@@ -1202,6 +1356,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.globalSearchConfigErr = errEmptyBluemixCredentials
 		session.globalTaggingConfigErr = errEmptyBluemixCredentials
 		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
+		session.globalCatalogConfigErr = errEmptyBluemixCredentials
 		session.hpcsEndpointErr = errEmptyBluemixCredentials
 		session.iamAccessGroupsErr = errEmptyBluemixCredentials
 		session.icdConfigErr = errEmptyBluemixCredentials
@@ -1270,53 +1425,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
+		err = sess.tokens.bluemixSession(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
 		err = authenticateCF(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if c.IAMTrustedProfileID == "" && sess.tokens == nil && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
-			}
-			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
-			}
+			return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
 		}
-
 	}
 	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
 	if err != nil {
@@ -1332,150 +1456,135 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
 
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
+	fileMap := sess.endpointsFile
+	// Key Protect clients retry through their own retryable client
+	kp.RetryMax = retryPolicy.MaxRetries
+	kp.RetryWaitMax = retryPolicy.MaxDelay
+
+	session.loaders.define(func() {
+		accv1API, err := accountv1.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+			session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
+		session.bmxAccountv1ServiceAPI = accv1API
+	}, "BluemixAcccountv1API")
+
+	session.loaders.define(func() {
+		accAPI, err := accountv2.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+			session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
+		session.bmxAccountServiceAPI = accAPI
+	}, "BluemixAcccountAPI")
+
+	session.loaders.define(func() {
+		cfAPI, err := mccpv2.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+			session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 		}
-	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
-
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
-
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
-
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
-
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
+		session.cfServiceAPI = cfAPI
+	}, "MccpAPI")
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
-
-	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
-	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	session.loaders.define(func() {
+		clusterAPI, err := containerv1.New(sess.BluemixSession)
+		if err != nil {
+			session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 		}
+		session.csServiceAPI = clusterAPI
+	}, "ContainerAPI")
 
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	session.loaders.define(func() {
+		v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+		if err != nil {
+			session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
+		session.csv2ServiceAPI = v2clusterAPI
+	}, "VpcContainerAPI")
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+	session.loaders.define(func() {
+		hpcsAPI, err := hpcs.New(sess.BluemixSession)
+		if err != nil {
+			session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
-	// KEY MANAGEMENT Service
-	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
-	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		session.hpcsEndpointAPI = hpcsAPI
+	}, "HpcsEndpointAPI")
+
+	session.loaders.define(func() {
+		var err error
+		kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 		}
+		var options kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			options = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		} else {
+			options = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 		}
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
+		kpAPIclient, err := kp.New(options, sess.tokens.Transport(DefaultTransport()))
+		if err != nil {
+			session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+		}
+		session.kpAPI = kpAPIclient
+	}, "KeyProtectAPI", "KeyManagementAPI")
 
-	var authenticator core.Authenticator
+	iamURL := c.iamURL(fileMap)
 
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+	session.loaders.define(func() {
+		var err error
+		// KEY MANAGEMENT Service
+		kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
+		}
+		var kmsOptions kp.ClientConfig
 		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			kmsOptions = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 			}
+
 		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 			}
 		}
+		kmsAPIclient, err := kp.New(kmsOptions, sess.tokens.Transport(DefaultTransport()))
+		if err != nil {
+			session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
+		}
+		session.kmsAPI = kmsAPIclient
+	}, "KeyManagementAPI")
+
+	var authenticator core.Authenticator
+
+	if sess.tokens != nil {
+		// The API key, the refresh token or the trusted profile renews the token before it expires
+		authenticator = sess.tokens
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
@@ -1486,647 +1595,764 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
-	}
-
-	// APPID Service
-	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
-	if c.Visibility == "private" {
-		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
-	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.appidAPI = appIDClient
-
-	// Construct an "options" struct for creating Context Based Restrictions service client.
-	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
-
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
-		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
-	}
-
-	// CATALOG MANAGEMENT Service
-	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	if c.Visibility == "private" {
-		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ATRACKER Service
-	var atrackerClientURL string
-	var atrackerURLErr error
+	session.loaders.define(func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+		}
 
-	atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
+		// Construct the service client.
+		session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.ukoClient.Service)
+			// Add custom header for analytics
+			session.ukoClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
+	}, "UkoV4")
+
+	session.loaders.define(func() {
+		var err error
+		// APPID Service
+		appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
+		if c.Visibility == "private" {
+			session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
+		}
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			retryPolicy.EnableRetries(appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.appidAPI = appIDClient
+	}, "AppIDAPI")
+
+	session.loaders.define(func() {
+		var err error
+		// Construct an "options" struct for creating Context Based Restrictions service client.
+		cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
+		}
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
 		}
-	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
-	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url
-	if atrackerURLErr != nil && len(atrackerClientOptions.URL) == 0 {
-		session.atrackerClientErr = atrackerURLErr
-	}
-	// Construct the service client.
-	session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
-	if err != nil {
-		session.atrackerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service: %q", err)
-	}
-	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
-		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// Version 2 Atracker
-	var atrackerClientV2URL string
-	var atrackerURLV2Err error
+		// Construct the service client.
+		session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err == nil && session.contextBasedRestrictionsClient != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.contextBasedRestrictionsClient.Service)
+			// Add custom header for analytics
+			session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
+	}, "ContextBasedRestrictionsV1")
+
+	session.loaders.define(func() {
+		var err error
+		// CATALOG MANAGEMENT Service
+		catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
+		if c.Visibility == "private" {
+			session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
+		}
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		}
+		if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.catalogManagementClient.Service)
+			// Add custom header for analytics
+			session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CatalogManagementV1")
+
+	session.loaders.define(func() {
+		var err error
+		// ATRACKER Service
+		var atrackerClientURL string
+		var atrackerURLErr error
+
+		atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				atrackerClientURL, atrackerURLErr = atrackerv1.GetServiceURLForRegion(c.Region)
+			}
+		}
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
+		}
+		atrackerClientOptions := &atrackerv1.AtrackerV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
+		}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url
+		if atrackerURLErr != nil && len(atrackerClientOptions.URL) == 0 {
+			session.atrackerClientErr = atrackerURLErr
+		}
+		// Construct the service client.
+		session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
+		if err != nil {
+			session.atrackerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker API service: %q", err)
+		}
+		if session.atrackerClient != nil && session.atrackerClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.atrackerClient.Service)
+			// Add custom header for analytics
+			session.atrackerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// Version 2 Atracker
+		var atrackerClientV2URL string
+		var atrackerURLV2Err error
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
-	}
-	if atrackerURLV2Err != nil {
-		atrackerClientV2URL = atrackerv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientOptions.URL) == 0 {
-		session.atrackerClientErr = atrackerURLErr
-	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
-		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
-	}
-
-	// SCC ADMIN Service
-	var adminServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		if atrackerURLV2Err != nil {
+			atrackerClientV2URL = atrackerv2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
+		}
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
+		// This should technically never happen as we default this for v2
+		if atrackerURLV2Err != nil && len(atrackerClientOptions.URL) == 0 {
+			session.atrackerClientErr = atrackerURLErr
+		}
+		session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.atrackerClientV2.Service)
+			// Add custom header for analytics
+			session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
+	}, "AtrackerV1", "AtrackerV2")
+
+	session.loaders.define(func() {
+		var err error
+		// SCC ADMIN Service
+		var adminServiceApiClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		adminServiceApiClientURL = adminserviceapiv1.DefaultServiceURL
-	}
-	adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
-	}
+		if err != nil {
+			adminServiceApiClientURL = adminserviceapiv1.DefaultServiceURL
+		}
+		adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
+		}
 
-	// Construct the service client.
-	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
-	if err == nil {
+		// Construct the service client.
+		session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.adminServiceApiClient.Service)
+			// Add custom header for analytics
+			session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
+		}
+	}, "AdminServiceApiV1")
+
+	session.loaders.define(func() {
+		var err error
+		// SCHEMATICS Service
+		// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+		schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
+		}
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
-	}
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			retryPolicy.EnableRetries(schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.schematicsClient = schematicsClient
+	}, "SchematicsV1")
+
+	session.loaders.define(func() {
+		var err error
+		// VPC Service
+		vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
+		}
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			retryPolicy.EnableRetries(vpcclient.Service)
+			limiters.Apply(RateLimitVPC, vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcAPI = vpcclient
+	}, "VpcV1API")
+
+	session.loaders.define(func() {
+		var err error
+		// PUSH NOTIFICATIONS Service
+		pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
+		if c.Visibility == "private" {
+			session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
+		}
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.pushServiceClient = pnclient
+	}, "PushServiceV1")
+	session.loaders.define(func() {
+		var err error
+		// event notifications
+		enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+		if c.Visibility == "private" {
+			session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
+		}
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		}
+		// Construct the service client.
+		session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			// Enable {
+			session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.eventNotificationsApiClient.Service)
+			session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "EventNotificationsApiV1")
+
+	session.loaders.define(func() {
+		var err error
+		// APP CONFIGURATION Service
+		appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
+		}
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
+			Authenticator: authenticator,
+		}
 
-	// SCHEMATICS Service
-	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
-	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
-	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.schematicsClient = schematicsClient
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(appConfigClient.Service)
+			session.appConfigurationClient = appConfigClient
+		} else {
+			session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		}
+	}, "AppConfigurationV1")
 
-	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcAPI = vpcclient
-
-	// PUSH NOTIFICATIONS Service
-	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
-	if c.Visibility == "private" {
-		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
-	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.pushServiceClient = pnclient
-	// event notifications
-	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
-	if c.Visibility == "private" {
-		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
-	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// APP CONFIGURATION Service
-	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
-
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
-		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
-	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
-	}
-
-	// CONTAINER REGISTRY Service
-	// Construct an "options" struct for creating the service client.
-	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
-	if err != nil {
-		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
+	session.loaders.define(func() {
+		// CONTAINER REGISTRY Service
+		// Construct an "options" struct for creating the service client.
+		containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 		if err != nil {
-			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
+			containerRegistryClientURL = containerregistryv1.DefaultServiceURL
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
-		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
-
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
-
-	// GLOBAL TAGGING Service
-	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalTaggingRegion string
-		if c.Region != "us-south" && c.Region != "us-east" {
-			globalTaggingRegion = "us-south"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
+			if err != nil {
+				containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
+		}
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+			Account:       core.StringPtr(userConfig.UserAccount),
+		}
+		// Construct the service client.
+		session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
+		if err != nil {
+			session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.containerRegistryClient.Service)
+			// Add custom header for analytics
+			session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "ContainerRegistryV1")
+
+	session.loaders.define(func() {
+		var err error
+		// OBJECT STORAGE Service
+		cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+		}
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err == nil {
+			cosconfigclient.Service.SetHTTPClient(retryPolicy.HTTPClient(cosconfigclient.Service.Client))
 		} else {
-			globalTaggingRegion = c.Region
+			session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 		}
-		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// GLOBAL TAGGING Service
-	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		globalSearchEndpoint = ContructEndpoint("api.private", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
-	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
-
-	var cloudDatabasesEndpoint string
+		session.cosConfigAPI = cosconfigclient
+	}, "CosConfigV1API")
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
-	} else {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
-	}
-
-	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
+	session.loaders.define(func() {
+		globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+		if err != nil {
+			session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		session.globalSearchServiceAPI = globalSearchAPI
+	}, "GlobalSearchAPI")
+	session.loaders.define(func() {
+		// Global Tagging Bluemix-go
+		globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+		if err != nil {
+			session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		session.globalTaggingServiceAPI = globalTaggingAPI
+	}, "GlobalTaggingAPI")
+
+	session.loaders.define(func() {
+		var err error
+		// GLOBAL TAGGING Service
+		globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalTaggingRegion string
+			if c.Region != "us-south" && c.Region != "us-east" {
+				globalTaggingRegion = "us-south"
+			} else {
+				globalTaggingRegion = c.Region
+			}
+			globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
+		}
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			retryPolicy.EnableRetries(session.globalTaggingServiceAPIV1.Service)
+			session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// GLOBAL TAGGING Service
+		globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			globalSearchEndpoint = ContructEndpoint("api.private", fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
+		}
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+			retryPolicy.EnableRetries(session.globalSearchServiceAPIV2.Service)
+			session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "GlobalTaggingAPIv1", "GlobalSearchAPIV2")
+
+	session.loaders.define(func() {
+		var err error
+		// GLOBAL CATALOG Service
+		globalCatalogEndpoint := globalcatalogv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalCatalogRegion string
+			if c.Region != "us-south" && c.Region != "us-east" {
+				globalCatalogRegion = "us-south"
+			} else {
+				globalCatalogRegion = c.Region
+			}
+			globalCatalogEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.globalcatalog", globalCatalogRegion), fmt.Sprintf("%s/api/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalCatalogEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT", c.Region, globalCatalogEndpoint)
+		}
+		globalCatalogV1Options := &globalcatalogv1.GlobalCatalogV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT"}, globalCatalogEndpoint),
+			Authenticator: authenticator,
+		}
+		session.globalCatalogAPI, err = globalcatalogv1.NewGlobalCatalogV1(globalCatalogV1Options)
+		if err != nil {
+			session.globalCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Catalog: %q", err)
+		}
+		if session.globalCatalogAPI != nil && session.globalCatalogAPI.Service != nil {
+			retryPolicy.EnableRetries(session.globalCatalogAPI.Service)
+			session.globalCatalogAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "GlobalCatalogV1API")
 
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
-	}
+	session.loaders.define(func() {
+		icdAPI, err := icdv4.New(sess.BluemixSession)
+		if err != nil {
+			session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+		session.icdServiceAPI = icdAPI
+	}, "ICDAPI")
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
+	session.loaders.define(func() {
+		var err error
+		var cloudDatabasesEndpoint string
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
+		} else {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
+		}
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
+		// Construct an "options" struct for creating the service client.
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+			Authenticator: authenticator,
+		}
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+		// Construct the service client.
+		session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.cloudDatabasesClient.Service)
+			// Add custom header for analytics
+			session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
+	}, "CloudDatabasesV5")
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
+	session.loaders.define(func() {
+		resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
+		}
+		session.resourceCatalogServiceAPI = resourceCatalogAPI
+	}, "ResourceCatalogAPI")
 
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
-	if err != nil {
-		session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
-	}
-	session.certManagementAPI = certManagementAPI
+	session.loaders.define(func() {
+		resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
+		}
+		session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	}, "ResourceManagementAPIv2")
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
+	session.loaders.define(func() {
+		var err error
+		rcSession := limiters.bluemixSession(RateLimitResourceController, sess.BluemixSession, retryPolicy)
+		resourceControllerAPI, err := controller.New(rcSession)
+		if err != nil {
+			session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		session.resourceControllerServiceAPI = resourceControllerAPI
 
-	//  API GATEWAY service
-	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
-	if err != nil {
-		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
-	}
-	session.apigatewayAPI = apigatewayAPI
+		ResourceControllerAPIv2, err := controllerv2.New(rcSession)
+		if err != nil {
+			session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+		session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	}, "ResourceControllerAPI", "ResourceControllerAPIV2")
 
-	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
-	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
-		Debug:         os.Getenv("TF_LOG") != "",
-		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
-		Zone:          c.Zone,
-	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
-	}
-	session.ibmpiSession = ibmpisession
+	session.loaders.define(func() {
+		userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
+		}
+		session.userManagementAPI = userManagementAPI
+	}, "UserManagementAPI")
 
-	// PRIVATE DNS Service
-	pdnsURL := dns.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.loaders.define(func() {
+		certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
+		if err != nil {
+			session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
+		}
+		session.certManagementAPI = certManagementAPI
+	}, "CertificateManagerAPI")
 
-	// DIRECT LINK Service
-	ver := time.Now().Format("2006-01-02")
-	dlURL := dl.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.loaders.define(func() {
+		namespaceFunction, err := functions.New(sess.BluemixSession)
+		if err != nil {
+			session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+		session.functionIAMNamespaceAPI = namespaceFunction
+	}, "FunctionIAMNamespaceAPI")
+
+	session.loaders.define(func() {
+		var err error
+		//  API GATEWAY service
+		apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
+		}
+		APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+			Authenticator: &core.NoAuthAuthenticator{},
+		}
+		apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+		if err == nil {
+			apigatewayAPI.Service.SetHTTPClient(retryPolicy.HTTPClient(apigatewayAPI.Service.Client))
+		} else {
+			session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+		}
+		session.apigatewayAPI = apigatewayAPI
+	}, "APIGateway")
+
+	session.loaders.define(func() {
+		var err error
+		// POWER SYSTEMS Service
+		piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+		ibmPIOptions := &ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			Region:        c.Region,
+			URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+			UserAccount:   userConfig.UserAccount,
+			Zone:          c.Zone,
+		}
+		ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err == nil {
+			if piRuntime, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+				piRuntime.Transport = retryPolicy.RoundTripper(limiters.RoundTripper(RateLimitPower, piRuntime.Transport))
+			}
+		} else {
+			session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		session.ibmpiSession = ibmpisession
+	}, "IBMPISession")
+
+	session.loaders.define(func() {
+		// PRIVATE DNS Service
+		pdnsURL := dns.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
+		}
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+			Authenticator: authenticator,
+		}
+		session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
+		if session.pDNSErr != nil {
+			session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
+		}
+		if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+			retryPolicy.EnableRetries(session.pDNSClient.Service)
+			session.pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "PrivateDNSClientSession")
+
+	session.loaders.define(func() {
+		// DIRECT LINK Service
+		ver := time.Now().Format("2006-01-02")
+		dlURL := dl.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
+		}
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
+		if session.directlinkErr != nil {
+			session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
+		}
+		if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+			retryPolicy.EnableRetries(session.directlinkAPI.Service)
+			session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// DIRECT LINK PROVIDER Service
-	dlproviderURL := dlProviderV2.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// DIRECT LINK PROVIDER Service
+		dlproviderURL := dlProviderV2.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
+		}
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if session.dlProviderErr != nil {
+			session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
+		}
+		if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+			retryPolicy.EnableRetries(session.dlProviderAPI.Service)
+			session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "DirectlinkV1API", "DirectlinkProviderV2API")
 
-	// TRANSIT GATEWAY Service
-	tgURL := tg.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
-	}
+	session.loaders.define(func() {
+		// TRANSIT GATEWAY Service
+		tgURL := tg.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
+		}
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if session.transitgatewayErr != nil {
+			session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
+		}
+		if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+			retryPolicy.EnableRetries(session.transitgatewayAPI.Service)
+			// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
+	}, "TransitGatewayV1API")
 
 	// CIS Service instances starts here.
 	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
@@ -2163,1047 +2389,1186 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 
-	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr =
-		cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
-				session.cisGLBPoolErr)
-	}
-	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
-				session.cisGLBErr)
-	}
-	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr =
-		cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
-				session.cisGLBHealthCheckErr)
-	}
-	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Alerts
-	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
-	if session.cisAlertsErr != nil {
-		session.cisAlertsErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
-				session.cisAlertsErr)
-	}
-	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr =
-		cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
-				session.cisEdgeFunctionErr)
-	}
-	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
-				session.cisSSLErr)
-	}
-	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr =
-		ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr =
-			fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
-				session.cisWAFPackageErr)
-	}
-	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr =
-		cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
-				session.cisDomainSettingsErr)
-	}
-	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr =
-		cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
-				session.cisRoutingErr)
-	}
-	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr =
-		ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
-				session.cisWAFGroupErr)
-	}
-	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr =
-		ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
-				session.cisCacheErr)
-	}
-	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisCustomPageClient, session.cisCustomPageErr =
-		ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
-				session.cisCustomPageErr)
-	}
-	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr =
-		cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
-				session.cisAccessRuleErr)
-	}
-	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr =
-		cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-				session.cisUARuleErr)
-	}
-	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr =
-		cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-				session.cisLockdownErr)
-	}
-	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr =
-		cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
-				session.cisRangeAppErr)
-	}
-	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr =
-		ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS LogpushJobs
-	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
-	if session.cisLogpushJobsErr != nil {
-		session.cisLogpushJobsErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
-				session.cisLogpushJobsErr)
-	}
-	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM MTLS Session
-	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
-	if session.cisMtlsErr != nil {
-		session.cisMtlsErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
-				session.cisMtlsErr)
-	}
-	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Webhooks
-	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
-	if session.cisWebhooksErr != nil {
-		session.cisWebhooksErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
-				session.cisWebhooksErr)
-	}
-	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Filters
-	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
-	if session.cisFiltersErr != nil {
-		session.cisFiltersErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
-				session.cisFiltersErr)
-	}
-	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall rules
-	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
-	if session.cisFirewallRulesErr != nil {
-		session.cisFirewallRulesErr =
-			fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
-				session.cisFirewallRulesErr)
-	}
-	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Authenticated Origin Pull
-	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-
-	session.cisOriginAuthClient, session.cisOriginAuthPullErr =
-		cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
-	if session.cisOriginAuthPullErr != nil {
-		session.cisOriginAuthPullErr = fmt.Errorf(
-			"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
-			session.cisOriginAuthPullErr)
-	}
-	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+	session.loaders.define(func() {
+		// IBM Network CIS Zones service
+		cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
+		if session.cisZonesErr != nil {
+			session.cisZonesErr = fmt.Errorf(
+				"Error occured while configuring CIS Zones service: %s",
+				session.cisZonesErr)
+		}
+		if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+			retryPolicy.EnableRetries(session.cisZonesV1Client.Service)
+			limiters.Apply(RateLimitCIS, session.cisZonesV1Client.Service)
+			session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisZonesV1ClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS DNS Record service
+		cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
+		if session.cisDNSErr != nil {
+			session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
+		}
+		if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisDNSRecordsClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisDNSRecordsClient.Service)
+			session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisDNSRecordClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS DNS Record bulk service
+		cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
+		if session.cisDNSBulkErr != nil {
+			session.cisDNSBulkErr = fmt.Errorf(
+				"Error occured while configuration CIS DNS bulk service : %s",
+				session.cisDNSBulkErr)
+		}
+		if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisDNSRecordBulkClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisDNSRecordBulkClient.Service)
+			session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisDNSRecordBulkClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Global load balancer pool
+		cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBPoolClient, session.cisGLBPoolErr =
+			cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
+		if session.cisGLBPoolErr != nil {
+			session.cisGLBPoolErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
+					session.cisGLBPoolErr)
+		}
+		if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisGLBPoolClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisGLBPoolClient.Service)
+			session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisGLBPoolClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Global load balancer
+		cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+		session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
+		if session.cisGLBErr != nil {
+			session.cisGLBErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
+					session.cisGLBErr)
+		}
+		if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisGLBClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisGLBClient.Service)
+			session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisGLBClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Global load balancer health check/monitor
+		cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr =
+			cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
+		if session.cisGLBHealthCheckErr != nil {
+			session.cisGLBHealthCheckErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
+					session.cisGLBHealthCheckErr)
+		}
+		if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisGLBHealthCheckClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisGLBHealthCheckClient.Service)
+			session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisGLBHealthCheckClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS IP
+		cisIPOpt := &cisipv1.CisIpApiV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
+		if session.cisIPErr != nil {
+			session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
+				session.cisIPErr)
+		}
+		if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisIPClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisIPClient.Service)
+			session.cisIPClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisIPClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Zone Rate Limit
+		cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
+		if session.cisRLErr != nil {
+			session.cisRLErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Zone Rate Limit service: %s",
+				session.cisRLErr)
+		}
+		if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisRLClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisRLClient.Service)
+			session.cisRLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisRLClientSession")
+	session.loaders.define(func() {
+		// IBM Network CIS Alerts
+		cisAlertsOpt := &cisalertsv1.AlertsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
+		if session.cisAlertsErr != nil {
+			session.cisAlertsErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
+					session.cisAlertsErr)
+		}
+		if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisAlertsClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisAlertsClient.Service)
+			session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisAlertsSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Page Rules
+		cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
+		if session.cisPageRuleErr != nil {
+			session.cisPageRuleErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Page Rule service: %s",
+				session.cisPageRuleErr)
+		}
+		if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisPageRuleClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisPageRuleClient.Service)
+			session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisPageRuleClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Edge Function
+		cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisEdgeFunctionClient, session.cisEdgeFunctionErr =
+			cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
+		if session.cisEdgeFunctionErr != nil {
+			session.cisEdgeFunctionErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
+					session.cisEdgeFunctionErr)
+		}
+		if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisEdgeFunctionClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisEdgeFunctionClient.Service)
+			session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisEdgeFunctionClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS SSL certificate
+		cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+
+		session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
+		if session.cisSSLErr != nil {
+			session.cisSSLErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
+					session.cisSSLErr)
+		}
+		if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisSSLClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisSSLClient.Service)
+			session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisSSLClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS WAF Package
+		cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFPackageClient, session.cisWAFPackageErr =
+			ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
+		if session.cisWAFPackageErr != nil {
+			session.cisWAFPackageErr =
+				fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
+					session.cisWAFPackageErr)
+		}
+		if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisWAFPackageClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisWAFPackageClient.Service)
+			session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisWAFPackageClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Domain settings
+		cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDomainSettingsClient, session.cisDomainSettingsErr =
+			cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
+		if session.cisDomainSettingsErr != nil {
+			session.cisDomainSettingsErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
+					session.cisDomainSettingsErr)
+		}
+		if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisDomainSettingsClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisDomainSettingsClient.Service)
+			session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisDomainSettingsClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Routing
+		cisRoutingOpt := &cisroutingv1.RoutingV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRoutingClient, session.cisRoutingErr =
+			cisroutingv1.NewRoutingV1(cisRoutingOpt)
+		if session.cisRoutingErr != nil {
+			session.cisRoutingErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
+					session.cisRoutingErr)
+		}
+		if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisRoutingClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisRoutingClient.Service)
+			session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisRoutingClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS WAF Group
+		cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFGroupClient, session.cisWAFGroupErr =
+			ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
+		if session.cisWAFGroupErr != nil {
+			session.cisWAFGroupErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
+					session.cisWAFGroupErr)
+		}
+		if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisWAFGroupClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisWAFGroupClient.Service)
+			session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisWAFGroupClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Cache service
+		cisCacheOpt := &ciscachev1.CachingApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisCacheClient, session.cisCacheErr =
+			ciscachev1.NewCachingApiV1(cisCacheOpt)
+		if session.cisCacheErr != nil {
+			session.cisCacheErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
+					session.cisCacheErr)
+		}
+		if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisCacheClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisCacheClient.Service)
+			session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisCacheClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Custom pages service
+		cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+
+		session.cisCustomPageClient, session.cisCustomPageErr =
+			ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
+		if session.cisCustomPageErr != nil {
+			session.cisCustomPageErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
+					session.cisCustomPageErr)
+		}
+		if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisCustomPageClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisCustomPageClient.Service)
+			session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisCustomPageClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Firewall Access rule
+		cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisAccessRuleClient, session.cisAccessRuleErr =
+			cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
+		if session.cisAccessRuleErr != nil {
+			session.cisAccessRuleErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
+					session.cisAccessRuleErr)
+		}
+		if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisAccessRuleClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisAccessRuleClient.Service)
+			session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisAccessRuleClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Firewall User Agent Blocking rule
+		cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisUARuleClient, session.cisUARuleErr =
+			cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
+		if session.cisUARuleErr != nil {
+			session.cisUARuleErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
+					session.cisUARuleErr)
+		}
+		if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisUARuleClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisUARuleClient.Service)
+			session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisUARuleClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Firewall Lockdown rule
+		cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisLockdownClient, session.cisLockdownErr =
+			cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
+		if session.cisLockdownErr != nil {
+			session.cisLockdownErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
+					session.cisLockdownErr)
+		}
+		if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisLockdownClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisLockdownClient.Service)
+			session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisLockdownClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Range Application rule
+		cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRangeAppClient, session.cisRangeAppErr =
+			cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
+		if session.cisRangeAppErr != nil {
+			session.cisRangeAppErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
+					session.cisRangeAppErr)
+		}
+		if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisRangeAppClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisRangeAppClient.Service)
+			session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisRangeAppClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS WAF Rule Service
+		cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFRuleClient, session.cisWAFRuleErr =
+			ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
+		if session.cisWAFRuleErr != nil {
+			session.cisWAFRuleErr = fmt.Errorf(
+				"Error occured while configuring CIS WAF Rules service: %s",
+				session.cisWAFRuleErr)
+		}
+		if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisWAFRuleClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisWAFRuleClient.Service)
+			session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisWAFRuleClientSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS LogpushJobs
+		cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Dataset:       core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
+		if session.cisLogpushJobsErr != nil {
+			session.cisLogpushJobsErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
+					session.cisLogpushJobsErr)
+		}
+		if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisLogpushJobsClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisLogpushJobsClient.Service)
+			session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisLogpushJobsSession")
+
+	session.loaders.define(func() {
+		// IBM MTLS Session
+		cisMtlsOpt := &cismtlsv1.MtlsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
+		if session.cisMtlsErr != nil {
+			session.cisMtlsErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
+					session.cisMtlsErr)
+		}
+		if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisMtlsClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisMtlsClient.Service)
+			session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisMtlsSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Webhooks
+		cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
+		if session.cisWebhooksErr != nil {
+			session.cisWebhooksErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
+					session.cisWebhooksErr)
+		}
+		if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisWebhooksClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisWebhooksClient.Service)
+			session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisWebhookSession")
+	session.loaders.define(func() {
+		// IBM Network CIS Filters
+		cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+		if session.cisFiltersErr != nil {
+			session.cisFiltersErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
+					session.cisFiltersErr)
+		}
+		if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisFiltersClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisFiltersClient.Service)
+			session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisFiltersSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Firewall rules
+		cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
+		if session.cisFirewallRulesErr != nil {
+			session.cisFirewallRulesErr =
+				fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
+					session.cisFirewallRulesErr)
+		}
+		if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisFirewallRulesClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisFirewallRulesClient.Service)
+			session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisFirewallRulesSession")
+
+	session.loaders.define(func() {
+		// IBM Network CIS Authenticated Origin Pull
+		cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+
+		session.cisOriginAuthClient, session.cisOriginAuthPullErr =
+			cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
+		if session.cisOriginAuthPullErr != nil {
+			session.cisOriginAuthPullErr = fmt.Errorf(
+				"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
+				session.cisOriginAuthPullErr)
+		}
+		if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+			retryPolicy.EnableRetries(session.cisOriginAuthClient.Service)
+			limiters.Apply(RateLimitCIS, session.cisOriginAuthClient.Service)
+			session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "CisOrigAuthSession")
+
+	session.loaders.define(func() {
+		var err error
+		// IAM IDENTITY Service
+		// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+		iamIdenityURL := iamidentity.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+		}
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		}
+		iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
+		}
+		if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+			retryPolicy.EnableRetries(iamIdentityClient.Service)
+			limiters.Apply(RateLimitIAM, iamIdentityClient.Service)
+			iamIdentityClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamIdentityAPI = iamIdentityClient
+	}, "IAMIdentityV1API")
+
+	session.loaders.define(func() {
+		var err error
+		// IAM POLICY MANAGEMENT Service
+		iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
+		}
+		iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		}
+		iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
+		if err != nil {
+			session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
+		}
+		if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+			retryPolicy.EnableRetries(iamPolicyManagementClient.Service)
+			limiters.Apply(RateLimitIAM, iamPolicyManagementClient.Service)
+			iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamPolicyManagementAPI = iamPolicyManagementClient
+	}, "IAMPolicyManagementV1API")
+
+	session.loaders.define(func() {
+		var err error
+		// IAM ACCESS GROUP
+		iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
+		}
+		iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		}
+		iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
+		if err != nil {
+			session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
+		}
+		if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+			retryPolicy.EnableRetries(iamAccessGroupsClient.Service)
+			limiters.Apply(RateLimitIAM, iamAccessGroupsClient.Service)
+			iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamAccessGroupsAPI = iamAccessGroupsClient
+	}, "IAMAccessGroupsV2")
+
+	session.loaders.define(func() {
+		var err error
+		// RESOURCE MANAGEMENT Service
+		rmURL := resourcemanager.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				rmURL = resourcemanager.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
+		}
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		}
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
+		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			retryPolicy.EnableRetries(resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceManagerAPI = resourceManagerClient
+	}, "ResourceManagerV2API")
+
+	session.loaders.define(func() {
+		var err error
+		//CLOUD SHELL Service
+		cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
+		}
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		}
+		session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+			retryPolicy.EnableRetries(session.ibmCloudShellClient.Service)
+			session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "IBMCloudShellV1")
+
+	session.loaders.define(func() {
+		var err error
+		// ENTERPRISE Service
+		enterpriseURL := enterprisemanagementv1.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
+					fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
+		}
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		}
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
+		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			retryPolicy.EnableRetries(enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.enterpriseManagementClient = enterpriseManagementClient
+	}, "EnterpriseManagementV1")
+
+	session.loaders.define(func() {
+		var err error
+		// RESOURCE CONTROLLER Service
+		rcURL := resourcecontroller.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				rcURL = resourcecontroller.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
+		}
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		}
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			retryPolicy.EnableRetries(resourceControllerClient.Service)
+			limiters.Apply(RateLimitResourceController, resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceControllerAPI = resourceControllerClient
+	}, "ResourceControllerV2API")
+
+	session.loaders.define(func() {
+		var err error
+		// SECRETS MANAGER Service
+		secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
+			Authenticator: authenticator,
+		}
+		/// Construct the service client.
+		session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
+		if err != nil {
+			session.secretsManagerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
+		}
+		if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.secretsManagerClient.Service)
+			// Add custom header for analytics
+			session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "SecretsManagerV1")
+
+	session.loaders.define(func() {
+		var err error
+		// SATELLITE Service
+		containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
+		}
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+			Authenticator: authenticator,
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
-	}
-	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamIdentityAPI = iamIdentityClient
-
-	// IAM POLICY MANAGEMENT Service
-	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+		session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
-	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
-	}
-	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
-	if err != nil {
-		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
-	}
-	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamPolicyManagementAPI = iamPolicyManagementClient
 
-	// IAM ACCESS GROUP
-	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+		// Enable retries for API calls
+		if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+			retryPolicy.EnableRetries(session.satelliteClient.Service)
+			session.satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
-	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
-	}
-	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
-	if err != nil {
-		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
-	}
-	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamAccessGroupsAPI = iamAccessGroupsClient
+	}, "SatelliteClientSession")
+
+	session.loaders.define(func() {
+		var err error
+		// SATELLITE LINK Service
+		// Construct an "options" struct for creating the service client.
+		satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
+		}
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.satelliteLinkClient.Service)
+			// Add custom header for analytics
+			session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "SatellitLinkClientSession")
 
-	// RESOURCE MANAGEMENT Service
-	rmURL := resourcemanager.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+	session.loaders.define(func() {
+		var err error
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+		session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+			retryPolicy.EnableRetries(session.esSchemaRegistryClient.Service)
+			session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "ESschemaRegistrySession")
+
+	session.loaders.define(func() {
+		var err error
+		// Governance Service
+		var configServiceApiClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
+			}
 		} else {
-			rmURL = resourcemanager.DefaultServiceURL
+			configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
-
-	//CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
-	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ENTERPRISE Service
-	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		if err != nil {
+			configServiceApiClientURL = configurationgovernancev1.DefaultServiceURL
+		}
+		configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT"}, configServiceApiClientURL),
+		}
+		session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.configServiceApiClient.Service)
+			// Add custom header for analytics
+			session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+			session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
+	}, "ConfigurationGovernanceV1")
+
+	session.loaders.define(func() {
+		var err error
+		//COMPLIANCE Service
+		// Construct an "options" struct for creating the service client.
+		var postureManagementClientURL string
+		if c.Visibility == "public" || c.Visibility == "public-and-private" {
+			postureManagementClientURL, err = posturemanagementv1.GetServiceURLForRegion(c.Region)
 		} else {
-			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+			session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
+		}
+		if err != nil {
+			postureManagementClientURL = posturemanagementv1.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
+		}
+		postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
+			AccountID:     core.StringPtr(userConfig.UserAccount),
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
 
-	// RESOURCE CONTROLLER Service
-	rcURL := resourcecontroller.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+		// Construct the service client.
+		session.postureManagementClient, err = posturemanagementv1.NewPostureManagementV1(postureManagementClientOptions)
+		if err != nil {
+			session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management service: %q", err)
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+		if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.postureManagementClient.Service)
+			// Add custom header for analytics
+			session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "PostureManagementV1")
+
+	session.loaders.define(func() {
+		var err error
+		//COMPLIANCE Service v2 version
+		// Construct an "options" struct for creating the service client.
+		var postureManagementClientURLv2 string
+		if c.Visibility == "public" || c.Visibility == "public-and-private" {
+			postureManagementClientURLv2, err = posturemanagementv2.GetServiceURLForRegion(c.Region)
 		} else {
-			rcURL = resourcecontroller.DefaultServiceURL
+			session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Compliance Centre API service: `%v` visibility not supported", c.Visibility)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
-
-	// SECRETS MANAGER Service
-	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
-		Authenticator: authenticator,
-	}
-	/// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
-	if err != nil {
-		session.secretsManagerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
-	}
-	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
-		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// SATELLITE Service
-	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
-	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
-
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// SATELLITE LINK Service
-	// Construct an "options" struct for creating the service client.
-	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
-	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
-	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// Governance Service
-	var configServiceApiClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
+		}
+		postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
 		}
-	} else {
-		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		configServiceApiClientURL = configurationgovernancev1.DefaultServiceURL
-	}
-	configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT"}, configServiceApiClientURL),
-	}
-	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
-	}
-
-	//COMPLIANCE Service
-	// Construct an "options" struct for creating the service client.
-	var postureManagementClientURL string
-	if c.Visibility == "public" || c.Visibility == "public-and-private" {
-		postureManagementClientURL, err = posturemanagementv1.GetServiceURLForRegion(c.Region)
-	} else {
-		session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
-	}
-	if err != nil {
-		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
-	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
-	}
-
-	// Construct the service client.
-	session.postureManagementClient, err = posturemanagementv1.NewPostureManagementV1(postureManagementClientOptions)
-	if err != nil {
-		session.postureManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management service: %q", err)
-	}
-	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	//COMPLIANCE Service v2 version
-	// Construct an "options" struct for creating the service client.
-	var postureManagementClientURLv2 string
-	if c.Visibility == "public" || c.Visibility == "public-and-private" {
-		postureManagementClientURLv2, err = posturemanagementv2.GetServiceURLForRegion(c.Region)
-	} else {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Compliance Centre API service: `%v` visibility not supported", c.Visibility)
-	}
-	if err != nil {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
-	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
-	}
-
-	// Construct the service client.
-	session.postureManagementClientv2, err = posturemanagementv2.NewPostureManagementV2(postureManagementClientOptionsv2)
-	if err != nil {
-		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management v2 service: %q", err)
-	}
-	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
-		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
 
-	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		// Construct the service client.
+		session.postureManagementClientv2, err = posturemanagementv2.NewPostureManagementV2(postureManagementClientOptionsv2)
+		if err != nil {
+			session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Posture Management v2 service: %q", err)
+		}
+		if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.postureManagementClientv2.Service)
+			// Add custom header for analytics
+			session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	}, "PostureManagementV2")
+
+	session.loaders.define(func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		var cdToolchainClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
-
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
+		if err != nil {
+			cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+		}
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		}
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		// Construct the service client.
+		session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.cdToolchainClient.Service)
+			// Add custom header for analytics
+			session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
+	}, "CdToolchainV2")
+
+	session.loaders.define(func() {
+		var err error
+		// Construct an "options" struct for creating the tekton pipeline service client.
+		var cdTektonPipelineClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
-	}
+		if err != nil {
+			cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+		}
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		}
+		// Construct the service client.
+		session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			retryPolicy.EnableRetries(session.cdTektonPipelineClient.Service)
+			// Add custom header for analytics
+			session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
+		}
+	}, "CdTektonPipelineV2")
 
 	if os.Getenv("TF_LOG") != "" {
 		logDestination := log.Writer()
@@ -3223,16 +3588,73 @@ func CreateVersionDate() *string {
 }
 
 func newSession(c *Config) (*Session, error) {
-	ibmSession := &Session{}
+	if _, err := HTTPRecorder(); err != nil {
+		return nil, err
+	}
+	ibmSession := &Session{config: c}
+	retryPolicy := c.RetryPolicy()
+
+	endpointsFile, err := c.loadEndpointsFile()
+	if err != nil {
+		return nil, err
+	}
+	ibmSession.endpointsFile = endpointsFile
 
+	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.iamURL(endpointsFile))
+	profile := c.AssumeProfile
+	if profile == nil {
+		profile = c.crTokenProfile()
+	}
+	if profile != nil {
+		tokens, err := c.trustedProfileTokenManager(profile, iamURL, retryPolicy)
+		if err != nil {
+			return nil, err
+		}
+		token, err := tokens.Token()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Assumed the trusted profile %s", profile.name())
+		// The sessions are configured with the token of the profile. The credentials of the
+		// caller are only used to request a new one.
+		assumed := *c
+		assumed.BluemixAPIKey = ""
+		assumed.IAMToken = "Bearer " + token
+		assumed.IAMRefreshToken = ""
+		assumed.IAMTrustedProfileID = profile.name()
+		c = &assumed
+		ibmSession.config = c
+		ibmSession.tokens = tokens
+	} else if tokens := c.iamTokenManager(iamURL); tokens != nil {
+		if c.BluemixAPIKey == "" {
+			// The IAM token may be close to its expiry, so it is renewed right away
+			token, err := tokens.Token()
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
+			}
+			renewed := *c
+			renewed.IAMToken = "Bearer " + token
+			renewed.IAMRefreshToken = tokens.RefreshToken()
+			c = &renewed
+			ibmSession.config = c
+		}
+		ibmSession.tokens = tokens
+	}
+	// Every client of the session sends its requests with the current token
+	retryPolicy.tokens = ibmSession.tokens
+
+	// SoftLayer reports API exceptions and expired IAM tokens with a 500, so
+	// those are left to the session, which refreshes the token and retries once.
+	// Every other failure is retried by the HTTP client.
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    2,
+		RetryWait:  retryPolicy.MinDelay,
+		HTTPClient: retryPolicy.withoutStatusCodes(500).HTTPClient(&gohttp.Client{Transport: DefaultTransport()}),
 	}
 
 	if c.IAMToken != "" {
@@ -3252,9 +3674,11 @@ func newSession(c *Config) (*Session, error) {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
 	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
-		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
+		return nil, fmt.Errorf("iam_token, iam_cr_token_file or iam_vpc_instance_metadata must be provided with iam_profile_id")
 	}
 
+	// bluemix-go clients retry through the HTTP client of the session
+	bluemixRetries := 0
 	if c.IAMToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
@@ -3266,16 +3690,18 @@ func newSession(c *Config) (*Session, error) {
 			HTTPTimeout:   c.BluemixTimeout,
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			RetryDelay:    &retryPolicy.MinDelay,
+			MaxRetries:    &bluemixRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.EndpointLocator = newEndpointLocator(c.Region, c.Visibility, endpointsFile)
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = retryPolicy.HTTPClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
@@ -3289,22 +3715,37 @@ func newSession(c *Config) (*Session, error) {
 			HTTPTimeout:   c.BluemixTimeout,
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			RetryDelay:    &retryPolicy.MinDelay,
+			MaxRetries:    &bluemixRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.EndpointLocator = newEndpointLocator(c.Region, c.Visibility, endpointsFile)
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = retryPolicy.HTTPClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
 	return ibmSession, nil
 }
 
+// bluemixSession returns a copy of sess whose requests are rate limited with the limiter
+// of the service family, or sess itself when the family is not rate limited.
+func (l rateLimiters) bluemixSession(service string, sess *bxsession.Session, retryPolicy RetryPolicy) *bxsession.Session {
+	if l[service] == nil {
+		return sess
+	}
+	limited := sess.Copy()
+	httpClient := http.NewHTTPClient(limited.Config)
+	httpClient.Transport = l.RoundTripper(service, httpClient.Transport)
+	limited.Config.HTTPClient = retryPolicy.HTTPClient(httpClient)
+	return limited
+}
+
 func authenticateAPIKey(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
@@ -3312,6 +3753,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3326,6 +3768,7 @@ func authenticateCF(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3384,6 +3827,7 @@ func RefreshToken(sess *bxsession.Session) error {
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -3392,6 +3836,22 @@ func RefreshToken(sess *bxsession.Session) error {
 	return err
 }
 
+// iamURL returns the IAM endpoint for the visibility and the region of the provider.
+func (c *Config) iamURL(fileMap EndpointsFile) string {
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+	return iamURL
+}
+
 func EnvFallBack(envs []string, defaultValue string) string {
 	for _, k := range envs {
 		if v := os.Getenv(k); v != "" {
@@ -3400,17 +3860,34 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	}
 	return defaultValue
 }
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
+func fileFallBack(fileMap EndpointsFile, visibility, key, region, defaultValue string) string {
+	if v := fileMap.URL(key, visibility, region); v != "" {
+		return v
 	}
 	return defaultValue
 }
 
+// loadEndpointsFile reads the endpoints file set with endpoints_file_path or
+// IBMCLOUD_ENDPOINTS_FILE_PATH. It returns nil when no file is set.
+func (c *Config) loadEndpointsFile() (EndpointsFile, error) {
+	path := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile)
+	if path == "" {
+		return nil, nil
+	}
+	file, warnings, errs := LoadEndpointsFile(path)
+	for _, warning := range warnings {
+		log.Printf("[WARN] %s", warning)
+	}
+	if len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i, err := range errs {
+			msgs[i] = err.Error()
+		}
+		return nil, fmt.Errorf("[ERROR] Invalid endpoints file: %s", strings.Join(msgs, "; "))
+	}
+	return file, nil
+}
+
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
 	transport := &gohttp.Transport{
@@ -3421,30 +3898,7 @@ func DefaultTransport() gohttp.RoundTripper {
 			InsecureSkipVerify: false,
 		},
 	}
-	return transport
-}
-
-func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
+	return recordingTransport(transport)
 }
 
 func ContructEndpoint(subdomain, domain string) string {
@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// defaultEndpointLocator returns the default endpoints of the bluemix-go clients for a region and
+// a visibility, as the locator of bluemix-go does. Unlike endpoints.NewEndpointLocator, it does
+// not read the endpoints file named by IBMCLOUD_ENDPOINTS_FILE_PATH, which bluemix-go parses as
+// JSON and exits the process on, nor any other environment variable: fileEndpointLocator looks
+// them up before the defaults.
+type defaultEndpointLocator struct {
+	region     string
+	visibility string
+}
+
+var defaultRegionEndpoints = map[string]map[string]string{
+	"cf": {
+		"us-south": "https://api.ng.bluemix.net",
+		"us-east":  "https://api.us-east.bluemix.net",
+		"eu-gb":    "https://api.eu-gb.bluemix.net",
+		"au-syd":   "https://api.au-syd.bluemix.net",
+		"eu-de":    "https://api.eu-de.bluemix.net",
+		"jp-tok":   "https://api.jp-tok.bluemix.net",
+	},
+	"cr": {
+		"us-south": "us.icr.io",
+		"us-east":  "us.icr.io",
+		"eu-de":    "de.icr.io",
+		"au-syd":   "au.icr.io",
+		"eu-gb":    "uk.icr.io",
+		"jp-tok":   "jp.icr.io",
+		"jp-osa":   "jp2.icr.io",
+	},
+	"uaa": {
+		"us-south": "https://iam.cloud.ibm.com/cloudfoundry/login/us-south",
+		"us-east":  "https://iam.cloud.ibm.com/cloudfoundry/login/us-east",
+		"eu-gb":    "https://iam.cloud.ibm.com/cloudfoundry/login/uk-south",
+		"au-syd":   "https://iam.cloud.ibm.com/cloudfoundry/login/ap-south",
+		"eu-de":    "https://iam.cloud.ibm.com/cloudfoundry/login/eu-central",
+	},
+}
+
+// defaultPrivateRegions are the regions with a private endpoint, by service
+var defaultPrivateRegions = map[string][]string{
+	"accounts":              {"us-south", "us-east"},
+	"certificate-manager":   {"us-south", "us-east", "eu-gb", "eu-de", "jp-tok", "au-syd", "jp-osa"},
+	"icd":                   {"us-south", "us-east", "eu-gb", "eu-de", "jp-tok", "au-syd", "osl01", "seo01", "che01", "ca-tor"},
+	"schematics":            {"us-south", "us-east", "eu-de", "eu-gb"},
+	"global-search-tagging": {"us-south", "us-east"},
+	"container":             {"us-south", "us-east", "eu-gb", "eu-de", "jp-tok", "au-syd", "jp-osa", "ca-tor"},
+	"iam":                   {"us-south", "us-east"},
+	"resource":              {"us-south", "us-east"},
+	"satellite":             {"us-south", "us-east", "eu-gb", "eu-de"},
+}
+
+// cloudURL returns the URL of subdomain in the domain of IBM Cloud.
+func cloudURL(subdomain string) string {
+	return fmt.Sprintf("https://%s.cloud.ibm.com", subdomain)
+}
+
+func (l *defaultEndpointLocator) private() bool {
+	return l.visibility == "private" || l.visibility == "public-and-private"
+}
+
+// privateRegion returns the region of the private endpoint of service, and whether it has one.
+func (l *defaultEndpointLocator) privateRegion(service string) (string, bool) {
+	return l.region, stringInList(l.region, defaultPrivateRegions[service])
+}
+
+// privateRegionOrDefault returns the region of the private endpoint of service, us-south for the
+// services which only have one in some regions.
+func (l *defaultEndpointLocator) privateRegionOrDefault(service string) string {
+	if r, ok := l.privateRegion(service); ok {
+		return r
+	}
+	log.Printf("[ WARN ] There is no private endpoint support for this region %s, Defaulting to us-south", l.region)
+	return "us-south"
+}
+
+func (l *defaultEndpointLocator) notSupported(message string) (string, error) {
+	return "", bmxerror.New(endpoints.ErrCodeServiceEndpoint, message)
+}
+
+func (l *defaultEndpointLocator) AccountManagementEndpoint() (string, error) {
+	if l.private() {
+		return cloudURL(fmt.Sprintf("private.%s.accounts", l.privateRegionOrDefault("accounts"))), nil
+	}
+	return cloudURL("accounts"), nil
+}
+
+func (l *defaultEndpointLocator) CertificateManagerEndpoint() (string, error) {
+	if r, ok := l.privateRegion("certificate-manager"); l.visibility == "private" || (l.visibility == "public-and-private" && ok) {
+		return cloudURL(fmt.Sprintf("private.%s.certificate-manager", r)), nil
+	}
+	return cloudURL(fmt.Sprintf("%s.certificate-manager", l.region)), nil
+}
+
+func (l *defaultEndpointLocator) CFAPIEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported("Private Endpoints is not supported by this service")
+	}
+	if ep, ok := defaultRegionEndpoints["cf"][l.region]; ok {
+		return ep, nil
+	}
+	return l.notSupported(fmt.Sprintf("Cloud Foundry endpoint doesn't exist for region: %q", l.region))
+}
+
+func (l *defaultEndpointLocator) ContainerEndpoint() (string, error) {
+	if r, ok := l.privateRegion("container"); l.visibility == "private" || (l.visibility == "public-and-private" && ok) {
+		return fmt.Sprintf("https://private.%s.containers.cloud.ibm.com/global", r), nil
+	}
+	return "https://containers.cloud.ibm.com/global", nil
+}
+
+func (l *defaultEndpointLocator) ContainerRegistryEndpoint() (string, error) {
+	if ep, ok := defaultRegionEndpoints["cr"][l.region]; ok {
+		return "https://" + ep, nil
+	}
+	return l.notSupported(fmt.Sprintf("Container Registry endpoint doesn't exist for region: %q", l.region))
+}
+
+func (l *defaultEndpointLocator) CisEndpoint() (string, error) {
+	if l.private() {
+		return cloudURL("api.private.cis"), nil
+	}
+	return cloudURL("api.cis"), nil
+}
+
+func (l *defaultEndpointLocator) GlobalSearchEndpoint() (string, error) {
+	if l.private() {
+		return cloudURL(fmt.Sprintf("api.private.%s.global-search-tagging", l.privateRegionOrDefault("global-search-tagging"))), nil
+	}
+	return cloudURL("api.global-search-tagging"), nil
+}
+
+func (l *defaultEndpointLocator) GlobalTaggingEndpoint() (string, error) {
+	if l.private() {
+		return cloudURL(fmt.Sprintf("tags.private.%s.global-search-tagging", l.privateRegionOrDefault("global-search-tagging"))), nil
+	}
+	return cloudURL("tags.global-search-tagging"), nil
+}
+
+func (l *defaultEndpointLocator) IAMEndpoint() (string, error) {
+	if !l.private() {
+		return cloudURL("iam"), nil
+	}
+	if r, ok := l.privateRegion("iam"); ok {
+		return cloudURL(fmt.Sprintf("private.%s.iam", r)), nil
+	}
+	return cloudURL("private.iam"), nil
+}
+
+func (l *defaultEndpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.IAMEndpoint()
+}
+
+func (l *defaultEndpointLocator) ICDEndpoint() (string, error) {
+	if r, ok := l.privateRegion("icd"); l.visibility == "private" || (l.visibility == "public-and-private" && ok) {
+		return cloudURL(fmt.Sprintf("api.%s.private.databases", r)), nil
+	}
+	return cloudURL(fmt.Sprintf("api.%s.databases", l.region)), nil
+}
+
+func (l *defaultEndpointLocator) MCCPAPIEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported(fmt.Sprintf("Private Endpoints is not supported by this service for the region %s", l.region))
+	}
+	return cloudURL(fmt.Sprintf("mccp.%s.cf", l.region)), nil
+}
+
+func (l *defaultEndpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.ResourceControllerEndpoint()
+}
+
+func (l *defaultEndpointLocator) ResourceControllerEndpoint() (string, error) {
+	r, ok := l.privateRegion("resource")
+	switch {
+	case l.private() && ok:
+		return cloudURL(fmt.Sprintf("private.%s.resource-controller", r)), nil
+	case l.visibility == "private":
+		return cloudURL("private.resource-controller"), nil
+	}
+	return cloudURL("resource-controller"), nil
+}
+
+func (l *defaultEndpointLocator) ResourceCatalogEndpoint() (string, error) {
+	if l.private() {
+		r, ok := l.privateRegion("resource")
+		if !ok {
+			r = "us-south"
+		}
+		return cloudURL(fmt.Sprintf("private.%s.globalcatalog", r)), nil
+	}
+	return cloudURL("globalcatalog"), nil
+}
+
+func (l *defaultEndpointLocator) UAAEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported(fmt.Sprintf("Private Endpoints is not supported by this service for the region %s", l.region))
+	}
+	if ep, ok := defaultRegionEndpoints["uaa"][l.region]; ok {
+		return ep, nil
+	}
+	return l.notSupported(fmt.Sprintf("UAA endpoint doesn't exist for region: %q", l.region))
+}
+
+func (l *defaultEndpointLocator) CseEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported("Private Endpoints is not supported by this service")
+	}
+	return cloudURL("api.serviceendpoint"), nil
+}
+
+func (l *defaultEndpointLocator) SchematicsEndpoint() (string, error) {
+	if l.private() {
+		switch l.privateRegionOrDefault("schematics") {
+		case "us-south", "us-east":
+			return cloudURL("private-us.schematics"), nil
+		case "eu-gb", "eu-de":
+			return cloudURL("private-eu.schematics"), nil
+		}
+	}
+	return cloudURL(fmt.Sprintf("%s.schematics", l.region)), nil
+}
+
+func (l *defaultEndpointLocator) UserManagementEndpoint() (string, error) {
+	if l.private() {
+		r, ok := l.privateRegion("resource")
+		if !ok {
+			r = "us-south"
+		}
+		return cloudURL(fmt.Sprintf("private.%s.user-management", r)), nil
+	}
+	return cloudURL("user-management"), nil
+}
+
+func (l *defaultEndpointLocator) HpcsEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported(fmt.Sprintf("Private Endpoints is not supported by this service for the region %s", l.region))
+	}
+	return fmt.Sprintf("https://%s.broker.hs-crypto.cloud.ibm.com/crypto_v2/", l.region), nil
+}
+
+func (l *defaultEndpointLocator) FunctionsEndpoint() (string, error) {
+	if l.visibility == "private" {
+		return l.notSupported(fmt.Sprintf("Private Endpoints is not supported by this service for the region %s", l.region))
+	}
+	return cloudURL(fmt.Sprintf("%s.functions", l.region)), nil
+}
+
+func (l *defaultEndpointLocator) SatelliteEndpoint() (string, error) {
+	if r, ok := l.privateRegion("satellite"); l.visibility == "private" || (l.visibility == "public-and-private" && ok) {
+		return cloudURL(fmt.Sprintf("private.%s.api.link.satellite", r)), nil
+	}
+	return cloudURL("api.link.satellite"), nil
+}
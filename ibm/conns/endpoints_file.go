@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/ghodss/yaml"
+)
+
+// EndpointsFile is the content of the endpoints file set with endpoints_file_path or
+// IBMCLOUD_ENDPOINTS_FILE_PATH: service key -> visibility -> region -> URL.
+type EndpointsFile map[string]map[string]map[string]string
+
+// endpointsFileVisibilities are the visibilities which can be set in an endpoints file.
+// The file is not used with the public-and-private visibility.
+var endpointsFileVisibilities = []string{"public", "private"}
+
+// endpointsFileKeys are the service keys read from an endpoints file, by ClientSession
+// and by the bluemix-go endpoint locator. New keys must be added here.
+var endpointsFileKeys = map[string]bool{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT":     true,
+	"IBMCLOUD_API_GATEWAY_ENDPOINT":                true,
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT":       true,
+	"IBMCLOUD_APP_CONFIG_ENDPOINT":                 true,
+	"IBMCLOUD_ATRACKER_API_ENDPOINT":               true,
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT":     true,
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT":    true,
+	"IBMCLOUD_CIS_API_ENDPOINT":                    true,
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT":            true,
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT":             true,
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT": true,
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":                 true,
+	"IBMCLOUD_CR_API_ENDPOINT":                     true,
+	"IBMCLOUD_CSE_ENDPOINT":                        true,
+	"IBMCLOUD_CS_API_ENDPOINT":                     true,
+	"IBMCLOUD_DL_API_ENDPOINT":                     true,
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT":            true,
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT":             true,
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT":    true,
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT":              true,
+	"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT":         true,
+	"IBMCLOUD_GS_API_ENDPOINT":                     true,
+	"IBMCLOUD_GT_API_ENDPOINT":                     true,
+	"IBMCLOUD_HPCS_API_ENDPOINT":                   true,
+	"IBMCLOUD_IAMPAP_API_ENDPOINT":                 true,
+	"IBMCLOUD_IAM_API_ENDPOINT":                    true,
+	"IBMCLOUD_ICD_API_ENDPOINT":                    true,
+	"IBMCLOUD_IS_NG_API_ENDPOINT":                  true,
+	"IBMCLOUD_KP_API_ENDPOINT":                     true,
+	"IBMCLOUD_MCCP_API_ENDPOINT":                   true,
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":            true,
+	"IBMCLOUD_PUSH_API_ENDPOINT":                   true,
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":       true,
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT":    true,
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT":    true,
+	"IBMCLOUD_SATELLITE_API_ENDPOINT":              true,
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT":         true,
+	"IBMCLOUD_SAT_API_ENDPOINT":                    true,
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":             true,
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT":            true,
+	"IBMCLOUD_TG_API_ENDPOINT":                     true,
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT":                  true,
+	"IBMCLOUD_UAA_ENDPOINT":                        true,
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT":            true,
+}
+
+// URL returns the endpoint of the service key for the visibility and region, or "" when
+// the file does not define one.
+func (f EndpointsFile) URL(key, visibility, region string) string {
+	return f[key][visibility][region]
+}
+
+type endpointsFileResult struct {
+	modTime  time.Time
+	size     int64
+	file     EndpointsFile
+	warnings []string
+	errors   []error
+}
+
+// endpointsFiles caches the parsed endpoints files. A file is parsed again as soon as it
+// changes on disk, so edits are picked up the next time the provider is configured.
+var endpointsFiles = struct {
+	lock    sync.Mutex
+	results map[string]endpointsFileResult
+}{results: map[string]endpointsFileResult{}}
+
+// LoadEndpointsFile reads and checks the endpoints file at path. Files with a .yaml or .yml
+// extension are read as YAML, all others as JSON. Unknown service keys are reported as
+// warnings; malformed content, unknown visibilities and invalid URLs as errors.
+func LoadEndpointsFile(path string) (EndpointsFile, []string, []error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("Unable to open endpoints file %s: %s", path, err)}
+	}
+
+	endpointsFiles.lock.Lock()
+	defer endpointsFiles.lock.Unlock()
+	if result, ok := endpointsFiles.results[path]; ok && result.modTime.Equal(info.ModTime()) && result.size == info.Size() {
+		return result.file, result.warnings, result.errors
+	}
+
+	result := endpointsFileResult{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+	result.file, result.warnings, result.errors = parseEndpointsFile(path)
+	endpointsFiles.results[path] = result
+	return result.file, result.warnings, result.errors
+}
+
+func parseEndpointsFile(path string) (EndpointsFile, []string, []error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("Unable to read endpoints file %s: %s", path, err)}
+	}
+
+	var content interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(bytes, &content)
+	default:
+		err = json.Unmarshal(bytes, &content)
+	}
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("Unable to unmarshal endpoints file %s: %s", path, err)}
+	}
+
+	var warnings []string
+	var errors []error
+	services, ok := content.(map[string]interface{})
+	if !ok {
+		return nil, nil, []error{fmt.Errorf("Endpoints file %s must contain an object of service keys", path)}
+	}
+	file := EndpointsFile{}
+	for _, key := range sortedKeys(services) {
+		if !endpointsFileKeys[key] {
+			warnings = append(warnings, fmt.Sprintf("Endpoints file %s: unknown service key %q is ignored", path, key))
+		}
+		visibilities, ok := services[key].(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("Endpoints file %s: %s must be an object of visibilities", path, key))
+			continue
+		}
+		file[key] = map[string]map[string]string{}
+		for _, visibility := range sortedKeys(visibilities) {
+			if !stringInList(visibility, endpointsFileVisibilities) {
+				errors = append(errors, fmt.Errorf("Endpoints file %s: unknown visibility %q for %s, must be one of %s",
+					path, visibility, key, strings.Join(endpointsFileVisibilities, ", ")))
+				continue
+			}
+			regions, ok := visibilities[visibility].(map[string]interface{})
+			if !ok {
+				errors = append(errors, fmt.Errorf("Endpoints file %s: %s.%s must be an object of regions", path, key, visibility))
+				continue
+			}
+			file[key][visibility] = map[string]string{}
+			for _, region := range sortedKeys(regions) {
+				endpoint, ok := regions[region].(string)
+				if !ok {
+					errors = append(errors, fmt.Errorf("Endpoints file %s: %s.%s.%s must be a URL string", path, key, visibility, region))
+					continue
+				}
+				if endpoint == "" {
+					continue
+				}
+				if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+					errors = append(errors, fmt.Errorf("Endpoints file %s: %s.%s.%s is not a valid http(s) URL: %q", path, key, visibility, region, endpoint))
+					continue
+				}
+				file[key][visibility][region] = endpoint
+			}
+		}
+	}
+	return file, warnings, errors
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringInList(value string, list []string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// fileEndpointLocator resolves the endpoints of the bluemix-go clients from a checked
+// endpoints file. Environment variables keep precedence over the file, as in bluemix-go.
+type fileEndpointLocator struct {
+	endpoints.EndpointLocator
+	file       EndpointsFile
+	region     string
+	visibility string
+}
+
+func newEndpointLocator(region, visibility string, file EndpointsFile) endpoints.EndpointLocator {
+	return &fileEndpointLocator{
+		EndpointLocator: &defaultEndpointLocator{region: region, visibility: visibility},
+		file:            file,
+		region:          region,
+		visibility:      visibility,
+	}
+}
+
+func (l *fileEndpointLocator) endpoint(key string, defaultEndpoint func() (string, error)) (string, error) {
+	if v := os.Getenv(key); v != "" {
+		return v, nil
+	}
+	if l.visibility != "public-and-private" {
+		if v := l.file.URL(key, l.visibility, l.region); v != "" {
+			return v, nil
+		}
+	}
+	return defaultEndpoint()
+}
+
+func (l *fileEndpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *fileEndpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *fileEndpointLocator) ContainerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *fileEndpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *fileEndpointLocator) CisEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *fileEndpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *fileEndpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *fileEndpointLocator) IAMEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *fileEndpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *fileEndpointLocator) ICDEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *fileEndpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *fileEndpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *fileEndpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *fileEndpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *fileEndpointLocator) UAAEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *fileEndpointLocator) CseEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *fileEndpointLocator) SchematicsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *fileEndpointLocator) UserManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *fileEndpointLocator) HpcsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *fileEndpointLocator) FunctionsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *fileEndpointLocator) SatelliteEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SAT_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
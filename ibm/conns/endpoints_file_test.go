@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEndpointsFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEndpointsFile(t *testing.T) {
+	files := map[string]string{
+		"endpoints.json": `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://us-south.private.iaas.cloud.ibm.com/v1"}}}`,
+		"endpoints.yaml": `
+IBMCLOUD_IS_NG_API_ENDPOINT:
+  private:
+    us-south: https://us-south.private.iaas.cloud.ibm.com/v1
+`,
+	}
+	for name, content := range files {
+		file, warnings, errs := LoadEndpointsFile(writeEndpointsFile(t, name, content))
+		if len(warnings) != 0 || len(errs) != 0 {
+			t.Fatalf("%s: unexpected warnings %v and errors %v", name, warnings, errs)
+		}
+		if url := file.URL("IBMCLOUD_IS_NG_API_ENDPOINT", "private", "us-south"); url != "https://us-south.private.iaas.cloud.ibm.com/v1" {
+			t.Fatalf("%s: unexpected endpoint %q", name, url)
+		}
+		if url := file.URL("IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-south"); url != "" {
+			t.Fatalf("%s: unexpected endpoint %q", name, url)
+		}
+	}
+}
+
+func TestLoadEndpointsFileWarnings(t *testing.T) {
+	path := writeEndpointsFile(t, "endpoints.json", `{"IBMCLOUD_UNKNOWN_ENDPOINT": {"public": {"us-south": "https://example.com"}}}`)
+	_, warnings, errs := LoadEndpointsFile(path)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "IBMCLOUD_UNKNOWN_ENDPOINT") {
+		t.Fatalf("expected a warning for the unknown key, got %v", warnings)
+	}
+}
+
+func TestLoadEndpointsFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"malformed":  `{"IBMCLOUD_IS_NG_API_ENDPOINT": `,
+		"not object": `["https://example.com"]`,
+		"visibility": `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"internal": {"us-south": "https://example.com"}}}`,
+		"regions":    `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": "https://example.com"}}`,
+		"url":        `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "us-south.iaas.cloud.ibm.com"}}}`,
+		"value":      `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": 1}}}`,
+	}
+	for name, content := range cases {
+		if _, _, errs := LoadEndpointsFile(writeEndpointsFile(t, "endpoints.json", content)); len(errs) == 0 {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+	if _, _, errs := LoadEndpointsFile(filepath.Join(t.TempDir(), "missing.json")); len(errs) == 0 {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestLoadEndpointsFileReload(t *testing.T) {
+	path := writeEndpointsFile(t, "endpoints.json", `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "https://a.example.com"}}}`)
+	if _, _, errs := LoadEndpointsFile(path); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if err := ioutil.WriteFile(path, []byte(`{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "https://bb.example.com"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	file, _, errs := LoadEndpointsFile(path)
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if url := file.URL("IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-south"); url != "https://bb.example.com" {
+		t.Fatalf("expected the changed endpoint, got %q", url)
+	}
+}
+
+func TestNewEndpointLocatorFile(t *testing.T) {
+	files := map[string]string{
+		"endpoints.json": `{"IBMCLOUD_CS_API_ENDPOINT": {"public": {"us-south": "https://containers.example.com"}}}`,
+		"endpoints.yaml": `
+IBMCLOUD_CS_API_ENDPOINT:
+  public:
+    us-south: https://containers.example.com
+`,
+	}
+	for name, content := range files {
+		path := writeEndpointsFile(t, name, content)
+		t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", path)
+		file, _, errs := LoadEndpointsFile(path)
+		if len(errs) != 0 {
+			t.Fatalf("%s: %v", name, errs)
+		}
+		locator := newEndpointLocator("us-south", "public", file)
+		if url, err := locator.ContainerEndpoint(); err != nil || url != "https://containers.example.com" {
+			t.Fatalf("%s: unexpected endpoint %q: %v", name, url, err)
+		}
+		if url, err := locator.IAMEndpoint(); err != nil || url != "https://iam.cloud.ibm.com" {
+			t.Fatalf("%s: unexpected default endpoint %q: %v", name, url, err)
+		}
+		if env := os.Getenv("IBMCLOUD_ENDPOINTS_FILE_PATH"); env != path {
+			t.Fatalf("%s: expected IBMCLOUD_ENDPOINTS_FILE_PATH to be left unchanged, got %q", name, env)
+		}
+	}
+}
+
+func TestDefaultEndpointLocator(t *testing.T) {
+	cases := []struct {
+		region, visibility string
+		endpoint           func(l *defaultEndpointLocator) (string, error)
+		url                string
+	}{
+		{"eu-de", "public", (*defaultEndpointLocator).ContainerEndpoint, "https://containers.cloud.ibm.com/global"},
+		{"eu-de", "private", (*defaultEndpointLocator).ContainerEndpoint, "https://private.eu-de.containers.cloud.ibm.com/global"},
+		{"br-sao", "public-and-private", (*defaultEndpointLocator).ContainerEndpoint, "https://containers.cloud.ibm.com/global"},
+		{"eu-de", "private", (*defaultEndpointLocator).IAMEndpoint, "https://private.iam.cloud.ibm.com"},
+		{"us-east", "private", (*defaultEndpointLocator).IAMEndpoint, "https://private.us-east.iam.cloud.ibm.com"},
+		{"jp-tok", "private", (*defaultEndpointLocator).AccountManagementEndpoint, "https://private.us-south.accounts.cloud.ibm.com"},
+		{"eu-gb", "private", (*defaultEndpointLocator).SchematicsEndpoint, "https://private-eu.schematics.cloud.ibm.com"},
+		{"eu-gb", "public", (*defaultEndpointLocator).SchematicsEndpoint, "https://eu-gb.schematics.cloud.ibm.com"},
+		{"eu-de", "public-and-private", (*defaultEndpointLocator).ResourceControllerEndpoint, "https://resource-controller.cloud.ibm.com"},
+		{"eu-de", "private", (*defaultEndpointLocator).ResourceControllerEndpoint, "https://private.resource-controller.cloud.ibm.com"},
+		{"jp-osa", "public", (*defaultEndpointLocator).ContainerRegistryEndpoint, "https://jp2.icr.io"},
+		{"us-south", "private", (*defaultEndpointLocator).ICDEndpoint, "https://api.us-south.private.databases.cloud.ibm.com"},
+		{"us-south", "private", (*defaultEndpointLocator).FunctionsEndpoint, ""},
+		{"br-sao", "public", (*defaultEndpointLocator).UAAEndpoint, ""},
+	}
+	for _, c := range cases {
+		url, err := c.endpoint(&defaultEndpointLocator{region: c.region, visibility: c.visibility})
+		switch {
+		case c.url == "" && err == nil:
+			t.Errorf("%s %s: expected an error, got %q", c.region, c.visibility, url)
+		case c.url != "" && (err != nil || url != c.url):
+			t.Errorf("%s %s: expected %q, got %q: %v", c.region, c.visibility, c.url, url, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lockWaitWarning is the time waited for a lock before the holders of the lock are logged
+var lockWaitWarning = time.Minute
+
+// Locks serializes the changes of the resources of the provider sharing a parent object, such as
+// the rules of a security group or the pools of a load balancer, on a key derived from the ID of
+// the parent object.
+var Locks = NewLockManager()
+
+// LockManager is a set of read/write locks by key. The locks are taken with a context, so that
+// waiting for a lock held by a stuck API call ends with the timeout of the operation instead of
+// hanging the apply, and the key of a lock is freed once no one holds or waits for it.
+//
+// A write lock is exclusive. Read locks are shared, for the changes which can run concurrently
+// but not during a write on the same key. A waiting writer blocks the new readers, so that a
+// steady flow of readers does not starve it.
+type LockManager struct {
+	lock sync.Mutex
+	keys map[string]*keyLock
+}
+
+// keyLock is the state of the lock of a key
+type keyLock struct {
+	// refs counts the holders and the waiters of the lock, the key is freed at 0
+	refs int
+	// writer is true when the write lock is held, readers counts the read locks held
+	writer  bool
+	readers int
+	// writers counts the writers waiting for the lock
+	writers int
+	// released is closed, and replaced, when the lock is released
+	released chan struct{}
+	// holders are the callers holding the lock, for the diagnostics
+	holders []lockHolder
+}
+
+type lockHolder struct {
+	caller string
+	write  bool
+	since  time.Time
+}
+
+func (h lockHolder) String() string {
+	mode := "read"
+	if h.write {
+		mode = "write"
+	}
+	return fmt.Sprintf("%s (%s lock, held for %s)", h.caller, mode, time.Since(h.since).Round(time.Second))
+}
+
+// NewLockManager returns a lock manager without locks.
+func NewLockManager() *LockManager {
+	return &LockManager{keys: map[string]*keyLock{}}
+}
+
+// LockContext takes the write lock of key, waiting until it is released or until ctx is done. The
+// caller must call Unlock for the same key once the lock is taken.
+func (m *LockManager) LockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, true)
+}
+
+// RLockContext takes a read lock of key, waiting until the write lock is released or until ctx is
+// done. The caller must call RUnlock for the same key once the lock is taken.
+func (m *LockManager) RLockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, false)
+}
+
+// LockTimeout takes the write lock of key, waiting at most timeout, for the functions of the
+// resources which have no context but the timeout of their operation.
+func (m *LockManager) LockTimeout(timeout time.Duration, key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return m.acquire(ctx, key, true)
+}
+
+// RLockTimeout takes a read lock of key, waiting at most timeout.
+func (m *LockManager) RLockTimeout(timeout time.Duration, key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return m.acquire(ctx, key, false)
+}
+
+// Unlock releases the write lock of key.
+func (m *LockManager) Unlock(key string) {
+	m.release(key, true)
+}
+
+// RUnlock releases a read lock of key.
+func (m *LockManager) RUnlock(key string) {
+	m.release(key, false)
+}
+
+// Holders returns the callers holding the lock of key.
+func (m *LockManager) Holders(key string) []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.holders(key)
+}
+
+func (m *LockManager) holders(key string) []string {
+	k, ok := m.keys[key]
+	if !ok {
+		return nil
+	}
+	holders := make([]string, 0, len(k.holders))
+	for _, h := range k.holders {
+		holders = append(holders, h.String())
+	}
+	return holders
+}
+
+func (m *LockManager) acquire(ctx context.Context, key string, write bool) error {
+	holder := lockHolder{caller: lockCaller(), write: write}
+
+	m.lock.Lock()
+	k, ok := m.keys[key]
+	if !ok {
+		k = &keyLock{released: make(chan struct{})}
+		m.keys[key] = k
+	}
+	k.refs++
+	if write {
+		k.writers++
+	}
+	start := time.Now()
+	warning := time.NewTimer(lockWaitWarning)
+	defer warning.Stop()
+	for !k.available(write) {
+		released := k.released
+		m.lock.Unlock()
+		select {
+		case <-released:
+		case <-warning.C:
+			log.Printf("[WARN] %s has waited %s for the lock of %q held by %s", holder.caller,
+				time.Since(start).Round(time.Second), key, strings.Join(m.Holders(key), ", "))
+		case <-ctx.Done():
+			m.lock.Lock()
+			holders := m.holders(key)
+			if write {
+				k.writers--
+			}
+			m.unref(key, k)
+			m.lock.Unlock()
+			return fmt.Errorf("[ERROR] Error waiting for the lock of %q held by %s: %w", key, strings.Join(holders, ", "), ctx.Err())
+		}
+		m.lock.Lock()
+	}
+	if write {
+		k.writers--
+		k.writer = true
+	} else {
+		k.readers++
+	}
+	holder.since = time.Now()
+	k.holders = append(k.holders, holder)
+	m.lock.Unlock()
+	if waited := time.Since(start); waited > time.Second {
+		log.Printf("[DEBUG] %s waited %s for the lock of %q", holder.caller, waited.Round(time.Second), key)
+	}
+	return nil
+}
+
+func (m *LockManager) release(key string, write bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	k, ok := m.keys[key]
+	if !ok || (write && !k.writer) || (!write && k.readers == 0) {
+		panic(fmt.Sprintf("unlock of the unlocked key %q", key))
+	}
+	if write {
+		k.writer = false
+	} else {
+		k.readers--
+	}
+	for i, h := range k.holders {
+		if h.write == write {
+			k.holders = append(k.holders[:i], k.holders[i+1:]...)
+			break
+		}
+	}
+	m.unref(key, k)
+}
+
+// unref drops a holder or a waiter of the lock of key and wakes the waiters up, the key is freed
+// once no one holds or waits for the lock.
+func (m *LockManager) unref(key string, k *keyLock) {
+	k.refs--
+	close(k.released)
+	k.released = make(chan struct{})
+	if k.refs == 0 {
+		delete(m.keys, key)
+	}
+}
+
+func (k *keyLock) available(write bool) bool {
+	if write {
+		return !k.writer && k.readers == 0
+	}
+	return !k.writer && k.writers == 0
+}
+
+// lockCaller returns the function taking the lock, outside of the lock manager.
+func lockCaller() string {
+	pc := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "conns.(*LockManager)") && !strings.Contains(frame.Function, "conns.(*MutexKV)") {
+			return path.Base(frame.Function)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// locked reports whether lock takes the lock of the key within 50ms.
+func locked(lock func(ctx context.Context) error) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	return lock(ctx) == nil
+}
+
+func TestLockManagerLockContext(t *testing.T) {
+	m := NewLockManager()
+	ctx := context.Background()
+
+	if err := m.LockContext(ctx, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := m.LockContext(ctx, "foo")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the second lock to time out, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "TestLockManagerLockContext") {
+		t.Fatalf("expected the error to name the holder of the lock, got %v", err)
+	}
+	if !locked(func(ctx context.Context) error { return m.LockContext(ctx, "bar") }) {
+		t.Fatal("expected the lock of a different key to be taken")
+	}
+
+	m.Unlock("foo")
+	m.Unlock("bar")
+	if len(m.keys) != 0 {
+		t.Fatalf("expected the keys to be freed, got %d keys", len(m.keys))
+	}
+}
+
+func TestLockManagerUnlockWakesWaiter(t *testing.T) {
+	m := NewLockManager()
+	if err := m.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- m.LockContext(context.Background(), "foo")
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected the second lock to wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+	m.Unlock("foo")
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	m.Unlock("foo")
+	if len(m.keys) != 0 {
+		t.Fatalf("expected the keys to be freed, got %d keys", len(m.keys))
+	}
+}
+
+func TestLockManagerReadLocks(t *testing.T) {
+	m := NewLockManager()
+	rlock := func(ctx context.Context) error { return m.RLockContext(ctx, "vpc") }
+	lock := func(ctx context.Context) error { return m.LockContext(ctx, "vpc") }
+
+	if !locked(rlock) || !locked(rlock) {
+		t.Fatal("expected the read locks to be shared")
+	}
+	if locked(lock) {
+		t.Fatal("expected the write lock to wait for the readers")
+	}
+	if len(m.Holders("vpc")) != 2 {
+		t.Fatalf("expected 2 holders, got %v", m.Holders("vpc"))
+	}
+
+	// A waiting writer blocks the new readers
+	done := make(chan error)
+	go func() {
+		done <- m.LockContext(context.Background(), "vpc")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if locked(rlock) {
+		t.Fatal("expected the read lock to wait for the waiting writer")
+	}
+	m.RUnlock("vpc")
+	m.RUnlock("vpc")
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if locked(rlock) {
+		t.Fatal("expected the read lock to wait for the writer")
+	}
+	m.Unlock("vpc")
+	if !locked(rlock) {
+		t.Fatal("expected the read lock to be taken once the writer is done")
+	}
+	m.RUnlock("vpc")
+	if len(m.keys) != 0 {
+		t.Fatalf("expected the keys to be freed, got %d keys", len(m.keys))
+	}
+}
+
+func TestLockManagerUnlockUnlocked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected the unlock of an unlocked key to panic")
+		}
+	}()
+	NewLockManager().Unlock("foo")
+}
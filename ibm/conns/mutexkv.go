@@ -4,67 +4,44 @@
 package conns
 
 import (
-	"log"
-	"sync"
+	"context"
 )
 
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
-//
-// The initial use case is to let aws_security_group_rule resources serialize
-// their access to individual security groups based on SG ID.
+// Deprecated: Use the write locks of Locks, which can be cancelled with a context.
 
-// This is a global MutexKV for use within this plugin.
-var IbmMutexKV = NewMutexKV()
+// This is a global MutexKV for use within this plugin. It shares the keys of Locks.
+//
+// Deprecated: Use Locks.
+var IbmMutexKV = &MutexKV{locks: Locks}
 
 type MutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	locks *LockManager
 }
 
 // Lock the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use LockManager.LockContext.
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	m.locks.LockContext(context.Background(), key)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use LockManager.Unlock.
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
-}
-
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
-	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
-	}
-	return mutex
+	m.locks.Unlock(key)
 }
 
 // NewMutexKV Returns a properly initalized MutexKV
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use NewLockManager.
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		locks: NewLockManager(),
 	}
 }
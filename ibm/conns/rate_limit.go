@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	gohttp "net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+// Service families which can be rate limited on the client side
+const (
+	RateLimitVPC                = "vpc"
+	RateLimitIAM                = "iam"
+	RateLimitResourceController = "resource_controller"
+	RateLimitCIS                = "cis"
+	RateLimitPower              = "power"
+)
+
+// RateLimitServices lists the service families accepted in the rate_limit provider block.
+var RateLimitServices = []string{
+	RateLimitVPC,
+	RateLimitIAM,
+	RateLimitResourceController,
+	RateLimitCIS,
+	RateLimitPower,
+}
+
+// RateLimit is a token bucket refilled with RequestsPerSecond tokens per second and
+// holding at most Burst tokens. Every HTTP request sent to the service takes a token.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiters holds one limiter per configured service family. All the clients of a
+// family share its limiter, so the limit applies to the provider as a whole.
+type rateLimiters map[string]*rate.Limiter
+
+func (c *Config) rateLimiters() rateLimiters {
+	limiters := rateLimiters{}
+	for service, limit := range c.RateLimits {
+		if limit.RequestsPerSecond <= 0 {
+			continue
+		}
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiters[service] = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	return limiters
+}
+
+// rateLimitedTransport waits for a token of the limiter before sending each request.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	base    gohttp.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// RateLimitedTransport returns a transport which sends the requests through base, at the
+// rate allowed by limiter. base defaults to DefaultTransport.
+func RateLimitedTransport(limiter *rate.Limiter, base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = DefaultTransport()
+	}
+	if limiter == nil {
+		return base
+	}
+	return &rateLimitedTransport{limiter: limiter, base: base}
+}
+
+// RoundTripper wraps base with the limiter of the service family, if one is configured.
+func (l rateLimiters) RoundTripper(service string, base gohttp.RoundTripper) gohttp.RoundTripper {
+	if l[service] == nil {
+		return base
+	}
+	return RateLimitedTransport(l[service], base)
+}
+
+// Apply rate limits an IBM Cloud SDK service with the limiter of the service family.
+// When retries are enabled the limiter sits below the retry loop, so every attempt
+// takes a token.
+func (l rateLimiters) Apply(service string, baseService *core.BaseService) {
+	if l[service] == nil {
+		return
+	}
+	client := baseService.Client
+	if tr, ok := client.Transport.(*retryablehttp.RoundTripper); ok {
+		client = tr.Client.HTTPClient
+	}
+	client.Transport = l.RoundTripper(service, client.Transport)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestRateLimitedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	limiters := (&Config{RateLimits: map[string]RateLimit{
+		RateLimitVPC: {RequestsPerSecond: 20, Burst: 1},
+	}}).rateLimiters()
+	client := &http.Client{Transport: limiters.RoundTripper(RateLimitVPC, http.DefaultTransport)}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected 3 requests at 20 requests per second to take at least 100ms, took %s", elapsed)
+	}
+}
+
+func TestRateLimitersApply(t *testing.T) {
+	limiters := (&Config{RateLimits: map[string]RateLimit{
+		RateLimitCIS: {RequestsPerSecond: 1},
+	}}).rateLimiters()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	RetryPolicy{MaxRetries: 1, MinDelay: time.Second, MaxDelay: time.Second}.EnableRetries(service)
+
+	limiters.Apply(RateLimitVPC, service)
+	limiters.Apply(RateLimitCIS, service)
+	tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok {
+		t.Fatal("expected the service to keep its retrying transport")
+	}
+	if _, ok := tr.Client.HTTPClient.Transport.(*rateLimitedTransport); !ok {
+		t.Fatal("expected the limiter below the retry loop")
+	}
+}
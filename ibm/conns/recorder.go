@@ -0,0 +1,395 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Modes of the HTTP recorder, set with IBMCLOUD_HTTP_RECORDER_MODE
+const (
+	RecorderModeRecord = "record"
+	RecorderModeReplay = "replay"
+)
+
+// redacted replaces the credentials in the recorded requests
+const redacted = "REDACTED"
+
+// redactedHeaders and redactedParams carry credentials and are never written to a cassette
+var redactedHeaders = []string{"Authorization", "X-Auth-Token", "X-Auth-Refresh-Token", "Refresh-Token", "X-Auth-Resource-Group"}
+var redactedParams = []string{"apikey", "refresh_token", "access_token", "cr_token", "password", "client_secret", "passcode"}
+
+// redactedFields carry credentials in the JSON bodies of the requests and the responses, as the
+// tokens of the IAM token responses, and are never written to a cassette
+var redactedFields = append([]string{"api_key", "delegated_refresh_token", "ims_token", "uaa_token", "uaa_refresh_token", "token", "secret", "private_key"}, redactedParams...)
+
+// versionDate matches the version query parameter of the IBM Cloud APIs, which is set to the current date
+// by some clients.
+var versionDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// Interaction is an HTTP request and its response, as stored in a cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the recorded part of an HTTP request.
+type RecordedRequest struct {
+	Method string        `json:"method"`
+	URL    string        `json:"url"`
+	Header gohttp.Header `json:"header,omitempty"`
+	Body   string        `json:"body,omitempty"`
+}
+
+// RecordedResponse is the recorded part of an HTTP response.
+type RecordedResponse struct {
+	StatusCode int           `json:"status_code"`
+	Header     gohttp.Header `json:"header,omitempty"`
+	Body       string        `json:"body,omitempty"`
+}
+
+// Recorder records the HTTP calls sent through its transports into a cassette file, or serves them
+// back from it. A cassette holds one JSON encoded Interaction per line, so that the provider processes
+// started by a terraform run can all append to the same file.
+//
+// In replay mode the interactions are matched on the method and the URL of the request. The
+// interactions recorded for the same request are served in order, and the last one is served again
+// once they are used up.
+type Recorder struct {
+	mode string
+	path string
+
+	lock         sync.Mutex
+	interactions map[string][]*Interaction
+	served       map[string]int
+}
+
+// NewRecorder returns a recorder for the cassette at path. In replay mode the cassette is read
+// immediately.
+func NewRecorder(mode, path string) (*Recorder, error) {
+	if path == "" {
+		return nil, fmt.Errorf("[ERROR] A cassette file must be set for the %s mode of the HTTP recorder", mode)
+	}
+	r := &Recorder{
+		mode:         mode,
+		path:         path,
+		interactions: map[string][]*Interaction{},
+		served:       map[string]int{},
+	}
+	switch mode {
+	case RecorderModeRecord:
+		return r, nil
+	case RecorderModeReplay:
+		return r, r.load()
+	}
+	return nil, fmt.Errorf("[ERROR] Unknown HTTP recorder mode %q, must be %s or %s", mode, RecorderModeRecord, RecorderModeReplay)
+}
+
+var httpRecorder struct {
+	once     sync.Once
+	recorder *Recorder
+	err      error
+}
+
+// HTTPRecorder returns the recorder configured with the IBMCLOUD_HTTP_RECORDER_MODE and
+// IBMCLOUD_HTTP_CASSETTE environment variables, or nil when recording is off.
+func HTTPRecorder() (*Recorder, error) {
+	httpRecorder.once.Do(func() {
+		mode := EnvFallBack([]string{"IBMCLOUD_HTTP_RECORDER_MODE", "IC_HTTP_RECORDER_MODE"}, "")
+		if mode == "" {
+			return
+		}
+		path := EnvFallBack([]string{"IBMCLOUD_HTTP_CASSETTE", "IC_HTTP_CASSETTE"}, "")
+		httpRecorder.recorder, httpRecorder.err = NewRecorder(mode, path)
+	})
+	return httpRecorder.recorder, httpRecorder.err
+}
+
+// recordingTransport returns base wrapped with the configured recorder, if any.
+func recordingTransport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	r, _ := HTTPRecorder()
+	return r.Transport(base)
+}
+
+// recordingAuthenticator sends the token requests of an IAM authenticator through the configured
+// recorder, if any.
+func recordingAuthenticator(authenticator core.Authenticator) {
+	r, _ := HTTPRecorder()
+	if r == nil {
+		return
+	}
+	if iam, ok := authenticator.(*core.IamAuthenticator); ok {
+		client := core.DefaultHTTPClient()
+		client.Transport = r.Transport(client.Transport)
+		iam.Client = client
+	}
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() string {
+	return r.mode
+}
+
+// Transport wraps base with the recorder. base defaults to http.DefaultTransport and is not used
+// in replay mode. A nil recorder returns base.
+func (r *Recorder) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if r == nil {
+		return base
+	}
+	if _, ok := base.(*recorderTransport); ok {
+		return base
+	}
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &recorderTransport{recorder: r, base: base}
+}
+
+type recorderTransport struct {
+	recorder *Recorder
+	base     gohttp.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if t.recorder.mode == RecorderModeReplay {
+		return t.recorder.replay(req)
+	}
+	return t.recorder.record(req, t.base)
+}
+
+func (r *Recorder) record(req *gohttp.Request, base gohttp.RoundTripper) (*gohttp.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		var err error
+		if reqBody, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: redactHeader(req.Header),
+			Body:   redactBody(req.Header.Get("Content-Type"), string(reqBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       redactBody(resp.Header.Get("Content-Type"), string(respBody)),
+		},
+	}
+	if err := r.append(interaction); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *Recorder) append(interaction *Interaction) error {
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	file, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening the cassette %s: %s", r.path, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("[ERROR] Error writing the cassette %s: %s", r.path, err)
+	}
+	return nil
+}
+
+func (r *Recorder) load() error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening the cassette %s: %s", r.path, err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		interaction := &Interaction{}
+		if err := json.Unmarshal(scanner.Bytes(), interaction); err != nil {
+			return fmt.Errorf("[ERROR] Error reading line %d of the cassette %s: %s", n, r.path, err)
+		}
+		key := interactionKey(interaction.Request.Method, interaction.Request.URL)
+		r.interactions[key] = append(r.interactions[key], interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("[ERROR] Error reading the cassette %s: %s", r.path, err)
+	}
+	return nil
+}
+
+func (r *Recorder) replay(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	key := interactionKey(req.Method, req.URL.String())
+	r.lock.Lock()
+	interactions := r.interactions[key]
+	if len(interactions) == 0 {
+		r.lock.Unlock()
+		return nil, fmt.Errorf("[ERROR] No interaction recorded in the cassette %s for %s %s", r.path, req.Method, req.URL)
+	}
+	i := r.served[key]
+	if i < len(interactions)-1 {
+		r.served[key] = i + 1
+	}
+	interaction := interactions[i]
+	r.lock.Unlock()
+
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = gohttp.Header{}
+	}
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, gohttp.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// interactionKey identifies the interactions of a request. Date valued version parameters are left
+// out so that a cassette can be replayed on another day.
+func interactionKey(method, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return method + " " + rawURL
+	}
+	query := u.Query()
+	if versionDate.MatchString(query.Get("version")) {
+		query.Del("version")
+	}
+	u.RawQuery = query.Encode()
+	return method + " " + u.String()
+}
+
+func redactHeader(header gohttp.Header) gohttp.Header {
+	header = header.Clone()
+	for _, name := range redactedHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redacted)
+		}
+	}
+	return header
+}
+
+// redactBody redacts the credentials of a form encoded or JSON body.
+func redactBody(contentType, body string) string {
+	if body == "" {
+		return body
+	}
+	if !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return redactJSON(body)
+	}
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return body
+	}
+	for _, name := range redactedParams {
+		if values.Get(name) != "" {
+			values.Set(name, redacted)
+		}
+	}
+	return values.Encode()
+}
+
+// redactJSON redacts the redactedFields of a JSON body, at any depth. Other bodies are returned
+// unchanged.
+func redactJSON(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var content interface{}
+	if err := decoder.Decode(&content); err != nil || decoder.More() {
+		return body
+	}
+	if !redactJSONValue(content) {
+		return body
+	}
+	out, err := json.Marshal(content)
+	if err != nil {
+		return body
+	}
+	return string(out)
+}
+
+// redactJSONValue redacts the redactedFields of value in place, and returns whether it changed.
+func redactJSONValue(value interface{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if s, ok := field.(string); ok && s != "" && isRedactedField(key) {
+				v[key] = redactToken(s)
+				changed = true
+			} else if redactJSONValue(field) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactJSONValue(item) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func isRedactedField(name string) bool {
+	for _, field := range redactedFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactToken redacts a credential. The signature of a JWT is redacted only, so that the clients
+// replaying a cassette can still read the expiration and the claims of the tokens, which cannot be
+// used without it.
+func redactToken(token string) string {
+	if parts := strings.Split(token, "."); len(parts) == 3 && parts[0] != "" && parts[1] != "" {
+		return parts[0] + "." + parts[1] + "." + redacted
+	}
+	return redacted
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// DefaultRetryMinDelay - wait before the first retry of a failed api call
+	DefaultRetryMinDelay = 1 * time.Second
+	// DefaultRetryMaxDelay - upper bound of the wait between two retries
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryableStatusCodes are the HTTP status codes for which a request is retried.
+var retryableStatusCodes = map[int]bool{
+	408: true,
+	429: true,
+	500: true,
+	502: true,
+	503: true,
+	504: true,
+	520: true,
+	599: true,
+}
+
+// RetryPolicy is the retry and backoff behaviour shared by every client built in ClientSession.
+// Failed calls are retried with an exponential backoff with jitter, starting at MinDelay and
+// capped at MaxDelay. A Retry-After header sent by the server takes precedence over the backoff.
+type RetryPolicy struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+
+	// skipStatusCodes are retryable status codes which are not retried by this policy
+	skipStatusCodes map[int]bool
+
+	// tokens renews the IAM token of the requests, below the retry loop
+	tokens *tokenManager
+}
+
+// RetryPolicy returns the retry policy configured for the provider.
+func (c *Config) RetryPolicy() RetryPolicy {
+	policy := RetryPolicy{
+		MaxRetries: c.RetryCount,
+		MinDelay:   c.RetryDelay,
+		MaxDelay:   c.RetryMaxDelay,
+	}
+	if policy.MaxRetries < 0 {
+		policy.MaxRetries = 0
+	}
+	if policy.MinDelay <= 0 {
+		policy.MinDelay = DefaultRetryMinDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultRetryMaxDelay
+	}
+	if policy.MaxDelay < policy.MinDelay {
+		policy.MaxDelay = policy.MinDelay
+	}
+	return policy
+}
+
+// withoutStatusCodes returns a copy of the policy which does not retry the given status codes.
+func (p RetryPolicy) withoutStatusCodes(codes ...int) RetryPolicy {
+	skip := make(map[int]bool, len(p.skipStatusCodes)+len(codes))
+	for code := range p.skipStatusCodes {
+		skip[code] = true
+	}
+	for _, code := range codes {
+		skip[code] = true
+	}
+	p.skipStatusCodes = skip
+	return p
+}
+
+func (p RetryPolicy) isRetryableStatus(statusCode int) bool {
+	return retryableStatusCodes[statusCode] && !p.skipStatusCodes[statusCode]
+}
+
+// Backoff returns the wait before retry number attempt (starting at 0). The Retry-After header
+// of resp is honoured when present, bounded by MaxDelay.
+func (p RetryPolicy) Backoff(attempt int, resp *gohttp.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		if wait > p.MaxDelay {
+			wait = p.MaxDelay
+		}
+		return wait
+	}
+	wait := p.MaxDelay
+	if attempt < 32 {
+		if exp := p.MinDelay << uint(attempt); exp > 0 && exp < p.MaxDelay {
+			wait = exp
+		}
+	}
+	// Full jitter over the upper half of the interval keeps concurrent
+	// callers from retrying in lock step after a burst of 429s.
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryTime, err := gohttp.ParseTime(value); err == nil {
+		wait := time.Until(retryTime)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// checkRetry implements retryablehttp.CheckRetry. Transport errors are classified the same way
+// as the IBM Cloud SDKs do, responses by their status code.
+func (p RetryPolicy) checkRetry(ctx context.Context, resp *gohttp.Response, err error) (bool, error) {
+	if err != nil || ctx.Err() != nil {
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+	return p.isRetryableStatus(resp.StatusCode), nil
+}
+
+func (p RetryPolicy) backoff(min, max time.Duration, attempt int, resp *gohttp.Response) time.Duration {
+	return p.Backoff(attempt, resp)
+}
+
+func (p RetryPolicy) configure(client *retryablehttp.Client) {
+	client.RetryMax = p.MaxRetries
+	client.RetryWaitMin = p.MinDelay
+	client.RetryWaitMax = p.MaxDelay
+	client.CheckRetry = p.checkRetry
+	client.Backoff = p.backoff
+}
+
+// EnableRetries turns on retries for an IBM Cloud SDK service using this policy.
+func (p RetryPolicy) EnableRetries(service *core.BaseService) {
+	service.EnableRetries(p.MaxRetries, p.MaxDelay)
+	if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		p.configure(tr.Client)
+		tr.Client.HTTPClient.Transport = p.tokens.Transport(recordingTransport(tr.Client.HTTPClient.Transport))
+	}
+}
+
+// HTTPClient returns an http.Client which retries the requests sent through client using this
+// policy. It is used for the clients which are not built on the IBM Cloud SDK core.
+func (p RetryPolicy) HTTPClient(client *gohttp.Client) *gohttp.Client {
+	recorded := *client
+	recorded.Transport = p.tokens.Transport(recordingTransport(client.Transport))
+	retryableClient := core.NewRetryableClientWithHTTPClient(&recorded)
+	p.configure(retryableClient)
+	return retryableClient.StandardClient()
+}
+
+// RoundTripper returns a transport which retries the requests sent through base using this policy.
+func (p RetryPolicy) RoundTripper(base gohttp.RoundTripper) gohttp.RoundTripper {
+	return p.HTTPClient(&gohttp.Client{Transport: base}).Transport
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, MinDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	for attempt, max := range []time.Duration{1, 2, 4, 8, 10, 10} {
+		max = max * time.Second
+		wait := policy.Backoff(attempt, nil)
+		if wait < max/2 || wait > max {
+			t.Fatalf("attempt %d: expected a wait between %s and %s, got %s", attempt, max/2, max, wait)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, MinDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if wait := policy.Backoff(0, resp); wait != 3*time.Second {
+		t.Fatalf("expected the Retry-After delay of 3s, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if wait := policy.Backoff(0, resp); wait != policy.MaxDelay {
+		t.Fatalf("expected the Retry-After delay to be capped at %s, got %s", policy.MaxDelay, wait)
+	}
+}
+
+func TestConfigRetryPolicyDefaults(t *testing.T) {
+	policy := (&Config{RetryCount: -1}).RetryPolicy()
+	if policy.MaxRetries != 0 || policy.MinDelay != DefaultRetryMinDelay || policy.MaxDelay != DefaultRetryMaxDelay {
+		t.Fatalf("unexpected default retry policy %+v", policy)
+	}
+}
+
+func TestRetryPolicyHTTPClient(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	resp, err := policy.HTTPClient(&http.Client{}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected success after 3 calls, got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryPolicyWithoutStatusCodes(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	resp, err := policy.withoutStatusCodes(500).HTTPClient(&http.Client{}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// tagCachePageSize is the number of resources loaded per Global Search call, the maximum allowed
+const tagCachePageSize = 1000
+
+// tagCacheDirectReads is the number of tag reads sent to the tagging API before the tags of the
+// account are loaded, so that runs reading the tags of a few resources do not load a large account.
+const tagCacheDirectReads = 20
+
+// TagCache serves the user and access tags of the resources of the account from memory, so that
+// refreshing a large state does not send one tagging API call per resource. The tags of all the
+// resources of the account are loaded with Global Search once the run has read the tags of a few
+// resources, and kept for the run of the provider.
+//
+// Global Search is eventually consistent. The tags of a resource which is not found in the loaded
+// tags, or whose tags were attached or detached by the provider, are not served by the cache and
+// must be read from the tagging API.
+type TagCache struct {
+	search func(*searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error)
+
+	load sync.Once
+	// err is the error of the load, the cache serves no tags after a failed load
+	err error
+
+	lock sync.RWMutex
+	// reads counts the tag reads, up to tagCacheDirectReads
+	reads int
+	// tags holds the tags of the resources by CRN and by tag type
+	tags map[string]map[string][]string
+	// invalidated holds the resources whose tags were changed by the provider
+	invalidated map[string]bool
+}
+
+// NewTagCache returns a cache loading the tags with search.
+func NewTagCache(search func(*searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error)) *TagCache {
+	return &TagCache{search: search, tags: map[string]map[string][]string{}, invalidated: map[string]bool{}}
+}
+
+// tagCacheFields are the Global Search fields of the tag types served by the cache
+var tagCacheFields = map[string]string{"user": "tags", "access": "access_tags"}
+
+// Tags returns the tags of tagType, "user" or "access", attached to the resource crn. ok is false
+// when the tags must be read from the tagging API instead. A nil cache serves no tags.
+func (c *TagCache) Tags(crn, tagType string) (tags []string, ok bool) {
+	if c == nil || !strings.HasPrefix(crn, "crn:") {
+		return nil, false
+	}
+	if strings.TrimSpace(tagType) == "" {
+		tagType = "user"
+	}
+	if _, ok := tagCacheFields[tagType]; !ok {
+		return nil, false
+	}
+	c.lock.Lock()
+	direct := c.reads < tagCacheDirectReads
+	if direct {
+		c.reads++
+	}
+	c.lock.Unlock()
+	if direct {
+		return nil, false
+	}
+	c.load.Do(func() {
+		if c.err = c.loadTags(); c.err != nil {
+			log.Printf("[WARN] Error loading the tags of the account from Global Search, the tags are read for each resource: %s", c.err)
+		}
+	})
+	if c.err != nil {
+		return nil, false
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	resource, ok := c.tags[crn]
+	if !ok || c.invalidated[crn] {
+		return nil, false
+	}
+	return resource[tagType], true
+}
+
+// Invalidate stops serving the tags of the resource crn, once the provider attached or detached tags.
+func (c *TagCache) Invalidate(crn string) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.invalidated[crn] = true
+}
+
+// loadTags loads the tags of all the resources of the account, one page at a time.
+func (c *TagCache) loadTags() error {
+	options := &searchv2.SearchOptions{}
+	options.SetQuery("*")
+	options.SetFields([]string{"tags", "access_tags"})
+	options.SetLimit(tagCachePageSize)
+
+	tags := map[string]map[string][]string{}
+	for {
+		result, response, err := c.search(options)
+		if err != nil {
+			return fmt.Errorf("%s\n%s", err, response)
+		}
+		if len(result.Items) == 0 {
+			break
+		}
+		for _, item := range result.Items {
+			if item.CRN == nil {
+				continue
+			}
+			resource := map[string][]string{}
+			for tagType, field := range tagCacheFields {
+				resource[tagType] = searchTags(item.GetProperty(field))
+			}
+			tags[*item.CRN] = resource
+		}
+		if result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	log.Printf("[DEBUG] Loaded the tags of %d resources from Global Search", len(tags))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.tags = tags
+	return nil
+}
+
+// searchTags returns the tags of a Global Search tags field.
+func searchTags(field interface{}) []string {
+	values, _ := field.([]interface{})
+	tags := make([]string, 0, len(values))
+	for _, v := range values {
+		tags = append(tags, fmt.Sprint(v))
+	}
+	return tags
+}
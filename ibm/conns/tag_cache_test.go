@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// fakeSearch serves the resources of the account in pages of pageSize, and counts the calls.
+func fakeSearch(resources int, pageSize int) (func(*searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error), *int) {
+	calls := 0
+	return func(options *searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error) {
+		calls++
+		start := 0
+		if options.SearchCursor != nil {
+			fmt.Sscan(*options.SearchCursor, &start)
+		}
+		result := &searchv2.ScanResult{SearchCursor: core.StringPtr(fmt.Sprint(start + pageSize))}
+		for i := start; i < start+pageSize && i < resources; i++ {
+			item := searchv2.ResultItem{CRN: core.StringPtr(fmt.Sprintf("crn:v1:resource-%d", i))}
+			item.SetProperty("tags", []interface{}{fmt.Sprintf("index:%d", i)})
+			item.SetProperty("access_tags", []interface{}{})
+			result.Items = append(result.Items, item)
+		}
+		return result, nil, nil
+	}, &calls
+}
+
+func TestTagCache(t *testing.T) {
+	search, calls := fakeSearch(25, 10)
+	cache := NewTagCache(search)
+
+	// The first reads go to the tagging API
+	for i := 0; i < tagCacheDirectReads; i++ {
+		if _, ok := cache.Tags("crn:v1:resource-1", "user"); ok {
+			t.Fatalf("expected read %d to go to the tagging API", i)
+		}
+	}
+	if *calls != 0 {
+		t.Fatalf("expected no search before %d reads, got %d", tagCacheDirectReads, *calls)
+	}
+
+	tags, ok := cache.Tags("crn:v1:resource-24", "")
+	if !ok || !reflect.DeepEqual(tags, []string{"index:24"}) {
+		t.Fatalf("unexpected cached tags %v, %t", tags, ok)
+	}
+	if tags, ok := cache.Tags("crn:v1:resource-3", "access"); !ok || len(tags) != 0 {
+		t.Fatalf("unexpected cached access tags %v, %t", tags, ok)
+	}
+	if *calls != 4 {
+		t.Fatalf("expected the account to be loaded once in 4 pages, got %d searches", *calls)
+	}
+
+	for _, crn := range []string{"crn:v1:resource-25", "1234/SoftLayer_Virtual_Guest"} {
+		if _, ok := cache.Tags(crn, "user"); ok {
+			t.Fatalf("expected no cached tags for %s", crn)
+		}
+	}
+	if _, ok := cache.Tags("crn:v1:resource-1", "service"); ok {
+		t.Fatal("expected no cached service tags")
+	}
+	cache.Invalidate("crn:v1:resource-24")
+	if _, ok := cache.Tags("crn:v1:resource-24", "user"); ok {
+		t.Fatal("expected the tags of an invalidated resource to go to the tagging API")
+	}
+	if *calls != 4 {
+		t.Fatalf("expected no further search, got %d searches", *calls)
+	}
+}
+
+func TestTagCacheLoadError(t *testing.T) {
+	calls := 0
+	cache := NewTagCache(func(*searchv2.SearchOptions) (*searchv2.ScanResult, *core.DetailedResponse, error) {
+		calls++
+		return nil, nil, fmt.Errorf("Too Many Requests")
+	})
+	cache.reads = tagCacheDirectReads
+	for i := 0; i < 3; i++ {
+		if _, ok := cache.Tags("crn:v1:resource-1", "user"); ok {
+			t.Fatal("expected no cached tags after a failed load")
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single load attempt, got %d", calls)
+	}
+	var nilCache *TagCache
+	if _, ok := nilCache.Tags("crn:v1:resource-1", "user"); ok {
+		t.Fatal("expected no tags from a nil cache")
+	}
+	nilCache.Invalidate("crn:v1:resource-1")
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"strings"
+)
+
+// TagsConfig holds the default_tags and ignore_tags blocks of the provider.
+type TagsConfig struct {
+	// User and access tags attached to every taggable resource
+	DefaultTags       []string
+	DefaultAccessTags []string
+
+	// Tags applied outside of Terraform which are left alone. A tag is ignored when its key, the
+	// part before the first ':', or the whole tag is in IgnoreTagKeys, or when it starts with one
+	// of IgnoreTagPrefixes.
+	IgnoreTagKeys     []string
+	IgnoreTagPrefixes []string
+}
+
+// Defaults returns the default tags of tagType, "user" or "access". The user tags include the tags
+// of the IC_ENV_TAGS environment variable.
+func (t TagsConfig) Defaults(tagType string) []string {
+	switch strings.TrimSpace(tagType) {
+	case "", "user":
+		tags := append([]string{}, t.DefaultTags...)
+		if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+			tags = append(tags, strings.Split(v, ",")...)
+		}
+		return tags
+	case "access":
+		return t.DefaultAccessTags
+	}
+	return nil
+}
+
+// IsDefault reports whether tag is one of the default tags of tagType.
+func (t TagsConfig) IsDefault(tagType, tag string) bool {
+	for _, v := range t.Defaults(tagType) {
+		if strings.EqualFold(strings.TrimSpace(v), tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ignored reports whether tag matches ignore_tags.
+func (t TagsConfig) Ignored(tag string) bool {
+	key := strings.SplitN(tag, ":", 2)[0]
+	for _, k := range t.IgnoreTagKeys {
+		if strings.EqualFold(k, key) || strings.EqualFold(k, tag) {
+			return true
+		}
+	}
+	for _, prefix := range t.IgnoreTagPrefixes {
+		if strings.HasPrefix(strings.ToLower(tag), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
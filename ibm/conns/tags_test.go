@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagsConfigDefaults(t *testing.T) {
+	t.Setenv("IC_ENV_TAGS", "schematics:workspace")
+	tags := TagsConfig{DefaultTags: []string{"env:dev"}, DefaultAccessTags: []string{"project:blue"}}
+
+	if got := tags.Defaults("user"); !reflect.DeepEqual(got, []string{"env:dev", "schematics:workspace"}) {
+		t.Fatalf("unexpected default user tags %v", got)
+	}
+	if got := tags.Defaults("access"); !reflect.DeepEqual(got, []string{"project:blue"}) {
+		t.Fatalf("unexpected default access tags %v", got)
+	}
+	if got := tags.Defaults("service"); got != nil {
+		t.Fatalf("expected no default service tags, got %v", got)
+	}
+	if !tags.IsDefault("", "ENV:DEV") || tags.IsDefault("access", "env:dev") {
+		t.Fatal("unexpected default tag match")
+	}
+}
+
+func TestTagsConfigIgnored(t *testing.T) {
+	tags := TagsConfig{IgnoreTagKeys: []string{"owner", "managed"}, IgnoreTagPrefixes: []string{"sys-"}}
+	for tag, want := range map[string]bool{
+		"owner:alice":  true,
+		"managed":      true,
+		"sys-backup":   true,
+		"SYS-backup":   true,
+		"env:owner":    false,
+		"owners:alice": false,
+		"team:sys-ops": false,
+	} {
+		if got := tags.Ignored(tag); got != want {
+			t.Errorf("Ignored(%q) = %t, want %t", tag, got, want)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// authTypeTokenManager is the authentication type of the token manager
+const authTypeTokenManager = "tokenManager"
+
+// tokenManager hands out the IAM token of the provider, and requests a new one once 80% of its
+// lifetime has passed so that long running applies never send an expired token. It is shared by
+// all the clients of a session:
+//   - the IBM Cloud SDK clients use it as their authenticator
+//   - the bluemix-go and SoftLayer clients copy the token when they are created; the Transport of
+//     the manager sends their requests with the current token instead
+//
+// A request rejected with a 401 is sent once more with a new token.
+type tokenManager struct {
+	// name describes the identity the tokens are issued for, in logs and errors
+	name    string
+	request func() (*core.IamTokenServerResponse, error)
+
+	lock         sync.Mutex
+	token        string
+	refreshToken string
+	expiration   time.Time
+	refreshAt    time.Time
+	// issued holds every token returned so far, so that the transport can replace a stale one
+	issued map[string]bool
+}
+
+func newTokenManager(name string, request func() (*core.IamTokenServerResponse, error)) *tokenManager {
+	return &tokenManager{name: name, request: request, issued: map[string]bool{}}
+}
+
+// iamTokenManager returns the token manager of the API key or of the refresh token of the provider,
+// or nil when the provider is configured with an IAM token alone, which cannot be renewed.
+func (c *Config) iamTokenManager(iamURL string) *tokenManager {
+	switch {
+	case c.BluemixAPIKey != "":
+		iam := &core.IamAuthenticator{ApiKey: c.BluemixAPIKey, URL: iamURL}
+		recordingAuthenticator(iam)
+		return newTokenManager("the API key", iam.RequestToken)
+	case c.IAMToken != "" && c.IAMRefreshToken != "":
+		iam := &core.IamAuthenticator{RefreshToken: c.IAMRefreshToken, ClientId: "bx", ClientSecret: "bx", URL: iamURL}
+		recordingAuthenticator(iam)
+		return newTokenManager("the IAM refresh token", func() (*core.IamTokenServerResponse, error) {
+			result, err := iam.RequestToken()
+			if err == nil && result.RefreshToken != "" {
+				iam.RefreshToken = result.RefreshToken
+			}
+			return result, err
+		})
+	}
+	return nil
+}
+
+func (m *tokenManager) AuthenticationType() string {
+	return authTypeTokenManager
+}
+
+func (m *tokenManager) Validate() error {
+	return nil
+}
+
+func (m *tokenManager) Authenticate(request *gohttp.Request) error {
+	token, err := m.Token()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns the current token, and requests a new one when it is due for refresh. A failed
+// refresh is only reported once the current token has expired.
+func (m *tokenManager) Token() (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.token != "" && time.Now().Before(m.refreshAt) {
+		return m.token, nil
+	}
+	return m.renew()
+}
+
+// RefreshToken returns the refresh token issued along with the current token, if any.
+func (m *tokenManager) RefreshToken() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.refreshToken
+}
+
+// refresh requests a new token in place of rejected, unless another request already replaced it.
+func (m *tokenManager) refresh(rejected string) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.token != "" && m.token != rejected {
+		return m.token, nil
+	}
+	m.refreshAt = time.Time{}
+	m.expiration = time.Time{}
+	return m.renew()
+}
+
+// renew requests a new token. The lock must be held.
+func (m *tokenManager) renew() (string, error) {
+	now := time.Now()
+	result, err := m.request()
+	if err == nil && result.AccessToken == "" {
+		err = fmt.Errorf("[ERROR] IAM returned no token for %s", m.name)
+	}
+	if err != nil {
+		if m.token != "" && now.Before(m.expiration) {
+			log.Printf("[WARN] Error refreshing the token of %s, the current token is used until it expires: %s", m.name, err)
+			return m.token, nil
+		}
+		return "", err
+	}
+	lifetime := time.Duration(result.ExpiresIn) * time.Second
+	m.token = result.AccessToken
+	if result.RefreshToken != "" {
+		m.refreshToken = result.RefreshToken
+	}
+	m.expiration = now.Add(lifetime)
+	m.refreshAt = now.Add(lifetime * 8 / 10)
+	m.issued[m.token] = true
+	return m.token, nil
+}
+
+// isIssued reports whether the authorization header carries a token of the manager.
+func (m *tokenManager) isIssued(authorization string) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.issued[strings.TrimPrefix(authorization, "Bearer ")]
+}
+
+// bluemixSession authenticates the bluemix-go session with the token of the manager.
+func (m *tokenManager) bluemixSession(sess *bxsession.Session) error {
+	token, err := m.Token()
+	if err != nil {
+		return err
+	}
+	sess.Config.IAMAccessToken = "Bearer " + token
+	sess.Config.IAMRefreshToken = m.RefreshToken()
+	return nil
+}
+
+// Transport wraps base so that the requests authorized with a token of the manager are sent with
+// the current one, and sent once more with a new token when they are rejected with a 401. A nil
+// manager returns base.
+func (m *tokenManager) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if m == nil {
+		return base
+	}
+	if base == nil {
+		base = DefaultTransport()
+	}
+	if t, ok := base.(*tokenManagerTransport); ok && t.tokens == m {
+		return base
+	}
+	return &tokenManagerTransport{tokens: m, base: base}
+}
+
+type tokenManagerTransport struct {
+	tokens *tokenManager
+	base   gohttp.RoundTripper
+}
+
+func (t *tokenManagerTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if !t.tokens.isIssued(req.Header.Get("Authorization")) {
+		return t.base.RoundTrip(req)
+	}
+	token, err := t.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// The body is kept to send the request again after a 401
+	var body []byte
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+	send := func(token string) (*gohttp.Response, error) {
+		r := req.Clone(req.Context())
+		r.Header.Set("Authorization", "Bearer "+token)
+		if body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return t.base.RoundTrip(r)
+	}
+
+	resp, err := send(token)
+	if err != nil || resp.StatusCode != gohttp.StatusUnauthorized {
+		return resp, err
+	}
+	rejected := token
+	if token, err = t.tokens.refresh(rejected); err != nil || token == rejected {
+		return resp, nil
+	}
+	log.Printf("[DEBUG] %s %s was rejected with a 401, sending it again with a new token of %s", req.Method, req.URL, t.tokens.name)
+	resp.Body.Close()
+	return send(token)
+}
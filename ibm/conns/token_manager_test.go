@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// countingTokens issues token-1, token-2... each valid for an hour.
+func countingTokens(issued *int) func() (*core.IamTokenServerResponse, error) {
+	return func() (*core.IamTokenServerResponse, error) {
+		*issued++
+		return &core.IamTokenServerResponse{
+			AccessToken:  fmt.Sprintf("token-%d", *issued),
+			RefreshToken: fmt.Sprintf("refresh-%d", *issued),
+			ExpiresIn:    3600,
+		}, nil
+	}
+}
+
+func TestTokenManagerRefreshBeforeExpiry(t *testing.T) {
+	issued := 0
+	m := newTokenManager("test", countingTokens(&issued))
+	if token, _ := m.Token(); token != "token-1" {
+		t.Fatalf("expected token-1, got %s", token)
+	}
+	if token, _ := m.Token(); token != "token-1" || issued != 1 {
+		t.Fatalf("expected token-1 to be reused, got %s after %d requests", token, issued)
+	}
+	m.refreshAt = time.Now().Add(-time.Second)
+	if token, _ := m.Token(); token != "token-2" || m.RefreshToken() != "refresh-2" {
+		t.Fatalf("expected token-2 once due for refresh, got %s", token)
+	}
+}
+
+func TestTokenManagerRefreshError(t *testing.T) {
+	fail := false
+	m := newTokenManager("test", func() (*core.IamTokenServerResponse, error) {
+		if fail {
+			return nil, fmt.Errorf("IAM is down")
+		}
+		return &core.IamTokenServerResponse{AccessToken: "token-1", ExpiresIn: 3600}, nil
+	})
+	m.Token()
+	fail = true
+	m.refreshAt = time.Now().Add(-time.Second)
+	if token, err := m.Token(); err != nil || token != "token-1" {
+		t.Fatalf("expected the unexpired token to be kept, got %q, %v", token, err)
+	}
+	m.expiration = time.Now().Add(-time.Second)
+	if _, err := m.Token(); err == nil {
+		t.Fatal("expected an error once the token expired")
+	}
+}
+
+func TestTokenManagerTransportRetriesUnauthorized(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	issued := 0
+	m := newTokenManager("test", countingTokens(&issued))
+	token, _ := m.Token()
+	client := (RetryPolicy{tokens: m}).HTTPClient(&http.Client{})
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+	req.Body = ioutil.NopCloser(jsonReader(t, map[string]string{"name": "vpc"}))
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || issued != 2 {
+		t.Fatalf("expected success with a new token, got status %d after %d token requests", resp.StatusCode, issued)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Fatalf("expected the request to be sent again with its body, got %q", bodies)
+	}
+
+	// Requests which do not carry a token of the manager are left alone
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Authorization", "Bearer other")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized || issued != 2 {
+		t.Fatalf("expected a 401 without token refresh, got status %d after %d token requests", resp.StatusCode, issued)
+	}
+}
+
+func TestConfigIAMTokenManager(t *testing.T) {
+	if m := (&Config{IAMToken: "Bearer token"}).iamTokenManager("https://iam"); m != nil {
+		t.Fatal("expected no token manager for an IAM token without refresh token")
+	}
+
+	var refreshTokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  fmt.Sprintf("token-%d", len(refreshTokens)),
+			"refresh_token": fmt.Sprintf("refresh-%d", len(refreshTokens)),
+			"expires_in":    3600,
+			"expiration":    time.Now().Add(time.Hour).Unix(),
+		})
+	}))
+	defer server.Close()
+
+	m := (&Config{IAMToken: "Bearer token", IAMRefreshToken: "refresh-0"}).iamTokenManager(server.URL)
+	m.Token()
+	m.refreshAt = time.Now().Add(-time.Second)
+	m.Token()
+	if len(refreshTokens) != 2 || refreshTokens[0] != "refresh-0" || refreshTokens[1] != "refresh-1" {
+		t.Fatalf("expected the refresh token to be rotated, got %q", refreshTokens)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func jsonReader(t *testing.T, v interface{}) *strings.Reader {
+	body, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.NewReader(string(body))
+}
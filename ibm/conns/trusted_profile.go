@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// Grant types of the IAM token requests made for a trusted profile
+const (
+	grantTypeAssume  = "urn:ibm:params:oauth:grant-type:assume"
+	grantTypeCRToken = "urn:ibm:params:oauth:grant-type:cr-token"
+)
+
+// vpcInstanceMetadataURL is the endpoint of the metadata service of the VPC instances
+const vpcInstanceMetadataURL = "http://169.254.169.254"
+
+// AssumeProfile is the trusted profile the provider operates as, set with the assume_profile block.
+type AssumeProfile struct {
+	// ID or CRN of the trusted profile, one of them is required
+	ProfileID  string
+	ProfileCRN string
+
+	// Account the profile belongs to. When set, the account of the profile token must match.
+	AccountID string
+
+	// File holding the compute resource token exchanged for the profile token. Without it the
+	// credentials of the provider are used to assume the profile.
+	CRTokenFile string
+
+	// VPCInstanceMetadata exchanges the identity token of the VPC instance the provider runs on
+	// for the profile token, through the instance metadata service.
+	VPCInstanceMetadata bool
+}
+
+// Validate checks that the profile is identified by its ID or by its CRN, and that it has at most
+// one compute resource token source.
+func (p *AssumeProfile) Validate() error {
+	if (p.ProfileID == "") == (p.ProfileCRN == "") {
+		return fmt.Errorf("[ERROR] Exactly one of profile_id and profile_crn must be set in assume_profile")
+	}
+	if p.CRTokenFile != "" && p.VPCInstanceMetadata {
+		return fmt.Errorf("[ERROR] A compute resource token file cannot be used along with the VPC instance metadata")
+	}
+	return nil
+}
+
+// computeResource reports whether the profile token is requested with a compute resource token.
+func (p *AssumeProfile) computeResource() bool {
+	return p.CRTokenFile != "" || p.VPCInstanceMetadata
+}
+
+// crTokenProfile returns the trusted profile set with iam_profile_id when the provider authenticates
+// with a compute resource token, or nil.
+func (c *Config) crTokenProfile() *AssumeProfile {
+	if c.IAMTrustedProfileID == "" || c.IAMToken != "" || (c.IAMCRTokenFile == "" && !c.IAMVPCInstanceMetadata) {
+		return nil
+	}
+	profile := &AssumeProfile{
+		CRTokenFile:         c.IAMCRTokenFile,
+		VPCInstanceMetadata: c.IAMVPCInstanceMetadata,
+	}
+	if strings.HasPrefix(c.IAMTrustedProfileID, "crn:") {
+		profile.ProfileCRN = c.IAMTrustedProfileID
+	} else {
+		profile.ProfileID = c.IAMTrustedProfileID
+	}
+	return profile
+}
+
+// name returns the ID or the CRN of the profile.
+func (p *AssumeProfile) name() string {
+	if p.ProfileID != "" {
+		return p.ProfileID
+	}
+	return p.ProfileCRN
+}
+
+// trustedProfileTokens requests the IAM tokens of a trusted profile.
+type trustedProfileTokens struct {
+	profile AssumeProfile
+	url     string
+	client  *gohttp.Client
+
+	// caller returns the access token used to assume the profile. It is not used when the
+	// profile token is requested with a compute resource token.
+	caller func() (string, error)
+
+	// vpcInstance requests the profile token from the VPC instance metadata service
+	vpcInstance *core.VpcInstanceAuthenticator
+}
+
+// trustedProfileTokenManager returns the token manager of a trusted profile. Unless a compute
+// resource token is used, the caller is authenticated with the API key or the IAM token of the provider.
+func (c *Config) trustedProfileTokenManager(profile *AssumeProfile, iamURL string, retryPolicy RetryPolicy) (*tokenManager, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	tokens := &trustedProfileTokens{
+		profile: *profile,
+		url:     strings.TrimSuffix(iamURL, "/") + "/identity/token",
+		client:  retryPolicy.HTTPClient(&gohttp.Client{Transport: DefaultTransport(), Timeout: 30 * time.Second}),
+	}
+	manager := newTokenManager("the trusted profile "+profile.name(), tokens.requestToken)
+	if profile.VPCInstanceMetadata {
+		tokens.vpcInstance = &core.VpcInstanceAuthenticator{
+			IAMProfileID:  profile.ProfileID,
+			IAMProfileCRN: profile.ProfileCRN,
+			URL:           EnvFallBack([]string{"IBMCLOUD_VPC_INSTANCE_METADATA_ENDPOINT"}, vpcInstanceMetadataURL),
+			Client:        tokens.client,
+		}
+	}
+	if profile.computeResource() {
+		return manager, nil
+	}
+
+	if caller := c.iamTokenManager(iamURL); caller != nil {
+		tokens.caller = caller.Token
+	} else if c.IAMToken != "" {
+		token := strings.TrimPrefix(c.IAMToken, "Bearer ")
+		tokens.caller = func() (string, error) { return token, nil }
+	} else {
+		return nil, fmt.Errorf("[ERROR] assume_profile requires ibmcloud_api_key, iam_token or a compute resource token")
+	}
+	return manager, nil
+}
+
+// requestToken requests a profile token from IAM.
+func (t *trustedProfileTokens) requestToken() (*core.IamTokenServerResponse, error) {
+	result, err := t.exchange()
+	if err != nil {
+		return nil, err
+	}
+	if t.profile.AccountID != "" {
+		if account := tokenAccount(result.AccessToken); account != t.profile.AccountID {
+			return nil, fmt.Errorf("[ERROR] The trusted profile %s belongs to the account %q, not to the account %s set in assume_profile", t.profile.name(), account, t.profile.AccountID)
+		}
+	}
+	return result, nil
+}
+
+// exchange exchanges the token of the caller or the compute resource token for a profile token.
+func (t *trustedProfileTokens) exchange() (*core.IamTokenServerResponse, error) {
+	if t.vpcInstance != nil {
+		result, err := t.vpcInstance.RequestToken()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error requesting a token for the trusted profile %s from the VPC instance metadata service: %s", t.profile.name(), err)
+		}
+		return result, nil
+	}
+
+	form := url.Values{}
+	if t.profile.ProfileID != "" {
+		form.Set("profile_id", t.profile.ProfileID)
+	} else {
+		form.Set("profile_crn", t.profile.ProfileCRN)
+	}
+	if t.profile.CRTokenFile != "" {
+		crToken, err := ioutil.ReadFile(t.profile.CRTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading the compute resource token file %s: %s", t.profile.CRTokenFile, err)
+		}
+		form.Set("grant_type", grantTypeCRToken)
+		form.Set("cr_token", strings.TrimSpace(string(crToken)))
+	} else {
+		callerToken, err := t.caller()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error authenticating before assuming the trusted profile %s: %s", t.profile.name(), err)
+		}
+		form.Set("grant_type", grantTypeAssume)
+		form.Set("access_token", callerToken)
+	}
+
+	req, err := gohttp.NewRequest(gohttp.MethodPost, t.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error requesting a token for the trusted profile %s: %s", t.profile.name(), err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("[ERROR] Error requesting a token for the trusted profile %s: %s\n%s", t.profile.name(), resp.Status, body)
+	}
+	result := &core.IamTokenServerResponse{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the token of the trusted profile %s: %s", t.profile.name(), err)
+	}
+	return result, nil
+}
+
+// tokenAccount returns the ID of the account an IAM token was issued for, or "".
+func tokenAccount(token string) string {
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return ""
+	}
+	account, _ := parsed.Claims.(jwt.MapClaims)["account"].(map[string]interface{})
+	bss, _ := account["bss"].(string)
+	return bss
+}
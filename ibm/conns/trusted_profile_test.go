@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// fakeIAM serves profile tokens for the account, each valid for expiresIn seconds.
+func fakeIAM(t *testing.T, account string, expiresIn int64) (*httptest.Server, *[]http.Request) {
+	var requests []http.Request
+	issued := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, *r)
+		issued++
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"id":      fmt.Sprintf("token-%d", issued),
+			"account": map[string]interface{}{"bss": account},
+		}).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": token, "expires_in": expiresIn})
+	}))
+	return server, &requests
+}
+
+func TestTrustedProfileTokenManagerAssume(t *testing.T) {
+	server, requests := fakeIAM(t, "child-account", 3600)
+	defer server.Close()
+
+	c := &Config{IAMToken: "Bearer caller-token", AssumeProfile: &AssumeProfile{ProfileID: "Profile-1", AccountID: "child-account"}}
+	a, err := c.trustedProfileTokenManager(c.AssumeProfile, server.URL, RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := a.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := a.Token(); again != token || len(*requests) != 1 {
+		t.Fatalf("expected the token to be reused, got %d token requests", len(*requests))
+	}
+	form := (*requests)[0].PostForm
+	if form.Get("grant_type") != grantTypeAssume || form.Get("access_token") != "caller-token" || form.Get("profile_id") != "Profile-1" {
+		t.Fatalf("unexpected token request %v", form)
+	}
+}
+
+func TestTrustedProfileTokenManagerRefresh(t *testing.T) {
+	server, requests := fakeIAM(t, "child-account", 3600)
+	defer server.Close()
+
+	crTokenFile := filepath.Join(t.TempDir(), "cr-token")
+	if err := ioutil.WriteFile(crTokenFile, []byte("cr-token-1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c := &Config{AssumeProfile: &AssumeProfile{ProfileCRN: "crn:v1:profile", CRTokenFile: crTokenFile}}
+	a, err := c.trustedProfileTokenManager(c.AssumeProfile, server.URL, RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := a.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A request sent with the first token goes out with the refreshed one
+	if err := ioutil.WriteFile(crTokenFile, []byte("cr-token-2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	a.refreshAt = time.Now().Add(-time.Second)
+	var sent string
+	transport := a.Transport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("Authorization", "Bearer "+first)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	second, _ := a.Token()
+	if second == first || sent != "Bearer "+second {
+		t.Fatalf("expected the request to be sent with the refreshed token, got %q", sent)
+	}
+	for i, want := range []string{"cr-token-1", "cr-token-2"} {
+		form := (*requests)[i].PostForm
+		if form.Get("grant_type") != grantTypeCRToken || form.Get("cr_token") != want || form.Get("profile_crn") != "crn:v1:profile" {
+			t.Fatalf("unexpected token request %d: %v", i, form)
+		}
+	}
+}
+
+func TestTrustedProfileTokenManagerAccountMismatch(t *testing.T) {
+	server, _ := fakeIAM(t, "other-account", 3600)
+	defer server.Close()
+
+	c := &Config{IAMToken: "caller-token", AssumeProfile: &AssumeProfile{ProfileID: "Profile-1", AccountID: "child-account"}}
+	a, err := c.trustedProfileTokenManager(c.AssumeProfile, server.URL, RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Token(); err == nil {
+		t.Fatal("expected an error for a profile of another account")
+	}
+}
+
+func TestAssumeProfileValidate(t *testing.T) {
+	for _, profile := range []AssumeProfile{{}, {ProfileID: "Profile-1", ProfileCRN: "crn:v1:profile"}} {
+		if err := profile.Validate(); err == nil {
+			t.Fatalf("expected an error for %+v", profile)
+		}
+	}
+	if _, err := (&Config{}).trustedProfileTokenManager(&AssumeProfile{ProfileID: "Profile-1"}, "https://iam", RetryPolicy{}); err == nil {
+		t.Fatal("expected an error without credentials to assume the profile")
+	}
+}
+
+func TestConfigCRTokenProfile(t *testing.T) {
+	if profile := (&Config{IAMTrustedProfileID: "Profile-1", IAMToken: "Bearer token"}).crTokenProfile(); profile != nil {
+		t.Fatalf("expected no compute resource profile with an IAM token, got %+v", profile)
+	}
+	profile := (&Config{IAMTrustedProfileID: "crn:v1:profile", IAMCRTokenFile: "/var/run/secrets/tokens/sa-token"}).crTokenProfile()
+	if profile == nil || profile.ProfileCRN != "crn:v1:profile" || profile.CRTokenFile != "/var/run/secrets/tokens/sa-token" {
+		t.Fatalf("unexpected compute resource profile %+v", profile)
+	}
+}
+
+func TestTrustedProfileTokenManagerVPCInstanceMetadata(t *testing.T) {
+	var trustedProfile string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/instance_identity/v1/token":
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "instance-identity-token"})
+		case "/instance_identity/v1/iam_token":
+			body, _ := ioutil.ReadAll(r.Body)
+			trustedProfile = string(body)
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "profile-token", "expires_in": 3600, "expires_at": time.Now().Add(time.Hour).Format(time.RFC3339)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("IBMCLOUD_VPC_INSTANCE_METADATA_ENDPOINT", server.URL)
+
+	c := &Config{IAMTrustedProfileID: "Profile-1", IAMVPCInstanceMetadata: true}
+	a, err := c.trustedProfileTokenManager(c.crTokenProfile(), "https://iam", RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token, err := a.Token(); err != nil || token != "profile-token" {
+		t.Fatalf("expected the profile token, got %q, %v", token, err)
+	}
+	if trustedProfile != `{"trusted_profile": {"id": "Profile-1"}}` {
+		t.Fatalf("unexpected IAM token request %s", trustedProfile)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package discover finds the resources of an account and generates the import blocks and the
+// skeleton configuration which bring them under Terraform.
+package discover
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// searchPageSize is the number of resources loaded per Global Search call, the maximum allowed
+const searchPageSize = 1000
+
+// Resource is a resource of the account.
+type Resource struct {
+	CRN             string
+	Name            string
+	ResourceGroupID string
+	// Location is the region or the zone of the resource
+	Location string
+	// Service and Plan are the catalog names of the service and of the plan of a resource instance
+	Service string
+	Plan    string
+	// Instance is true for the resource instances of the Resource Controller
+	Instance bool
+}
+
+// Main runs the discover command of the provider binary, with the arguments following "discover".
+// The provider is configured from the same environment variables as in Terraform, IC_API_KEY and
+// IC_REGION among others.
+func Main(args []string) error {
+	flags := flag.NewFlagSet("discover", flag.ContinueOnError)
+	region := flags.String("region", "", "Region of the regional resources, defaults to IC_REGION or us-south")
+	resourceGroup := flags.String("resource-group", "", "ID of the resource group of the resources, all the resource groups by default")
+	query := flags.String("query", "*", "Global Search query selecting the resources")
+	out := flags.String("out", "", "File the configuration is written to, the standard output by default")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-ibm discover [options]\n\n"+
+			"Writes the import blocks and the skeleton configuration of the resources of the account.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	p := provider.Provider()
+	config := map[string]interface{}{}
+	if *region != "" {
+		config["region"] = *region
+	}
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		for _, d := range diags {
+			if d.Summary != "" {
+				return fmt.Errorf("[ERROR] Error configuring the provider: %s", d.Summary)
+			}
+		}
+		return fmt.Errorf("[ERROR] Error configuring the provider")
+	}
+	sess := p.Meta().(conns.ClientSession)
+	bxSession, err := sess.BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	resources, err := Discover(sess, *query)
+	if err != nil {
+		return err
+	}
+	if *resourceGroup != "" {
+		filtered := resources[:0]
+		for _, r := range resources {
+			if r.ResourceGroupID == *resourceGroup {
+				filtered = append(filtered, r)
+			}
+		}
+		resources = filtered
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	g := &Generator{Resources: p.ResourcesMap, Region: bxSession.Config.Region}
+	if err := g.Generate(w, resources); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Generated %d resources, %d resources of the account are not supported", g.Generated, g.Skipped)
+	return nil
+}
+
+// Discover returns the resources of the account selected by the Global Search query. The
+// resource instances listed by the Resource Controller are added to the resources found by
+// Global Search, which is eventually consistent, with the names of their service and plan.
+func Discover(sess conns.ClientSession, query string) ([]Resource, error) {
+	resources := map[string]Resource{}
+	if err := searchResources(sess, query, resources); err != nil {
+		return nil, err
+	}
+	if err := listResourceInstances(sess, resources); err != nil {
+		return nil, err
+	}
+
+	result := make([]Resource, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CRN < result[j].CRN })
+	return result, nil
+}
+
+// searchResources adds the resources found by Global Search to resources, by CRN.
+func searchResources(sess conns.ClientSession, query string, resources map[string]Resource) error {
+	client, err := sess.GlobalSearchAPIV2()
+	if err != nil {
+		return err
+	}
+	options := &searchv2.SearchOptions{}
+	options.SetQuery(query)
+	options.SetFields([]string{"crn", "name", "resource_group_id"})
+	options.SetLimit(searchPageSize)
+	for {
+		result, response, err := client.Search(options)
+		if err != nil {
+			return flex.NewAPIError(err, response).Summary("Error searching the resources of the account").Operation("Search")
+		}
+		if len(result.Items) == 0 {
+			break
+		}
+		for _, item := range result.Items {
+			if item.CRN == nil {
+				continue
+			}
+			c := parseCRN(*item.CRN)
+			resources[*item.CRN] = Resource{
+				CRN:             *item.CRN,
+				Name:            searchProperty(item, "name"),
+				ResourceGroupID: searchProperty(item, "resource_group_id"),
+				Location:        c.location,
+			}
+		}
+		if result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	return nil
+}
+
+func searchProperty(item searchv2.ResultItem, name string) string {
+	if v, ok := item.GetProperty(name).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// listResourceInstances adds the resource instances of the Resource Controller to resources, by CRN.
+func listResourceInstances(sess conns.ClientSession, resources map[string]Resource) error {
+	rsConClient, err := sess.ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	rsCatClient, err := sess.ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	services := map[string]string{}
+	plans := map[string]string{}
+	catalogName := func(names map[string]string, id *string, get func(string) (string, error)) string {
+		if id == nil {
+			return ""
+		}
+		if name, ok := names[*id]; ok {
+			return name
+		}
+		name, err := get(*id)
+		if err != nil {
+			log.Printf("[WARN] Error retrieving the catalog name of %s: %s", *id, err)
+		}
+		names[*id] = name
+		return name
+	}
+
+	options := rc.ListResourceInstancesOptions{}
+	for {
+		list, resp, err := rsConClient.ListResourceInstances(&options)
+		if err != nil {
+			return flex.NewAPIError(err, resp).Summary("Error listing the resource instances of the account").Operation("ListResourceInstances")
+		}
+		for _, instance := range list.Resources {
+			if instance.CRN == nil {
+				continue
+			}
+			r := resources[*instance.CRN]
+			r.CRN = *instance.CRN
+			r.Instance = true
+			if instance.Name != nil {
+				r.Name = *instance.Name
+			}
+			if instance.ResourceGroupID != nil {
+				r.ResourceGroupID = *instance.ResourceGroupID
+			}
+			if instance.RegionID != nil {
+				r.Location = *instance.RegionID
+			}
+			r.Service = catalogName(services, instance.ResourceID, rsCatRepo.GetServiceName)
+			r.Plan = catalogName(plans, instance.ResourcePlanID, rsCatRepo.GetServicePlanName)
+			resources[*instance.CRN] = r
+		}
+		next := ""
+		if list.NextURL != nil {
+			next, err = startParameter(*list.NextURL)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error listing the resource instances of the account: %s", err)
+			}
+		}
+		if next == "" {
+			break
+		}
+		options.Start = &next
+	}
+	return nil
+}
+
+// startParameter returns the start of the next page of a Resource Controller list.
+func startParameter(nextURL string) (string, error) {
+	u, err := url.Parse(nextURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	if start := q.Get("start"); start != "" {
+		return start, nil
+	}
+	return q.Get("next_url"), nil
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package discover
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// crn holds the segments of a CRN,
+// crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>
+type crn struct {
+	serviceName     string
+	location        string
+	serviceInstance string
+	resourceType    string
+	resource        string
+}
+
+func parseCRN(s string) crn {
+	parts := strings.SplitN(s, ":", 10)
+	if len(parts) != 10 || parts[0] != "crn" {
+		return crn{}
+	}
+	return crn{
+		serviceName:     parts[4],
+		location:        parts[5],
+		serviceInstance: parts[7],
+		resourceType:    parts[8],
+		resource:        parts[9],
+	}
+}
+
+// resourceType is the resource type of the provider a resource of the account is imported as.
+type resourceType struct {
+	name string
+	// regional resources are imported with the provider region only
+	regional bool
+	// importID returns the import ID of the resource
+	importID func(r Resource, c crn) string
+}
+
+// resourceID imports the resources by the resource segment of their CRN
+func resourceID(r Resource, c crn) string { return c.resource }
+
+// crnID imports the resources by CRN
+func crnID(r Resource, c crn) string { return r.CRN }
+
+// vpcResourceTypes maps the resource types of the CRNs of the VPC resources, service name "is",
+// to the resource types of the provider.
+var vpcResourceTypes = map[string]string{
+	"vpc":                  "ibm_is_vpc",
+	"subnet":               "ibm_is_subnet",
+	"instance":             "ibm_is_instance",
+	"instance-template":    "ibm_is_instance_template",
+	"instance-group":       "ibm_is_instance_group",
+	"security-group":       "ibm_is_security_group",
+	"network-acl":          "ibm_is_network_acl",
+	"volume":               "ibm_is_volume",
+	"snapshot":             "ibm_is_snapshot",
+	"image":                "ibm_is_image",
+	"key":                  "ibm_is_ssh_key",
+	"floating-ip":          "ibm_is_floating_ip",
+	"public-gateway":       "ibm_is_public_gateway",
+	"load-balancer":        "ibm_is_lb",
+	"vpn":                  "ibm_is_vpn_gateway",
+	"endpoint-gateway":     "ibm_is_virtual_endpoint_gateway",
+	"flow-log-collector":   "ibm_is_flow_log",
+	"placement-group":      "ibm_is_placement_group",
+	"dedicated-host":       "ibm_is_dedicated_host",
+	"dedicated-host-group": "ibm_is_dedicated_host_group",
+	"bare-metal-server":    "ibm_is_bare_metal_server",
+	"backup-policy":        "ibm_is_backup_policy",
+}
+
+// databaseServices are the services of the resource instances imported as ibm_database
+var databaseServices = []string{
+	"databases-for-cassandra",
+	"databases-for-elasticsearch",
+	"databases-for-enterprisedb",
+	"databases-for-etcd",
+	"databases-for-mongodb",
+	"databases-for-mysql",
+	"databases-for-postgresql",
+	"databases-for-redis",
+	"messages-for-rabbitmq",
+}
+
+// lookupResourceType returns the resource type of the provider r is imported as, ok is false when
+// the resource is not supported.
+func lookupResourceType(r Resource) (t resourceType, c crn, ok bool) {
+	c = parseCRN(r.CRN)
+	if c.serviceName == "is" {
+		if name, ok := vpcResourceTypes[c.resourceType]; ok && c.resource != "" {
+			return resourceType{name: name, regional: true, importID: resourceID}, c, true
+		}
+		return resourceType{}, c, false
+	}
+	if r.Instance {
+		for _, service := range databaseServices {
+			if c.serviceName == service {
+				return resourceType{name: "ibm_database", importID: crnID}, c, true
+			}
+		}
+		return resourceType{name: "ibm_resource_instance", importID: crnID}, c, true
+	}
+	return resourceType{}, c, false
+}
+
+// Generator writes the import blocks and the skeleton configuration of resources.
+type Generator struct {
+	// Resources are the resource types of the provider
+	Resources map[string]*schema.Resource
+	// Region of the provider, the regional resources of the other regions are skipped
+	Region string
+
+	// Generated and Skipped count the resources generated and the resources not supported
+	Generated int
+	Skipped   int
+
+	names map[string]bool
+}
+
+// Generate writes the import blocks and the resource blocks of resources to w. The arguments
+// which are not known from the resources are left as comments for the configuration to be
+// completed before the plan.
+func (g *Generator) Generate(w io.Writer, resources []Resource) error {
+	g.names = map[string]bool{}
+	for _, r := range resources {
+		t, c, ok := lookupResourceType(r)
+		resource, registered := g.Resources[t.name]
+		if !ok || !registered {
+			log.Printf("[DEBUG] Skipping %s, the resource is not supported", r.CRN)
+			g.Skipped++
+			continue
+		}
+		if t.regional && g.Region != "" && !strings.HasPrefix(c.location, g.Region) {
+			log.Printf("[DEBUG] Skipping %s, the resource is in %s", r.CRN, c.location)
+			g.Skipped++
+			continue
+		}
+		name := g.resourceName(t.name, r)
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", t.name, name, hclString(t.importID(r, c))); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, resourceBlock(t.name, name, resource, r)); err != nil {
+			return err
+		}
+		g.Generated++
+	}
+	return nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// resourceName returns a name unique for the resource type, derived from the name of r.
+func (g *Generator) resourceName(resourceType string, r Resource) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(r.Name), "_"), "_")
+	if name == "" {
+		name = "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' || name[0] == '-' {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; g.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType+"."+unique] = true
+	return unique
+}
+
+var zoneLocation = regexp.MustCompile(`^[a-z]+-[a-z]+-[0-9]+$`)
+
+// resourceBlock returns the resource block of r, setting the arguments known from r.
+func resourceBlock(resourceType, name string, resource *schema.Resource, r Resource) string {
+	values := map[string]string{
+		"name":              r.Name,
+		"resource_group":    r.ResourceGroupID,
+		"resource_group_id": r.ResourceGroupID,
+		"service":           r.Service,
+		"plan":              r.Plan,
+	}
+	if r.Instance {
+		values["location"] = r.Location
+	} else if zoneLocation.MatchString(r.Location) {
+		values["zone"] = r.Location
+	}
+
+	var keys, required []string
+	for k, s := range resource.Schema {
+		if !s.Required && !s.Optional {
+			continue
+		}
+		if _, ok := values[k]; ok && values[k] != "" && s.Type == schema.TypeString {
+			keys = append(keys, k)
+		} else if s.Required {
+			required = append(required, k)
+		}
+	}
+	sort.Strings(keys)
+	sort.Strings(required)
+
+	width := 0
+	for _, k := range keys {
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource %q %q {\n", resourceType, name)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "  %-*s = %s\n", width, k, hclString(values[k]))
+	}
+	for _, k := range required {
+		fmt.Fprintf(&b, "  # TODO: %s is required\n", k)
+	}
+	b.WriteString("}\n\n")
+	return b.String()
+}
+
+// hclString returns s as a quoted HCL string, escaping the template sequences.
+func hclString(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	return strings.ReplaceAll(s, "%{", "%%{")
+}
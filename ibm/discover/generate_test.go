@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package discover
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+func TestResourceTypesRegistered(t *testing.T) {
+	resources := provider.Provider().ResourcesMap
+	names := []string{"ibm_database", "ibm_resource_instance"}
+	for _, name := range vpcResourceTypes {
+		names = append(names, name)
+	}
+	for _, name := range names {
+		if _, ok := resources[name]; !ok {
+			t.Errorf("%s is not a resource type of the provider", name)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	g := &Generator{Resources: provider.Provider().ResourcesMap, Region: "us-south"}
+	resources := []Resource{
+		{
+			CRN:             "crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1111",
+			Name:            "my-vpc",
+			ResourceGroupID: "rg1",
+			Location:        "us-south",
+		},
+		{
+			CRN:             "crn:v1:bluemix:public:is:us-south-1:a/1234::subnet:0717-2222",
+			Name:            "My Subnet",
+			ResourceGroupID: "rg1",
+			Location:        "us-south-1",
+		},
+		{
+			CRN:      "crn:v1:bluemix:public:is:eu-de:a/1234::vpc:r010-3333",
+			Name:     "my-vpc",
+			Location: "eu-de",
+		},
+		{
+			CRN:             "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1234:4444::",
+			Name:            "my-vpc",
+			ResourceGroupID: "rg1",
+			Location:        "us-south",
+			Service:         "databases-for-postgresql",
+			Plan:            "standard",
+			Instance:        true,
+		},
+		{
+			CRN:             "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5555::",
+			Name:            "${cos}",
+			ResourceGroupID: "rg1",
+			Location:        "global",
+			Service:         "cloud-object-storage",
+			Plan:            "standard",
+			Instance:        true,
+		},
+		{
+			CRN:  "crn:v1:bluemix:public:iam-groups:global:a/1234::access-group:AccessGroupId-6666",
+			Name: "admins",
+		},
+	}
+
+	var b strings.Builder
+	if err := g.Generate(&b, resources); err != nil {
+		t.Fatal(err)
+	}
+	if g.Generated != 4 || g.Skipped != 2 {
+		t.Fatalf("expected 4 generated and 2 skipped resources, got %d and %d", g.Generated, g.Skipped)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"import {\n  to = ibm_is_vpc.my-vpc\n  id = \"r006-1111\"\n}\n",
+		"resource \"ibm_is_vpc\" \"my-vpc\" {\n  name           = \"my-vpc\"\n  resource_group = \"rg1\"\n}\n",
+		"import {\n  to = ibm_is_subnet.my_subnet\n  id = \"0717-2222\"\n}\n",
+		"  zone           = \"us-south-1\"\n",
+		"  # TODO: vpc is required\n",
+		"import {\n  to = ibm_database.my-vpc\n  id = \"crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1234:4444::\"\n}\n",
+		"  location          = \"us-south\"\n",
+		"  plan              = \"standard\"\n",
+		"  service           = \"databases-for-postgresql\"\n",
+		"resource \"ibm_resource_instance\" \"cos\" {\n",
+		"  name              = \"$${cos}\"\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the configuration to contain %q, got\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "r010-3333") {
+		t.Errorf("expected the VPC of another region to be skipped, got\n%s", out)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	g := &Generator{names: map[string]bool{}}
+	for _, tc := range []struct{ name, want string }{
+		{"web-server", "web-server"},
+		{"web-server", "web-server_2"},
+		{"Web Server!", "web_server"},
+		{"1st", "r_1st"},
+		{"", "resource"},
+	} {
+		if got := g.resourceName("ibm_is_instance", Resource{Name: tc.name}); got != tc.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
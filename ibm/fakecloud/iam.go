@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// tokenLifetime is the lifetime of the tokens of the fake
+const tokenLifetime = time.Hour
+
+// serveIAMToken exchanges APIKey, or any refresh token it issued, for an access token.
+func (s *Server) serveIAMToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, nil)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, iamError("BXNIM0109E", err.Error()))
+		return
+	}
+	switch r.PostForm.Get("grant_type") {
+	case "urn:ibm:params:oauth:grant-type:apikey":
+		if r.PostForm.Get("apikey") != APIKey {
+			writeJSON(w, http.StatusBadRequest, iamError("BXNIM0415E", "Provided API key could not be found."))
+			return
+		}
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != "fake-refresh-token" {
+			writeJSON(w, http.StatusBadRequest, iamError("BXNIM0407E", "Refresh token is not valid."))
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, iamError("BXNIM0109E", "Property missing or empty. Property name: grant_type."))
+		return
+	}
+
+	issued := time.Now()
+	expiration := issued.Add(tokenLifetime)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken(issued, expiration),
+		"refresh_token": "fake-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    int64(tokenLifetime / time.Second),
+		"expiration":    expiration.Unix(),
+	})
+}
+
+// accessToken returns an unsigned JWT with the claims read by the provider.
+func accessToken(issued, expiration time.Time) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iam_id":  "IBMid-fake",
+		"id":      "IBMid-fake",
+		"sub":     "fake@example.com",
+		"email":   "fake@example.com",
+		"account": map[string]string{"bss": AccountID},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"iat":     issued.Unix(),
+		"exp":     expiration.Unix(),
+	})
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode(header) + "." + encode(claims) + "." + encode([]byte("fake-signature"))
+}
+
+func iamError(code, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"errorCode":    code,
+		"errorMessage": message,
+		"context":      map[string]string{"requestId": "fake"},
+	}
+}
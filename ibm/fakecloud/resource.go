@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Apply plans and applies config to the resource r in state, as terraform apply would, and
+// returns the new state. A nil state creates the resource and a nil config destroys it. The test
+// fails on any error.
+func Apply(t testing.TB, r *schema.Resource, meta interface{}, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+	ctx := context.Background()
+	var diff *terraform.InstanceDiff
+	if config == nil {
+		diff = &terraform.InstanceDiff{Destroy: true}
+	} else {
+		var err error
+		diff, err = Plan(t, r, meta, state, config)
+		if err != nil {
+			t.Fatalf("Error planning the resource: %s", err)
+		}
+		if diff == nil {
+			return state
+		}
+	}
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	failOnDiags(t, "applying", diags)
+	return newState
+}
+
+// Plan plans the changes of the resource r in state to match config, as terraform plan would, and
+// returns the diff, nil when there is no change. A nil state plans the creation of the resource.
+func Plan(t testing.TB, r *schema.Resource, meta interface{}, state *terraform.InstanceState, config map[string]interface{}) (*terraform.InstanceDiff, error) {
+	t.Helper()
+	attributes := map[string]string{}
+	flattenConfig(attributes, "", config)
+	block := r.CoreConfigSchema()
+	configVal, err := (&terraform.InstanceState{Attributes: attributes}).AttrsAsObjectValue(block.ImpliedType())
+	if err != nil {
+		t.Fatalf("Error reading the configuration: %s", err)
+	}
+	// the raw configuration, read by diff.GetRawConfig, is passed along with the prior state
+	priorState := &terraform.InstanceState{}
+	if state != nil {
+		priorState = state.DeepCopy()
+	}
+	priorState.RawConfig = configVal
+	return r.Diff(context.Background(), priorState, terraform.NewResourceConfigShimmed(configVal, block), meta)
+}
+
+// flattenConfig adds the value v of the attribute key to attributes, in the flatmap format of the
+// state.
+func flattenConfig(attributes map[string]string, key string, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if key != "" {
+			attributes[key+".%"] = strconv.Itoa(len(v))
+			key += "."
+		}
+		for k, e := range v {
+			flattenConfig(attributes, key+k, e)
+		}
+	case []interface{}:
+		attributes[key+".#"] = strconv.Itoa(len(v))
+		for i, e := range v {
+			flattenConfig(attributes, key+"."+strconv.Itoa(i), e)
+		}
+	case []string:
+		attributes[key+".#"] = strconv.Itoa(len(v))
+		for i, e := range v {
+			attributes[key+"."+strconv.Itoa(i)] = e
+		}
+	default:
+		attributes[key] = fmt.Sprint(v)
+	}
+}
+
+// Refresh reads the resource r in state, as terraform refresh would, and returns the new state,
+// nil when the resource is gone. The test fails on any error.
+func Refresh(t testing.TB, r *schema.Resource, meta interface{}, state *terraform.InstanceState) *terraform.InstanceState {
+	t.Helper()
+	newState, diags := r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	failOnDiags(t, "refreshing", diags)
+	return newState
+}
+
+// Import imports the resource r with id, as terraform import would, and returns the state of the
+// first resource imported. The test fails on any error.
+func Import(t testing.TB, r *schema.Resource, meta interface{}, id string) *terraform.InstanceState {
+	t.Helper()
+	if r.Importer == nil {
+		t.Fatal("The resource does not support import")
+	}
+	d := r.Data(nil)
+	d.SetId(id)
+	var imported []*schema.ResourceData
+	var err error
+	switch {
+	case r.Importer.StateContext != nil:
+		imported, err = r.Importer.StateContext(context.Background(), d, meta)
+	case r.Importer.State != nil:
+		imported, err = r.Importer.State(d, meta)
+	default:
+		imported = []*schema.ResourceData{d}
+	}
+	if err != nil {
+		t.Fatalf("Error importing the resource: %s", err)
+	}
+	if len(imported) == 0 {
+		t.Fatal("No resource imported")
+	}
+	return Refresh(t, r, meta, imported[0].State())
+}
+
+func failOnDiags(t testing.TB, action string, diags diag.Diagnostics) {
+	t.Helper()
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			t.Fatalf("Error %s the resource: %s: %s", action, d.Summary, d.Detail)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const resourceInstancesPath = "/v2/resource_instances"
+
+// serveResourceInstances serves the resource instances of the Resource Controller API. The ID of
+// an instance is its CRN. A deleted instance is kept in the "removed" state, as in the API.
+func (s *Server) serveResourceInstances(w http.ResponseWriter, r *http.Request) {
+	// The ID is an escaped CRN, which holds "/"
+	id, err := url.PathUnescape(strings.TrimPrefix(strings.TrimPrefix(r.URL.EscapedPath(), resourceInstancesPath), "/"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, rcError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if id == "" {
+		switch r.Method {
+		case http.MethodPost:
+			body, err := readJSON(r)
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, rcError(http.StatusBadRequest, err.Error()))
+				return
+			}
+			writeJSON(w, http.StatusCreated, s.createResourceInstance(body))
+		case http.MethodGet:
+			resources := []interface{}{}
+			name := r.URL.Query().Get("name")
+			for _, p := range s.order {
+				obj, ok := s.objects[p]
+				if ok && strings.HasPrefix(p, resourceInstancesPath+"/") && obj["state"] != "removed" && (name == "" || obj["name"] == name) {
+					resources = append(resources, obj)
+				}
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"rows_count": len(resources),
+				"next_url":   nil,
+				"resources":  resources,
+			})
+		default:
+			writeJSON(w, http.StatusMethodNotAllowed, rcError(http.StatusMethodNotAllowed, r.Method))
+		}
+		return
+	}
+
+	obj, ok := s.objects[resourceInstancesPath+"/"+id]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, rcError(http.StatusNotFound, fmt.Sprintf("Instance %s not found", id)))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodPatch:
+		patch, err := readJSON(r)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, rcError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		for k, v := range patch {
+			obj[k] = v
+		}
+		obj["updated_at"] = now()
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodDelete:
+		obj["state"] = "removed"
+		obj["deleted_at"] = now()
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, rcError(http.StatusMethodNotAllowed, r.Method))
+	}
+}
+
+// createResourceInstance creates an active resource instance from body. The service name of the
+// CRN is the resource_id of the instance.
+func (s *Server) createResourceInstance(body map[string]interface{}) map[string]interface{} {
+	guid := s.newID("guid")
+	service, _ := body["resource_id"].(string)
+	if service == "" {
+		service = "fake-service"
+	}
+	target, _ := body["target"].(string)
+	if target == "" {
+		target = Region
+	}
+	crn := fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", service, target, AccountID, guid)
+	obj := copyObject(body)
+	obj["id"] = crn
+	obj["guid"] = guid
+	obj["crn"] = crn
+	obj["url"] = resourceInstancesPath + "/" + url.PathEscape(crn)
+	obj["account_id"] = AccountID
+	obj["region_id"] = target
+	obj["state"] = "active"
+	obj["type"] = "service_instance"
+	obj["created_at"] = now()
+	if group, ok := body["resource_group"].(string); ok && group != "" {
+		obj["resource_group_id"] = group
+	} else {
+		obj["resource_group_id"] = ResourceGroupID
+	}
+	s.objects[resourceInstancesPath+"/"+crn] = obj
+	s.order = append(s.order, resourceInstancesPath+"/"+crn)
+	return obj
+}
+
+func rcError(status int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"message":     message,
+		"status_code": status,
+		"error_code":  fmt.Sprintf("RC-%d", status),
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package fakecloud is a fake of the IBM Cloud APIs for the unit tests of the resources. The fake
+// serves the IAM token exchange and a minimal VPC, Resource Controller, Global Tagging and Global
+// Search API from memory, and the provider is pointed at it with an endpoints file, so that the
+// CRUD logic of the resources can be tested without an account:
+//
+//	cloud := fakecloud.NewServer(t)
+//	meta := cloud.Meta(t)
+//	state := fakecloud.Apply(t, vpc.ResourceIBMISSSHKey(), meta, nil, map[string]interface{}{...})
+//
+// The fake keeps the objects created through the APIs as they were sent, with the fields the
+// APIs compute, such as the ID and the CRN, added. It does not validate the requests beyond what
+// is needed to serve them.
+package fakecloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const (
+	// AccountID is the account of the fake
+	AccountID = "fakeaccount0000000000000000000000"
+	// APIKey is the API key accepted by the IAM token exchange of the fake
+	APIKey = "fake-api-key"
+	// Region is the region of the fake
+	Region = "us-south"
+	// ResourceGroupID is the default resource group of the account
+	ResourceGroupID = "fakedefaultresourcegroup00000000"
+)
+
+// endpointKeys are the endpoints file keys of the services served by the fake, and the paths
+// they are served at.
+var endpointKeys = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT":                 "",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":               "/v1",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "",
+	"IBMCLOUD_GT_API_ENDPOINT":                  "",
+	"IBMCLOUD_GS_API_ENDPOINT":                  "",
+}
+
+// Server is a fake of the IBM Cloud APIs, listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	lock sync.Mutex
+	// objects are the VPC objects and the resource instances by path, such as /v1/vpcs/<id>
+	objects map[string]map[string]interface{}
+	// order is the order the objects were created in, for the lists
+	order []string
+	// tags are the tags by CRN and by tag type
+	tags map[string]map[string][]string
+	// requests are the requests served, as "<method> <path>"
+	requests []string
+	// ids counts the IDs generated
+	ids int
+}
+
+// NewServer starts a fake, closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		objects: map[string]map[string]interface{}{},
+		tags:    map[string]map[string][]string{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/identity/token", s.serveIAMToken)
+	mux.HandleFunc("/v1/", s.serveVPC)
+	mux.HandleFunc("/v2/resource_instances", s.serveResourceInstances)
+	mux.HandleFunc("/v2/resource_instances/", s.serveResourceInstances)
+	mux.HandleFunc("/v3/tags", s.serveTags)
+	mux.HandleFunc("/v3/tags/", s.serveTags)
+	mux.HandleFunc("/v3/resources/search", s.serveSearch)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		s.lock.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Requests returns the requests served, as "<method> <path>".
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// EndpointsFile writes an endpoints file pointing the services served by the fake at it, for the
+// public visibility and the region of the fake, and returns its path.
+func (s *Server) EndpointsFile(t testing.TB) string {
+	file := map[string]map[string]map[string]string{}
+	for key, path := range endpointKeys {
+		file[key] = map[string]map[string]string{"public": {Region: s.URL + path}}
+	}
+	content, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Config returns the configuration of a provider using the fake. The environment variables which
+// would take precedence over the endpoints file are cleared for the test.
+func (s *Server) Config(t testing.TB) *conns.Config {
+	for key := range endpointKeys {
+		t.Setenv(key, "")
+	}
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IBMCLOUD_HTTP_RECORDER_MODE", "")
+	return &conns.Config{
+		BluemixAPIKey:  APIKey,
+		Region:         Region,
+		Visibility:     "public",
+		EndpointsFile:  s.EndpointsFile(t),
+		BluemixTimeout: 60 * time.Second,
+		RetryDelay:     10 * time.Millisecond,
+		RetryMaxDelay:  10 * time.Millisecond,
+	}
+}
+
+// Meta returns the client session of a provider using the fake, the meta passed to the functions
+// of the resources.
+func (s *Server) Meta(t testing.TB) conns.ClientSession {
+	sess, err := s.Config(t).ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring the provider with the fake IBM Cloud: %s", err)
+	}
+	return sess.(conns.ClientSession)
+}
+
+// Object returns a copy of the object at path, such as /v1/vpcs/<id>, nil when there is none.
+func (s *Server) Object(path string) map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	obj, ok := s.objects[path]
+	if !ok {
+		return nil
+	}
+	return copyObject(obj)
+}
+
+// List returns a copy of the objects of the collection at path, such as
+// /v1/network_acls/<id>/rules, in the order the API lists them.
+func (s *Server) List(path string) []map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var objects []map[string]interface{}
+	for _, obj := range s.list(path) {
+		objects = append(objects, copyObject(obj.(map[string]interface{})))
+	}
+	return objects
+}
+
+// Tags returns the tags of tagType, "user" or "access", attached to the resource crn.
+func (s *Server) Tags(crn, tagType string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.tags[crn][tagType]...)
+}
+
+// newID returns a new ID with prefix.
+func (s *Server) newID(prefix string) string {
+	s.ids++
+	return fmt.Sprintf("%s-fake-%04d", prefix, s.ids)
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		c[k] = v
+	}
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func readJSON(r *http.Request) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if r.Body == nil {
+		return body, nil
+	}
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(content) == 0 {
+		return body, err
+	}
+	return body, json.Unmarshal(content, &body)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fakecloud"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestServerIAM(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	user, err := cloud.Meta(t).BluemixUserDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.UserAccount != fakecloud.AccountID {
+		t.Fatalf("Expected the account %s, got %s", fakecloud.AccountID, user.UserAccount)
+	}
+
+	config := cloud.Config(t)
+	config.BluemixAPIKey = "not-the-fake-api-key"
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sess.(conns.ClientSession).BluemixUserDetails(); err == nil {
+		t.Fatal("Expected an error with an unknown API key")
+	}
+}
+
+func TestServerVPC(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	client, err := cloud.Meta(t).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vpc, _, err := client.CreateVPC(&vpcv1.CreateVPCOptions{Name: strPtr("fake-vpc")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpc.ID == nil || vpc.CRN == nil || vpc.DefaultSecurityGroup == nil || *vpc.Name != "fake-vpc" {
+		t.Fatalf("Unexpected VPC created: %+v", vpc)
+	}
+	if cloud.Object("/v1/vpcs/"+*vpc.ID) == nil {
+		t.Fatal("VPC not stored by the fake")
+	}
+
+	vpc, _, err = client.UpdateVPC(&vpcv1.UpdateVPCOptions{
+		ID:       vpc.ID,
+		VPCPatch: map[string]interface{}{"name": "renamed-vpc"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *vpc.Name != "renamed-vpc" {
+		t.Fatalf("Expected the VPC to be renamed, got %s", *vpc.Name)
+	}
+
+	vpcs, _, err := client.ListVpcs(&vpcv1.ListVpcsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vpcs.Vpcs) != 1 {
+		t.Fatalf("Expected 1 VPC, got %d", len(vpcs.Vpcs))
+	}
+
+	if _, err := client.DeleteVPC(&vpcv1.DeleteVPCOptions{ID: vpc.ID}); err != nil {
+		t.Fatal(err)
+	}
+	_, response, err := client.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+	if err == nil || response.StatusCode != 404 {
+		t.Fatalf("Expected the VPC to be gone, got %v", err)
+	}
+}
+
+func TestServerResourceControllerAndTagging(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	meta := cloud.Meta(t)
+	rc, err := meta.ResourceControllerV2API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagging, err := meta.GlobalTaggingAPIv1()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instance, _, err := rc.CreateResourceInstance(&resourcecontrollerv2.CreateResourceInstanceOptions{
+		Name:           strPtr("fake-instance"),
+		Target:         strPtr(fakecloud.Region),
+		ResourceGroup:  strPtr(fakecloud.ResourceGroupID),
+		ResourcePlanID: strPtr("fake-plan"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *instance.State != "active" || !strings.HasPrefix(*instance.CRN, "crn:v1:bluemix:public:") {
+		t.Fatalf("Unexpected instance created: %+v", instance)
+	}
+
+	_, _, err = tagging.AttachTag(&globaltaggingv1.AttachTagOptions{
+		Resources: []globaltaggingv1.Resource{{ResourceID: instance.CRN}},
+		TagNames:  []string{"env:test", "team:a"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = tagging.DetachTag(&globaltaggingv1.DetachTagOptions{
+		Resources: []globaltaggingv1.Resource{{ResourceID: instance.CRN}},
+		TagNames:  []string{"team:a"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags, _, err := tagging.ListTags(&globaltaggingv1.ListTagsOptions{AttachedTo: instance.CRN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags.Items) != 1 || *tags.Items[0].Name != "env:test" {
+		t.Fatalf("Unexpected tags: %+v", tags.Items)
+	}
+
+	if _, err := rc.DeleteResourceInstance(&resourcecontrollerv2.DeleteResourceInstanceOptions{ID: instance.ID}); err != nil {
+		t.Fatal(err)
+	}
+	instance, _, err = rc.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{ID: instance.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *instance.State != "removed" {
+		t.Fatalf("Expected the instance to be removed, got %s", *instance.State)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
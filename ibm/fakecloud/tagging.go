@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// serveTags serves the list, attach and detach of the tags of the Global Tagging API.
+func (s *Server) serveTags(w http.ResponseWriter, r *http.Request) {
+	tagType := r.URL.Query().Get("tag_type")
+	if tagType == "" {
+		tagType = "user"
+	}
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	switch {
+	case path == "/v3/tags" && r.Method == http.MethodGet:
+		items := []interface{}{}
+		for _, name := range s.listTags(r.URL.Query().Get("attached_to"), tagType) {
+			items = append(items, map[string]string{"name": name})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"total_count": len(items),
+			"offset":      0,
+			"limit":       len(items),
+			"items":       items,
+		})
+	case (path == "/v3/tags/attach" || path == "/v3/tags/detach") && r.Method == http.MethodPost:
+		body, err := readJSON(r)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, tagError(err.Error()))
+			return
+		}
+		var names []string
+		if name, ok := body["tag_name"].(string); ok {
+			names = append(names, name)
+		}
+		if list, ok := body["tag_names"].([]interface{}); ok {
+			for _, name := range list {
+				names = append(names, name.(string))
+			}
+		}
+		resources, _ := body["resources"].([]interface{})
+		results := []interface{}{}
+		for _, resource := range resources {
+			crn, _ := resource.(map[string]interface{})["resource_id"].(string)
+			if path == "/v3/tags/attach" {
+				s.attachTags(crn, tagType, names)
+			} else {
+				s.detachTags(crn, tagType, names)
+			}
+			results = append(results, map[string]interface{}{"resource_id": crn, "is_error": false})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+	case strings.HasPrefix(path, "/v3/tags/") && r.Method == http.MethodDelete:
+		name, _ := url.PathUnescape(strings.TrimPrefix(path, "/v3/tags/"))
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"results": []interface{}{map[string]interface{}{"provider": "ghost", "is_error": false, "tag_name": name}},
+		})
+	default:
+		writeJSON(w, http.StatusNotFound, tagError(r.Method+" "+path+" is not served by the fake"))
+	}
+}
+
+// listTags returns the tags of tagType attached to crn, or to any resource when crn is empty.
+func (s *Server) listTags(crn, tagType string) []string {
+	if crn != "" {
+		return s.tags[crn][tagType]
+	}
+	seen := map[string]bool{}
+	for _, resource := range s.tags {
+		for _, name := range resource[tagType] {
+			seen[name] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) attachTags(crn, tagType string, names []string) {
+	if s.tags[crn] == nil {
+		s.tags[crn] = map[string][]string{}
+	}
+	for _, name := range names {
+		found := false
+		for _, t := range s.tags[crn][tagType] {
+			found = found || t == name
+		}
+		if !found {
+			s.tags[crn][tagType] = append(s.tags[crn][tagType], name)
+		}
+	}
+}
+
+func (s *Server) detachTags(crn, tagType string, names []string) {
+	kept := []string{}
+	for _, t := range s.tags[crn][tagType] {
+		detached := false
+		for _, name := range names {
+			detached = detached || t == name
+		}
+		if !detached {
+			kept = append(kept, t)
+		}
+	}
+	if s.tags[crn] != nil {
+		s.tags[crn][tagType] = kept
+	}
+}
+
+// serveSearch serves the Global Search API, returning every object of the fake with a CRN in a
+// single page, whatever the query.
+func (s *Server) serveSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, nil)
+		return
+	}
+	body, err := readJSON(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, tagError(err.Error()))
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	items := []interface{}{}
+	if cursor, _ := body["search_cursor"].(string); cursor == "" {
+		for _, p := range s.order {
+			obj, ok := s.objects[p]
+			crn, _ := obj["crn"].(string)
+			if !ok || crn == "" || obj["state"] == "removed" {
+				continue
+			}
+			item := map[string]interface{}{
+				"crn":         crn,
+				"name":        obj["name"],
+				"tags":        s.tags[crn]["user"],
+				"access_tags": s.tags[crn]["access"],
+			}
+			if group, ok := obj["resource_group"].(map[string]interface{}); ok {
+				item["resource_group_id"] = group["id"]
+			} else {
+				item["resource_group_id"] = obj["resource_group_id"]
+			}
+			items = append(items, item)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"search_cursor": "fake-cursor",
+		"items":         items,
+	})
+}
+
+func tagError(message string) map[string]interface{} {
+	return map[string]interface{}{
+		"errors": []map[string]string{{"code": "fake", "message": message}},
+	}
+}
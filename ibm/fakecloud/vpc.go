@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// serveVPC serves the collections of the VPC API, /v1/<collection>[/<id>/<collection>...],
+// with the create, list, get, update and delete of their objects.
+func (s *Server) serveVPC(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	segments := strings.Split(strings.TrimPrefix(path, "/v1/"), "/")
+	collection := len(segments)%2 == 1
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	switch {
+	case collection && r.Method == http.MethodPost:
+		body, err := readJSON(r)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, vpcError("bad_request", err.Error()))
+			return
+		}
+		ref := s.createVPCObject(path, body)
+		writeJSON(w, http.StatusCreated, s.objects[ref["href"].(string)[len(s.URL):]])
+	case collection && r.Method == http.MethodGet:
+		name := segments[len(segments)-1]
+		items := s.list(path)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			name:          items,
+			"limit":       50,
+			"total_count": len(items),
+			"first":       map[string]string{"href": s.URL + path},
+		})
+	case collection:
+		writeJSON(w, http.StatusMethodNotAllowed, vpcError("method_not_allowed", r.Method+" "+path))
+	default:
+		obj, ok := s.objects[path]
+		if !ok {
+			writeJSON(w, http.StatusNotFound, vpcError("not_found", fmt.Sprintf("%s not found", path)))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, s.view(segments, obj))
+		case http.MethodPatch:
+			patch, err := readJSON(r)
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, vpcError("bad_request", err.Error()))
+				return
+			}
+			for k, v := range patch {
+				obj[k] = v
+			}
+			s.moveBefore(path, obj)
+			writeJSON(w, http.StatusOK, s.view(segments, obj))
+		case http.MethodDelete:
+			s.delete(path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeJSON(w, http.StatusMethodNotAllowed, vpcError("method_not_allowed", r.Method+" "+path))
+		}
+	}
+}
+
+// createVPCObject creates an object in the collection at path from body, and returns a
+// reference to it.
+func (s *Server) createVPCObject(path string, body map[string]interface{}) map[string]interface{} {
+	name := path[strings.LastIndex(path, "/")+1:]
+	id := s.newID("r006")
+	obj := copyObject(body)
+	obj["id"] = id
+	obj["crn"] = fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/%s::%s:%s", Region, AccountID, crnResourceType(name), id)
+	obj["href"] = s.URL + path + "/" + id
+	obj["created_at"] = now()
+	setDefault(obj, "status", "available")
+	setDefault(obj, "lifecycle_state", "stable")
+	resourceGroup, _ := obj["resource_group"].(map[string]interface{})
+	if resourceGroup == nil || resourceGroup["id"] == nil {
+		resourceGroup = map[string]interface{}{"id": ResourceGroupID}
+	}
+	obj["resource_group"] = map[string]interface{}{
+		"id":   resourceGroup["id"],
+		"name": "Default",
+		"href": "https://resource-controller.cloud.ibm.com/v2/resource_groups/" + fmt.Sprint(resourceGroup["id"]),
+	}
+	s.objects[path+"/"+id] = obj
+	s.order = append(s.order, path+"/"+id)
+	s.setVPCDefaults(name, path+"/"+id, obj)
+	s.moveBefore(path+"/"+id, obj)
+	return reference(obj)
+}
+
+// moveBefore moves the object at path before the object of its collection referenced by its
+// before field, as the rules of the network ACLs, and removes the field.
+func (s *Server) moveBefore(path string, obj map[string]interface{}) {
+	before, ok := obj["before"].(map[string]interface{})
+	delete(obj, "before")
+	if !ok || before["id"] == nil {
+		return
+	}
+	beforePath := path[:strings.LastIndex(path, "/")+1] + fmt.Sprint(before["id"])
+	order := make([]string, 0, len(s.order))
+	for _, p := range s.order {
+		if p == beforePath {
+			order = append(order, path)
+		}
+		if p != path {
+			order = append(order, p)
+		}
+	}
+	s.order = order
+}
+
+// view returns the object at the path of segments as returned by the API, with the objects of the
+// collections embedded in it.
+func (s *Server) view(segments []string, obj map[string]interface{}) map[string]interface{} {
+	if len(segments) != 2 || segments[0] != "network_acls" {
+		return obj
+	}
+	view := copyObject(obj)
+	view["rules"] = s.list("/v1/network_acls/" + segments[1] + "/rules")
+	return view
+}
+
+// setVPCDefaults sets the fields computed by the VPC API on the objects created in a collection,
+// beyond the ID, CRN, href, creation date, resource group and status set on every object.
+func (s *Server) setVPCDefaults(collection, path string, obj map[string]interface{}) {
+	switch collection {
+	case "vpcs":
+		setDefault(obj, "classic_access", false)
+		setDefault(obj, "cse_source_ips", []interface{}{})
+		name, _ := obj["name"].(string)
+		obj["default_network_acl"] = s.createVPCObject("/v1/network_acls", map[string]interface{}{
+			"name": name + "-default-acl",
+			"vpc":  reference(obj),
+		})
+		obj["default_security_group"] = s.createVPCObject("/v1/security_groups", map[string]interface{}{
+			"name": name + "-default-sg",
+			"vpc":  reference(obj),
+		})
+		obj["default_routing_table"] = s.createVPCObject(path+"/routing_tables", map[string]interface{}{
+			"name":       name + "-default-rt",
+			"is_default": true,
+		})
+	case "network_acls":
+		setDefault(obj, "subnets", []interface{}{})
+		// Without rules, the network ACL is created with rules allowing all the traffic
+		if rules, _ := obj["rules"].([]interface{}); len(rules) == 0 {
+			for _, direction := range []string{"inbound", "outbound"} {
+				rules = append(rules, map[string]interface{}{
+					"name":        "allow-" + direction,
+					"action":      "allow",
+					"direction":   direction,
+					"protocol":    "all",
+					"source":      "0.0.0.0/0",
+					"destination": "0.0.0.0/0",
+				})
+			}
+			for _, rule := range rules {
+				s.createVPCObject(path+"/rules", rule.(map[string]interface{}))
+			}
+		}
+		delete(obj, "rules")
+	case "rules":
+		setDefault(obj, "ip_version", "ipv4")
+	case "keys":
+		setDefault(obj, "type", "rsa")
+		setDefault(obj, "length", 2048)
+		setDefault(obj, "fingerprint", "SHA256:fake")
+	}
+}
+
+// list returns the objects of the collection at path, in the order they were created.
+func (s *Server) list(path string) []interface{} {
+	items := []interface{}{}
+	for _, p := range s.order {
+		if strings.HasPrefix(p, path+"/") && !strings.Contains(p[len(path)+1:], "/") {
+			if obj, ok := s.objects[p]; ok {
+				items = append(items, obj)
+			}
+		}
+	}
+	return items
+}
+
+// delete deletes the object at path and the objects of its collections.
+func (s *Server) delete(path string) {
+	for p := range s.objects {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			delete(s.objects, p)
+		}
+	}
+}
+
+// crnResourceType returns the CRN resource type of the objects of a collection, such as
+// security-group for security_groups.
+func crnResourceType(collection string) string {
+	if collection == "vpn_gateways" {
+		return "vpn"
+	}
+	if collection == "load_balancers" {
+		return "load-balancer"
+	}
+	return strings.ReplaceAll(strings.TrimSuffix(collection, "s"), "_", "-")
+}
+
+// reference returns a reference to obj, as found in the other objects.
+func reference(obj map[string]interface{}) map[string]interface{} {
+	ref := map[string]interface{}{}
+	for _, k := range []string{"id", "crn", "href", "name"} {
+		if v, ok := obj[k]; ok {
+			ref[k] = v
+		}
+	}
+	return ref
+}
+
+func setDefault(obj map[string]interface{}, key string, value interface{}) {
+	if _, ok := obj[key]; !ok {
+		obj[key] = value
+	}
+}
+
+func vpcError(code, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"errors": []map[string]string{{"code": code, "message": message}},
+		"trace":  "fake",
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/IBM/go-sdk-core/v3/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// requestIDHeaders are the response headers carrying the ID of an IBM Cloud API request
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id"}
+
+// APIError is the error reported by resources and data sources when an IBM Cloud API call fails.
+// It reports the operation, the resource, the HTTP status code and the request ID of the call in
+// the same layout everywhere, so that the request ID IBM support asks for is always at hand.
+//
+//	return flex.NewAPIError(err, response).Operation("GetVPCWithContext").Resource("ibm_is_vpc", d.Id()).Diagnostics()
+type APIError struct {
+	err          error
+	response     *core.DetailedResponse
+	summary      string
+	operation    string
+	resourceType string
+	resourceID   string
+}
+
+// NewAPIError returns the error of an API call which failed with err and response. response may be nil.
+func NewAPIError(err error, response *core.DetailedResponse) *APIError {
+	return &APIError{err: err, response: response}
+}
+
+// NewAPIErrorV3 returns the error of an API call of an SDK built on the version 3 of the IBM Go SDK
+// core, such as the API Gateway and the COS configuration SDKs. response may be nil.
+func NewAPIErrorV3(err error, response *corev3.DetailedResponse) *APIError {
+	if response == nil {
+		return NewAPIError(err, nil)
+	}
+	return NewAPIError(err, &core.DetailedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Headers,
+		Result:     response.Result,
+	})
+}
+
+// Summary sets the one line summary of the error. It defaults to "<operation> failed".
+func (e *APIError) Summary(format string, a ...interface{}) *APIError {
+	e.summary = fmt.Sprintf(format, a...)
+	return e
+}
+
+// Operation sets the name of the API operation which failed.
+func (e *APIError) Operation(operation string) *APIError {
+	e.operation = operation
+	return e
+}
+
+// Resource sets the type and the ID of the resource or data source which called the API. id may be
+// empty, e.g. when a create call fails.
+func (e *APIError) Resource(resourceType, id string) *APIError {
+	e.resourceType = resourceType
+	e.resourceID = id
+	return e
+}
+
+// StatusCode returns the HTTP status code of the response, or 0 without response.
+func (e *APIError) StatusCode() int {
+	if e.response == nil {
+		return 0
+	}
+	return e.response.StatusCode
+}
+
+// RequestID returns the request ID sent back by the API, or "".
+func (e *APIError) RequestID() string {
+	if e.response == nil {
+		return ""
+	}
+	for _, header := range requestIDHeaders {
+		if id := e.response.Headers.Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// Unwrap returns the error of the API call.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// SummaryText returns the summary of the error.
+func (e *APIError) SummaryText() string {
+	switch {
+	case e.summary != "":
+		return e.summary
+	case e.operation != "":
+		return e.operation + " failed"
+	}
+	return "IBM Cloud API call failed"
+}
+
+// Detail returns the error of the API call followed by one line per known attribute of the call.
+func (e *APIError) Detail() string {
+	var b strings.Builder
+	if e.err != nil {
+		b.WriteString(e.err.Error())
+	}
+	line := func(name, value string) {
+		if value == "" {
+			return
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(name + ": " + value)
+	}
+	line("Operation", e.operation)
+	switch {
+	case e.resourceType != "" && e.resourceID != "":
+		line("Resource", fmt.Sprintf("%s (%s)", e.resourceType, e.resourceID))
+	case e.resourceType != "":
+		line("Resource", e.resourceType)
+	}
+	if status := e.StatusCode(); status != 0 {
+		line("HTTP status", fmt.Sprint(status))
+	}
+	line("Request ID", e.RequestID())
+	if e.response != nil && e.response.Result != nil {
+		if result, err := json.Marshal(e.response.Result); err == nil {
+			line("Response", string(result))
+		}
+	}
+	return b.String()
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("[ERROR] %s: %s", e.SummaryText(), e.Detail())
+}
+
+// Diagnostics returns the error as Terraform diagnostics.
+func (e *APIError) Diagnostics() diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  e.SummaryText(),
+		Detail:   e.Detail(),
+	}}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	corev3 "github.com/IBM/go-sdk-core/v3/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAPIErrorRequestID(t *testing.T) {
+	for _, c := range []struct {
+		response *core.DetailedResponse
+		id       string
+	}{
+		{nil, ""},
+		{&core.DetailedResponse{StatusCode: 500}, ""},
+		{&core.DetailedResponse{Headers: http.Header{"X-Request-Id": {"request"}, "X-Correlation-Id": {"correlation"}}}, "request"},
+		{&core.DetailedResponse{Headers: http.Header{"X-Correlation-Id": {"correlation"}}}, "correlation"},
+	} {
+		if id := NewAPIError(errors.New("failed"), c.response).RequestID(); id != c.id {
+			t.Errorf("Expected the request ID %q, got %q", c.id, id)
+		}
+	}
+}
+
+func TestAPIErrorDetail(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 404,
+		Headers:    http.Header{"X-Correlation-Id": {"correlation"}},
+		Result:     map[string]interface{}{"errors": []interface{}{map[string]interface{}{"code": "not_found"}}},
+	}
+	for _, c := range []struct {
+		err    *APIError
+		detail string
+	}{
+		{
+			NewAPIError(errors.New("Not Found"), response).Operation("GetVPCWithContext").Resource("ibm_is_vpc", "r006-vpc"),
+			"Not Found\nOperation: GetVPCWithContext\nResource: ibm_is_vpc (r006-vpc)\nHTTP status: 404\nRequest ID: correlation\n" +
+				`Response: {"errors":[{"code":"not_found"}]}`,
+		},
+		{
+			NewAPIError(errors.New("timeout"), nil).Operation("CreateVPCWithContext").Resource("ibm_is_vpc", ""),
+			"timeout\nOperation: CreateVPCWithContext\nResource: ibm_is_vpc",
+		},
+		{NewAPIError(nil, nil).Operation("ListVpcsWithContext"), "Operation: ListVpcsWithContext"},
+	} {
+		if detail := c.err.Detail(); detail != c.detail {
+			t.Errorf("Expected the detail:\n%s\ngot:\n%s", c.detail, detail)
+		}
+	}
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	for _, c := range []struct {
+		err     *APIError
+		summary string
+	}{
+		{NewAPIError(errors.New("failed"), nil), "IBM Cloud API call failed"},
+		{NewAPIError(errors.New("failed"), nil).Operation("GetVPCWithContext"), "GetVPCWithContext failed"},
+		{NewAPIError(errors.New("failed"), nil).Operation("GetVPCWithContext").Summary("Error getting the VPC %s", "vpc"), "Error getting the VPC vpc"},
+	} {
+		diags := c.err.Diagnostics()
+		if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].Summary != c.summary || diags[0].Detail != c.err.Detail() {
+			t.Errorf("Unexpected diagnostics %+v", diags)
+		}
+		if want := "[ERROR] " + c.summary + ": " + c.err.Detail(); c.err.Error() != want {
+			t.Errorf("Expected the error %q, got %q", want, c.err.Error())
+		}
+	}
+	cause := errors.New("failed")
+	if err := error(NewAPIError(cause, nil)); !errors.Is(err, cause) {
+		t.Error("Expected the API error to wrap the error of the call")
+	}
+}
+
+func TestNewAPIErrorV3(t *testing.T) {
+	err := NewAPIErrorV3(errors.New("failed"), &corev3.DetailedResponse{StatusCode: 409, Headers: http.Header{"X-Request-Id": {"request"}}})
+	if err.StatusCode() != 409 || err.RequestID() != "request" {
+		t.Fatalf("Unexpected status code %d and request ID %q", err.StatusCode(), err.RequestID())
+	}
+	if err := NewAPIErrorV3(errors.New("failed"), nil); err.StatusCode() != 0 || err.RequestID() != "" {
+		t.Fatalf("Unexpected status code %d and request ID %q without response", err.StatusCode(), err.RequestID())
+	}
+}
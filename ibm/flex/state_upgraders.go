@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceWithStateUpgrades sets the SchemaVersion and the StateUpgraders of the resource r from
+// upgrades, upgrades[i] upgrading the state of the version i to the version i+1, and returns r.
+// The states are decoded with the current schema of r, so the upgrades may change the ID and the
+// values of the attributes, but a change of the type of an attribute needs a StateUpgrader with
+// the previous schema instead.
+func ResourceWithStateUpgrades(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	impliedType := r.CoreConfigSchema().ImpliedType()
+	for version, upgrade := range upgrades {
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    impliedType,
+			Upgrade: upgrade,
+		})
+	}
+	r.SchemaVersion = len(r.StateUpgraders)
+	return r
+}
+
+// UpgradeID returns a state upgrade replacing the ID of the states for which legacy returns
+// true with the ID returned by build from the legacy ID and the attributes of the state.
+func UpgradeID(legacy func(id string) bool, build func(id string, rawState map[string]interface{}) (string, error)) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		id, _ := rawState["id"].(string)
+		if id == "" || !legacy(id) {
+			return rawState, nil
+		}
+		newID, err := build(id, rawState)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error upgrading the ID %s: %s", id, err)
+		}
+		rawState["id"] = newID
+		return rawState, nil
+	}
+}
+
+// UpgradeCisID returns a state upgrade of the Cloud Internet Services resources created before
+// their ID held the CRN of the CIS instance, rebuilding it from the cis_id and domain_id
+// attributes. The ID is <id>:<zone_id>:<crn>, or <zone_id>:<crn> for the resources of a zone when
+// zone is true. It only fits the resources whose ID had this format change, the CIS resources added
+// since then have always held the CRN and some of them use other formats.
+func UpgradeCisID(zone bool) schema.StateUpgradeFunc {
+	return UpgradeID(
+		func(id string) bool {
+			return !strings.Contains(id, "crn:")
+		},
+		func(id string, rawState map[string]interface{}) (string, error) {
+			cisID, _ := rawState["cis_id"].(string)
+			if cisID == "" {
+				return "", fmt.Errorf("cis_id is not set")
+			}
+			parts := strings.Split(id, ":")
+			zoneID := parts[0]
+			if domainID, _ := rawState["domain_id"].(string); domainID != "" {
+				zoneID = strings.Split(domainID, ":")[0]
+			} else if !zone && len(parts) > 1 {
+				zoneID = parts[1]
+			}
+			if zone {
+				return ConvertCisToTfTwoVar(zoneID, cisID), nil
+			}
+			return ConvertCisToTfThreeVar(parts[0], zoneID, cisID), nil
+		},
+	)
+}
@@ -12,7 +12,6 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
-	"os"
 	"path"
 	"reflect"
 	"strconv"
@@ -2017,6 +2016,9 @@ func GetTags(d *schema.ResourceData, meta interface{}) error {
 // }
 
 func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
+	if tags, ok := tagCache(meta).Tags(resourceID, tagType); ok {
+		return managedTags(meta, tags), nil
+	}
 
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
@@ -2058,7 +2060,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 		taglist = append(taglist, *item.Name)
 	}
 	log.Println("tagList: ", taglist)
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return managedTags(meta, taglist), nil
 }
 
 func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
@@ -2086,7 +2088,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 	options.SetFields([]string{"access_tags", "tags", "service_tags"})
 	result, resp, err := gsClient.Search(&options)
 	if err != nil {
-		return nil, fmt.Errorf("[ERROR] Error to query the tags for the resource: %s %s", err, resp)
+		return nil, NewAPIError(err, resp).Summary("Error to query the tags for the resource").Operation("Search")
 	}
 	var taglist []string
 	var t interface{}
@@ -2108,7 +2110,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 			}
 		}
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return managedTags(meta, taglist), nil
 }
 
 func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
@@ -2122,6 +2124,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		return err
 	}
 	acctID := userDetails.UserAccount
+	defer tagCache(meta).Invalidate(resourceID)
 
 	resources := []globaltaggingv1.Resource{}
 	r := globaltaggingv1.Resource{ResourceID: PtrToString(resourceID), ResourceType: PtrToString(resourceType)}
@@ -2141,19 +2144,8 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	for i, v := range addInt {
 		add[i] = fmt.Sprint(v)
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
-	}
-
-	if strings.TrimSpace(tagType) == "" || tagType == "user" {
-		schematicTags := os.Getenv("IC_ENV_TAGS")
-		var envTags []string
-		if schematicTags != "" {
-			envTags = strings.Split(schematicTags, ",")
-			add = append(add, envTags...)
-		}
-	}
+	remove := removedTags(meta, tagType, removeInt)
+	add = append(add, DefaultTags(meta, tagType)...)
 
 	if len(remove) > 0 {
 		detachTagOptions := &globaltaggingv1.DetachTagOptions{}
@@ -2168,7 +2160,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error detaching database tags %v: %s\n%s", remove, err, resp)
+			return NewAPIError(err, resp).Summary("Error detaching database tags %v", remove).Operation("DetachTag")
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
@@ -2176,7 +2168,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			}
 			_, resp, err := gtClient.DeleteTag(delTagOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting database tag %v: %s\n%s", v, err, resp)
+				return NewAPIError(err, resp).Summary("Error deleting database tag %v", v).Operation("DeleteTag")
 			}
 		}
 	}
@@ -2194,7 +2186,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating database tags %v : %s\n%s", add, err, resp)
+			return NewAPIError(err, resp).Summary("Error updating database tags %v", add).Operation("AttachTag")
 		}
 	}
 
@@ -2216,6 +2208,9 @@ func ApplyOnce(k, o, n string, d *schema.ResourceData) bool {
 	return true
 }
 func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error) {
+	if tags, ok := tagCache(meta).Tags(resourceCRN, "user"); ok {
+		return managedTags(meta, tags), nil
+	}
 
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPI()
 	if err != nil {
@@ -2237,7 +2232,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return managedTags(meta, taglist), nil
 }
 
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
@@ -2245,6 +2240,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
 	}
+	defer tagCache(meta).Invalidate(resourceCRN)
 	if oldList == nil {
 		oldList = new(schema.Set)
 	}
@@ -2259,17 +2255,8 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	for i, v := range addInt {
 		add[i] = fmt.Sprint(v)
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
-	}
-
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
-	}
+	remove := removedTags(meta, "user", removeInt)
+	add = append(add, DefaultTags(meta, "user")...)
 
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
@@ -2313,25 +2300,101 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
-
-	if diff.Id() != "" && diff.HasChange("tags") {
-		o, n := diff.GetChange("tags")
+// ResourceTagsCustomizeDiff suppresses the changes of the tags and access_tags of a resource which
+// only add or remove default tags or ignored tags, and computes the tags_all attribute of the
+// resources which have one: the tags of the resource along with the default tags.
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	tags := tagsConfig(meta)
+	for key, tagType := range map[string]string{"tags": "user", "access_tags": "access"} {
+		if diff.Id() == "" || !diff.HasChange(key) {
+			continue
+		}
+		o, n := diff.GetChange(key)
 		oldSet := o.(*schema.Set)
 		newSet := n.(*schema.Set)
-		removeInt := oldSet.Difference(newSet).List()
-		addInt := newSet.Difference(oldSet).List()
-		if v := os.Getenv("IC_ENV_TAGS"); v != "" {
-			s := strings.Split(v, ",")
-			if len(removeInt) == len(s) && len(addInt) == 0 {
-				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
+		changed := append(oldSet.Difference(newSet).List(), newSet.Difference(oldSet).List()...)
+		suppress := true
+		for _, v := range changed {
+			if tag := v.(string); !tags.IsDefault(tagType, tag) && !tags.Ignored(tag) {
+				suppress = false
+				break
+			}
+		}
+		if suppress {
+			log.Printf("[DEBUG] Suppressing the diff of %s, which only changes default or ignored tags", key)
+			if err := diff.Clear(key); err != nil {
+				return err
 			}
 		}
 	}
+
+	if config := diff.GetRawConfig(); !config.Type().IsObjectType() || !config.Type().HasAttribute("tags_all") {
+		return nil
+	}
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tagsAll := managedTags(meta, append(ExpandStringList(diff.Get("tags").(*schema.Set).List()), tags.Defaults("user")...))
+	if old, ok := diff.Get("tags_all").(*schema.Set); ok && old.Equal(tagsAll) {
+		return nil
+	}
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+// tagsConfig returns the default and ignored tags of the provider.
+func tagsConfig(meta interface{}) conns.TagsConfig {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.TagsConfig()
+	}
+	return conns.TagsConfig{}
+}
+
+// tagCache returns the tags of the resources loaded for the run, or nil.
+func tagCache(meta interface{}) *conns.TagCache {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.TagCache()
+	}
 	return nil
 }
 
+// DefaultTags returns the default tags of tagType, "user" or "access", attached to every taggable
+// resource: the tags of the default_tags provider block, and of IC_ENV_TAGS for the user tags.
+func DefaultTags(meta interface{}, tagType string) []string {
+	return tagsConfig(meta).Defaults(tagType)
+}
+
+// HasDefaultTags reports whether default tags of tagType are configured.
+func HasDefaultTags(meta interface{}, tagType string) bool {
+	return len(DefaultTags(meta, tagType)) > 0
+}
+
+// managedTags returns the tags of taglist which are not ignored with ignore_tags.
+func managedTags(meta interface{}, taglist []string) *schema.Set {
+	tags := tagsConfig(meta)
+	managed := make([]string, 0, len(taglist))
+	for _, tag := range taglist {
+		if !tags.Ignored(tag) {
+			managed = append(managed, tag)
+		}
+	}
+	return NewStringSet(ResourceIBMVPCHash, managed)
+}
+
+// removedTags returns the tags to detach from a resource. Default tags and ignored tags are never
+// detached.
+func removedTags(meta interface{}, tagType string, removeInt []interface{}) []string {
+	tags := tagsConfig(meta)
+	remove := make([]string, 0, len(removeInt))
+	for _, v := range removeInt {
+		tag := fmt.Sprint(v)
+		if tags.IsDefault(tagType, tag) || tags.Ignored(tag) {
+			continue
+		}
+		remove = append(remove, tag)
+	}
+	return remove
+}
+
 func ResourceValidateAccessTags(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if value, ok := diff.GetOkExists("access_tags"); ok {
@@ -2411,17 +2474,8 @@ func ResourceIBMISLBPoolCookieValidate(diff *schema.ResourceDiff) error {
 	sessionPersistenceType := sessionPersistenceTypeIntf.(string)
 	sessionPersistenceCookieName := sessionPersistenceCookieNameIntf.(string)
 
-	if sessionPersistenceType == "app_cookie" {
-		if sessionPersistenceCookieName == "" {
-			return fmt.Errorf("Load Balancer Pool: %s is required for %s 'app_cookie'", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)
-		}
-		if strings.HasPrefix(sessionPersistenceCookieName, "IBM") {
-			return fmt.Errorf("Load Balancer Pool: %s starting with IBM are not allowed", isLBPoolSessPersistenceAppCookieName)
-		}
-	}
-
-	if sessionPersistenceCookieName != "" && sessionPersistenceType != "app_cookie" {
-		return fmt.Errorf("Load Balancer Pool: %s is only applicable for %s 'app_cookie'.", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)
+	if sessionPersistenceType == "app_cookie" && strings.HasPrefix(sessionPersistenceCookieName, "IBM") {
+		return fmt.Errorf("Load Balancer Pool: %s starting with IBM are not allowed", isLBPoolSessPersistenceAppCookieName)
 	}
 	return nil
 }
@@ -2454,33 +2508,10 @@ func ResourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 	return nil
 }
 
-func InstanceProfileValidate(diff *schema.ResourceDiff) error {
-	if diff.Id() != "" && diff.HasChange("profile") {
-		o, n := diff.GetChange("profile")
-		old := o.(string)
-		new := n.(string)
-		log.Println("old profile : ", old)
-		log.Println("new profile : ", new)
-		if !strings.Contains(old, "d") && strings.Contains(new, "d") {
-			diff.ForceNew("profile")
-		} else if strings.Contains(old, "d") && !strings.Contains(new, "d") {
-			diff.ForceNew("profile")
-		}
-	}
-	return nil
-}
-
-func ResourceIPSecPolicyValidate(diff *schema.ResourceDiff) error {
-
-	newEncAlgo := diff.Get("encryption_algorithm").(string)
-	newAuthAlgo := diff.Get("authentication_algorithm").(string)
-	if (newEncAlgo == "aes128gcm16" || newEncAlgo == "aes192gcm16" || newEncAlgo == "aes256gcm16") && newAuthAlgo != "disabled" {
-		return fmt.Errorf("authentication_algorithm must be set to 'disabled' when the encryption_algorithm is either one of 'aes128gcm16', 'aes192gcm16', 'aes256gcm16'")
-	}
-
-	return nil
-}
-
+// ResourceVolumeValidate validates the changes of the capacity and the iops of a volume. The capacity
+// allowed by the profiles, the iops allowed by the custom profile and the replacement of the volumes
+// changed from or to the custom profile are handled by the rules of the ibm_is_volume and
+// ibm_is_instance_volume_attachment validators.
 func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 
 	if diff.Id() != "" && diff.HasChange("capacity") {
@@ -2493,139 +2524,22 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 	}
 
 	profile := ""
-	var capacity, iops int64
+	var iops int64
 	if profileOk, ok := diff.GetOk("profile"); ok {
 		profile = profileOk.(string)
 	}
-	if capacityOk, ok := diff.GetOk("capacity"); ok {
-		capacity = int64(capacityOk.(int))
-	}
-
-	if capacity == int64(0) {
-		capacity = int64(100)
-	}
-	if profile == "5iops-tier" && capacity > 9600 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 9600)
-	} else if profile == "10iops-tier" && capacity > 4800 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 4800)
-	}
-
 	if iopsOk, ok := diff.GetOk("iops"); ok {
 		iops = int64(iopsOk.(int))
 	}
 
-	if diff.HasChange("profile") {
-		oldProfile, newProfile := diff.GetChange("profile")
-		if oldProfile.(string) == "custom" || newProfile.(string) == "custom" {
-			diff.ForceNew("profile")
-		}
-	}
-
 	if profile != "custom" {
 		if iops != 0 && diff.NewValueKnown("iops") && diff.HasChange("iops") {
 			return fmt.Errorf("VolumeError : iops is applicable for only custom volume profiles")
 		}
-	} else {
-		if capacity == 0 {
-			capacity = int64(100)
-		}
-		if capacity >= 10 && capacity <= 39 {
-			min := int64(100)
-			max := int64(1000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 40 && capacity <= 79 {
-			min := int64(100)
-			max := int64(2000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 80 && capacity <= 99 {
-			min := int64(100)
-			max := int64(4000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 100 && capacity <= 499 {
-			min := int64(100)
-			max := int64(6000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 500 && capacity <= 999 {
-			min := int64(100)
-			max := int64(10000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 1000 && capacity <= 1999 {
-			min := int64(100)
-			max := int64(20000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 2000 && capacity <= 3999 {
-			min := int64(200)
-			max := int64(40000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 4000 && capacity <= 7999 {
-			min := int64(300)
-			max := int64(40000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 8000 && capacity <= 9999 {
-			min := int64(500)
-			max := int64(48000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
-		if capacity >= 10000 && capacity <= 16000 {
-			min := int64(1000)
-			max := int64(48000)
-			if !(iops >= min && iops <= max) {
-				return fmt.Errorf("VolumeError : allowed iops value for capacity(%d) is [%d-%d] ", capacity, min, max)
-			}
-		}
 	}
 	return nil
 }
 
-func ResourceRouteModeValidate(diff *schema.ResourceDiff) error {
-
-	var lbtype, lbprofile string
-	if typeOk, ok := diff.GetOk(isLBType); ok {
-		lbtype = typeOk.(string)
-	}
-	if profileOk, ok := diff.GetOk(isLBProfile); ok {
-		lbprofile = profileOk.(string)
-	}
-	if rmOk, ok := diff.GetOk(isLBRouteMode); ok {
-		routeMode := rmOk.(bool)
-
-		if routeMode && lbtype != "private" {
-			return fmt.Errorf("'type' must be 'private', at present public load balancers are not supported with route mode enabled.")
-		}
-		if routeMode && lbprofile != "network-fixed" {
-			return fmt.Errorf("'profile' must be 'network-fixed', route mode is supported by private network load balancer.")
-		}
-	}
-
-	return nil
-}
-
 func FlattenRoleData(object []iampolicymanagementv1.Role, roleType string) []map[string]string {
 	var roles []map[string]string
 
@@ -2732,7 +2646,7 @@ func DefaultResourceGroup(meta interface{}) (string, error) {
 	}
 	grpList, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
 	if err != nil || grpList == nil || grpList.Resources == nil {
-		return "", fmt.Errorf("[ERROR] Error retrieving resource group: %s %s", err, resp)
+		return "", NewAPIError(err, resp).Summary("Error retrieving resource group").Operation("ListResourceGroups")
 	}
 	if len(grpList.Resources) <= 0 {
 		return "", fmt.Errorf("[ERROR] The default resource group could not be found. Make sure you have required permissions to access the resource group")
@@ -3440,7 +3354,7 @@ func FetchResourceInstanceDetails(d *schema.ResourceData, meta interface{}, inst
 	instance, response, err := resourceControllerClient.GetResourceInstance(&getResourceOpts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving resource instance: %s\n%s", err, response)
-		return fmt.Errorf("Error retrieving resource instance: %s\n%s", err, response)
+		return NewAPIError(err, response).Summary("Error retrieving resource instance").Operation("GetResourceInstance")
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[DEBUG] Error retrieving resource instance details: Resource has been removed")
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// testTagsSession is a client session configured with default_tags and ignore_tags.
+type testTagsSession struct {
+	conns.ClientSession
+	tags conns.TagsConfig
+}
+
+func (s testTagsSession) TagsConfig() conns.TagsConfig {
+	return s.tags
+}
+
+var testTagsMeta = testTagsSession{tags: conns.TagsConfig{
+	DefaultTags:       []string{"env:dev"},
+	DefaultAccessTags: []string{"project:blue"},
+	IgnoreTagKeys:     []string{"owner"},
+	IgnoreTagPrefixes: []string{"sys-"},
+}}
+
+func TestManagedTags(t *testing.T) {
+	managed := ExpandStringList(managedTags(testTagsMeta, []string{"env:dev", "owner:alice", "sys-backup", "team:core"}).List())
+	sort.Strings(managed)
+	if want := []string{"env:dev", "team:core"}; !reflect.DeepEqual(managed, want) {
+		t.Fatalf("Expected the managed tags %v, got %v", want, managed)
+	}
+	if managed := managedTags(nil, []string{"owner:alice"}); managed.Len() != 1 {
+		t.Fatalf("Expected no tag to be ignored without a session, got %v", managed.List())
+	}
+}
+
+func TestRemovedTags(t *testing.T) {
+	cases := []struct {
+		tagType string
+		remove  []interface{}
+		want    []string
+	}{
+		{"user", []interface{}{"env:dev", "owner:alice", "sys-backup", "team:core"}, []string{"team:core"}},
+		{"user", []interface{}{"ENV:DEV", "project:blue"}, []string{"project:blue"}},
+		{"access", []interface{}{"env:dev", "project:blue"}, []string{"env:dev"}},
+		{"access", []interface{}{"owner:bob"}, []string{}},
+	}
+	for _, c := range cases {
+		if remove := removedTags(testTagsMeta, c.tagType, c.remove); !reflect.DeepEqual(remove, c.want) {
+			t.Errorf("Expected to detach the %s tags %v of %v, got %v", c.tagType, c.want, c.remove, remove)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// MigrateConfig rewrites the configuration file src for renames: the resource and data source
+// blocks, their attributes and nested blocks, the references to them, and the renamed providers.
+// It returns the new content and a description of the changes.
+func MigrateConfig(src []byte, filename string, renames []Rename) ([]byte, []string, error) {
+	f, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	byType := index(renames)
+
+	changes := migrateReferences(f.BuildTokens(nil), byType)
+	changes = append(changes, migrateProviders(f.Body(), providers(renames))...)
+	for _, block := range f.Body().Blocks() {
+		labels := block.Labels()
+		if (block.Type() != "resource" && block.Type() != "data") || len(labels) != 2 {
+			continue
+		}
+		rename, ok := byType[labels[0]]
+		if !ok {
+			continue
+		}
+		address := labels[0] + "." + labels[1]
+		if block.Type() == "data" {
+			address = "data." + address
+		}
+		for _, change := range migrateBody(block.Body(), rename.Attributes) {
+			changes = append(changes, fmt.Sprintf("%s: %s", address, change))
+		}
+		if rename.To != rename.From {
+			block.SetLabels([]string{rename.To, labels[1]})
+			changes = append(changes, fmt.Sprintf("%s: renamed to %s", address, rename.To))
+		}
+	}
+	return f.Bytes(), changes, nil
+}
+
+// migrateProviders renames the providers of the renamed map, by old name, in place: the labels of
+// the provider blocks, the entries of terraform.required_providers, the provider arguments of the
+// resources and data sources, such as ibmcloud.alias, and the providers arguments of the modules.
+func migrateProviders(body *hclwrite.Body, renamed map[string]string) []string {
+	if len(renamed) == 0 {
+		return nil
+	}
+	var changes []string
+	for _, block := range body.Blocks() {
+		labels := block.Labels()
+		switch block.Type() {
+		case "provider":
+			if len(labels) == 1 && renamed[labels[0]] != "" {
+				block.SetLabels([]string{renamed[labels[0]]})
+				changes = append(changes, fmt.Sprintf("provider %s renamed to %s", labels[0], renamed[labels[0]]))
+			}
+		case "terraform":
+			for _, required := range block.Body().Blocks() {
+				if required.Type() != "required_providers" {
+					continue
+				}
+				for name, attr := range required.Body().Attributes() {
+					if renamed[name] == "" {
+						continue
+					}
+					tokens := attr.BuildTokens(nil)
+					for _, token := range tokens {
+						if token.Type == hclsyntax.TokenIdent {
+							token.Bytes = []byte(renamed[name])
+							break
+						}
+					}
+					for i, token := range tokens {
+						// the type of the provider is the last part of its source, such as
+						// registry.terraform.io/ibm-cloud/ibmcloud
+						if token.Type != hclsyntax.TokenQuotedLit || i < 3 || string(tokens[i-3].Bytes) != "source" {
+							continue
+						}
+						source := strings.Split(string(token.Bytes), "/")
+						if to := renamed[source[len(source)-1]]; to != "" {
+							source[len(source)-1] = to
+							token.Bytes = []byte(strings.Join(source, "/"))
+						}
+					}
+					changes = append(changes, fmt.Sprintf("required provider %s renamed to %s", name, renamed[name]))
+				}
+			}
+		case "resource", "data", "module":
+			argument := "provider"
+			if block.Type() == "module" {
+				argument = "providers"
+			}
+			attr := block.Body().GetAttribute(argument)
+			if attr == nil {
+				continue
+			}
+			for _, token := range attr.Expr().BuildTokens(nil) {
+				name := string(token.Bytes)
+				if token.Type == hclsyntax.TokenIdent && renamed[name] != "" {
+					token.Bytes = []byte(renamed[name])
+					changes = append(changes, fmt.Sprintf("%s %s: provider %s renamed to %s", block.Type(), strings.Join(labels, "."), name, renamed[name]))
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// migrateBody renames the attributes and the nested blocks of a resource body, in place. The
+// references to the attributes in lifecycle.ignore_changes are renamed as well.
+func migrateBody(body *hclwrite.Body, attributes map[string]string) []string {
+	var changes []string
+	for name, attr := range body.Attributes() {
+		if newName, ok := attributes[name]; ok {
+			for _, token := range attr.BuildTokens(nil) {
+				if token.Type == hclsyntax.TokenIdent {
+					token.Bytes = []byte(newName)
+					break
+				}
+			}
+			changes = append(changes, fmt.Sprintf("attribute %s renamed to %s", name, newName))
+		}
+	}
+	for _, block := range body.Blocks() {
+		switch {
+		case attributes[block.Type()] != "":
+			changes = append(changes, fmt.Sprintf("block %s renamed to %s", block.Type(), attributes[block.Type()]))
+			block.SetType(attributes[block.Type()])
+		case block.Type() == "dynamic" && len(block.Labels()) == 1 && attributes[block.Labels()[0]] != "":
+			changes = append(changes, fmt.Sprintf("dynamic block %s renamed to %s", block.Labels()[0], attributes[block.Labels()[0]]))
+			block.SetLabels([]string{attributes[block.Labels()[0]]})
+		case block.Type() == "lifecycle":
+			if attr := block.Body().GetAttribute("ignore_changes"); attr != nil {
+				tokens := attr.Expr().BuildTokens(nil)
+				for i, token := range tokens {
+					newName, ok := attributes[string(token.Bytes)]
+					if ok && token.Type == hclsyntax.TokenIdent && (i == 0 || tokens[i-1].Type != hclsyntax.TokenDot) {
+						token.Bytes = []byte(newName)
+					}
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// migrateReferences renames the resource types and the attributes in the references of tokens,
+// such as ibm_kp_key.key.key_protect_id or data.ibm_kp_key.keys, in place.
+func migrateReferences(tokens hclwrite.Tokens, byType map[string]Rename) []string {
+	var changes []string
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].Type != hclsyntax.TokenIdent || (i > 0 && tokens[i-1].Type == hclsyntax.TokenDot) {
+			continue
+		}
+		if string(tokens[i].Bytes) == "data" && isAttributeAccess(tokens, i+1) {
+			i += 2
+		}
+		rename, ok := byType[string(tokens[i].Bytes)]
+		if !ok || !isAttributeAccess(tokens, i+1) {
+			continue
+		}
+		reference := string(tokens[i].Bytes) + "." + string(tokens[i+2].Bytes)
+		if rename.To != rename.From {
+			tokens[i].Bytes = []byte(rename.To)
+			changes = append(changes, fmt.Sprintf("reference to %s renamed to %s", reference, rename.To))
+		}
+
+		// Skip the index of the resource, [0], [each.key] or .0, to the attribute
+		j := i + 3
+		if j < len(tokens) && tokens[j].Type == hclsyntax.TokenOBrack {
+			for depth := 0; j < len(tokens); j++ {
+				if tokens[j].Type == hclsyntax.TokenOBrack {
+					depth++
+				} else if tokens[j].Type == hclsyntax.TokenCBrack {
+					depth--
+					if depth == 0 {
+						j++
+						break
+					}
+				}
+			}
+		} else if j+1 < len(tokens) && tokens[j].Type == hclsyntax.TokenDot && (tokens[j+1].Type == hclsyntax.TokenNumberLit || tokens[j+1].Type == hclsyntax.TokenStar) {
+			j += 2
+		}
+		if isAttributeAccess(tokens, j) {
+			if newName, ok := rename.Attributes[string(tokens[j+1].Bytes)]; ok {
+				changes = append(changes, fmt.Sprintf("reference to %s.%s renamed to %s", reference, tokens[j+1].Bytes, newName))
+				tokens[j+1].Bytes = []byte(newName)
+			}
+		}
+		i = j
+	}
+	return changes
+}
+
+// isAttributeAccess returns whether tokens[i:] starts with an attribute access, .<name>.
+func isAttributeAccess(tokens hclwrite.Tokens, i int) bool {
+	return i+1 < len(tokens) && tokens[i].Type == hclsyntax.TokenDot && tokens[i+1].Type == hclsyntax.TokenIdent
+}
+
+// index returns the renames of the resource types, by old type.
+func index(renames []Rename) map[string]Rename {
+	byType := make(map[string]Rename, len(renames))
+	for _, rename := range renames {
+		if !rename.Provider {
+			byType[rename.From] = rename
+		}
+	}
+	return byType
+}
+
+// providers returns the new names of the renamed providers, by old name.
+func providers(renames []Rename) map[string]string {
+	renamed := map[string]string{}
+	for _, rename := range renames {
+		if rename.Provider {
+			renamed[rename.From] = rename.To
+		}
+	}
+	return renamed
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package migrate rewrites the configuration and the state of the resources and the attributes
+// renamed by the provider, driven by the declarative table Renames.
+package migrate
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Main runs the migrate command of the provider binary, with the arguments following "migrate".
+func Main(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "Directory of the configuration files, rewritten with the files of its subdirectories")
+	statePath := flags.String("state", "", "State file written by terraform state pull, rewritten for terraform state push")
+	backup := flags.Bool("backup", true, "Back up the rewritten files with a .bak suffix")
+	dryRun := flags.Bool("dry-run", false, "Print the changes without rewriting the files")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-ibm migrate [options]\n\n"+
+			"Rewrites the configuration and the state of the renamed resources and attributes.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	err := filepath.Walk(*dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != *dir && (strings.HasPrefix(info.Name(), ".") || info.Name() == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".tf" {
+			return nil
+		}
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		out, changes, err := MigrateConfig(src, path, Renames)
+		if err != nil {
+			return err
+		}
+		return rewrite(path, src, out, changes, *backup, *dryRun)
+	})
+	if err != nil {
+		return err
+	}
+
+	if *statePath != "" {
+		src, err := ioutil.ReadFile(*statePath)
+		if err != nil {
+			return err
+		}
+		out, changes, err := MigrateState(src, Renames)
+		if err != nil {
+			return err
+		}
+		if err := rewrite(*statePath, src, out, changes, *backup, *dryRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewrite prints changes and replaces the content src of the file at path with out, unless dryRun.
+func rewrite(path string, src, out []byte, changes []string, backup, dryRun bool) error {
+	if bytes.Equal(src, out) {
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Printf("%s: %s\n", path, change)
+	}
+	if dryRun {
+		return nil
+	}
+	// The state holds secrets, keep the permissions of the files
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if backup {
+		if err := ioutil.WriteFile(path+".bak", src, mode); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(path, out, mode)
+}
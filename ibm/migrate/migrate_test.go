@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMigrateConfig(t *testing.T) {
+	src := `resource "ibm_kp_key" "key" {
+  key_protect_id = ibm_resource_instance.kp.guid
+  key_name       = "key"
+
+  lifecycle {
+    ignore_changes = [key_protect_id]
+  }
+}
+
+data "ibm_kp_key" "keys" {
+  key_protect_id = ibm_kp_key.key.key_protect_id
+}
+
+resource "ibm_database" "db" {
+  name = "db"
+  whitelist {
+    address = "10.0.0.1/32"
+  }
+}
+
+resource "ibmcloud_infra_vlan" "vlan" {
+  count = 2
+}
+
+output "ids" {
+  value = [ibmcloud_infra_vlan.vlan[0].id, ibmcloud_infra_vlan.vlan.*.id, data.ibm_kp_key.keys.keys, ibm_database.db.whitelist]
+}
+`
+	want := `resource "ibm_kms_key" "key" {
+  instance_id = ibm_resource_instance.kp.guid
+  key_name    = "key"
+
+  lifecycle {
+    ignore_changes = [instance_id]
+  }
+}
+
+data "ibm_kms_key" "keys" {
+  instance_id = ibm_kms_key.key.instance_id
+}
+
+resource "ibm_database" "db" {
+  name = "db"
+  allowlist {
+    address = "10.0.0.1/32"
+  }
+}
+
+resource "ibm_network_vlan" "vlan" {
+  count = 2
+}
+
+output "ids" {
+  value = [ibm_network_vlan.vlan[0].id, ibm_network_vlan.vlan.*.id, data.ibm_kms_key.keys.keys, ibm_database.db.allowlist]
+}
+`
+	out, changes, err := MigrateConfig([]byte(src), "main.tf", Renames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != want {
+		t.Fatalf("Unexpected configuration:\n%s", out)
+	}
+	if len(changes) == 0 {
+		t.Fatal("Expected changes")
+	}
+}
+
+func TestMigrateConfigProvider(t *testing.T) {
+	src := `terraform {
+  required_providers {
+    ibmcloud = {
+      source = "terraform.local/ibm/ibmcloud"
+    }
+  }
+}
+
+provider "ibmcloud" {
+  region = "us-south"
+}
+
+provider "ibmcloud" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibmcloud_infra_vlan" "vlan" {
+  provider = ibmcloud.eu
+}
+
+data "ibmcloud_cs_cluster_config" "config" {
+  provider = ibmcloud
+}
+
+module "network" {
+  source = "./network"
+  providers = {
+    ibmcloud = ibmcloud.eu
+  }
+}
+
+output "ibmcloud" {
+  value = var.ibmcloud
+}
+`
+	want := `terraform {
+  required_providers {
+    ibm = {
+      source = "terraform.local/ibm/ibm"
+    }
+  }
+}
+
+provider "ibm" {
+  region = "us-south"
+}
+
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_network_vlan" "vlan" {
+  provider = ibm.eu
+}
+
+data "ibm_container_cluster_config" "config" {
+  provider = ibm
+}
+
+module "network" {
+  source = "./network"
+  providers = {
+    ibm = ibm.eu
+  }
+}
+
+output "ibmcloud" {
+  value = var.ibmcloud
+}
+`
+	out, changes, err := MigrateConfig([]byte(src), "main.tf", Renames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != want {
+		t.Fatalf("Unexpected configuration:\n%s", out)
+	}
+	if len(changes) != 9 {
+		t.Fatalf("Unexpected changes: %v", changes)
+	}
+}
+
+func TestMigrateState(t *testing.T) {
+	src := `{
+  "version": 4,
+  "terraform_version": "1.3.0",
+  "serial": 7,
+  "lineage": "lineage",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "ibm_kp_key",
+      "name": "key",
+      "provider": "provider[\"registry.terraform.io/ibm-cloud/ibm\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {"id": "crn:v1:key", "key_protect_id": "guid", "key_name": "key"},
+          "dependencies": ["module.kp.ibm_resource_instance.kp"]
+        }
+      ]
+    },
+    {
+      "module": "module.db",
+      "mode": "managed",
+      "type": "ibm_database",
+      "name": "db",
+      "provider": "provider[\"registry.terraform.io/ibm-cloud/ibm\"]",
+      "instances": [
+        {
+          "index_key": 0,
+          "schema_version": 0,
+          "attributes": {"id": "crn:v1:db", "whitelist": [{"address": "10.0.0.1/32"}], "allowlist": []},
+          "dependencies": ["ibm_kp_key.key"]
+        }
+      ]
+    }
+  ]
+}
+`
+	out, changes, err := MigrateState([]byte(src), Renames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 4 {
+		t.Fatalf("Unexpected changes: %v", changes)
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(out, &state); err != nil {
+		t.Fatal(err)
+	}
+	if state["serial"] != 8.0 {
+		t.Fatalf("Expected the serial to be incremented, got %v", state["serial"])
+	}
+	resources := state["resources"].([]interface{})
+	key := resources[0].(map[string]interface{})
+	if key["type"] != "ibm_kms_key" {
+		t.Fatalf("Unexpected type %v", key["type"])
+	}
+	keyInstance := key["instances"].([]interface{})[0].(map[string]interface{})
+	if attributes := keyInstance["attributes"]; !reflect.DeepEqual(attributes, map[string]interface{}{"id": "crn:v1:key", "instance_id": "guid", "key_name": "key"}) {
+		t.Fatalf("Unexpected attributes %v", attributes)
+	}
+	dbInstance := resources[1].(map[string]interface{})["instances"].([]interface{})[0].(map[string]interface{})
+	if attributes := dbInstance["attributes"].(map[string]interface{}); attributes["whitelist"] != nil || len(attributes["allowlist"].([]interface{})) != 1 {
+		t.Fatalf("Unexpected attributes %v", attributes)
+	}
+	if dependencies := dbInstance["dependencies"]; !reflect.DeepEqual(dependencies, []interface{}{"ibm_kms_key.key"}) {
+		t.Fatalf("Unexpected dependencies %v", dependencies)
+	}
+	if dbInstance["index_key"] != 0.0 {
+		t.Fatalf("Unexpected index key %v", dbInstance["index_key"])
+	}
+}
+
+func TestMigrateStateProvider(t *testing.T) {
+	src := `{
+  "version": 4,
+  "serial": 3,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "ibmcloud_infra_vlan",
+      "name": "vlan",
+      "provider": "provider[\"registry.terraform.io/ibm-cloud/ibmcloud\"].eu",
+      "instances": []
+    },
+    {
+      "module": "module.provider",
+      "mode": "data",
+      "type": "ibmcloud_cs_cluster_config",
+      "name": "config",
+      "provider": "module.provider.provider.ibmcloud",
+      "instances": []
+    },
+    {
+      "mode": "managed",
+      "type": "ibm_is_vpc",
+      "name": "vpc",
+      "provider": "provider[\"registry.terraform.io/ibm-cloud/ibm\"]",
+      "instances": []
+    }
+  ]
+}
+`
+	out, changes, err := MigrateState([]byte(src), Renames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 4 {
+		t.Fatalf("Unexpected changes: %v", changes)
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(out, &state); err != nil {
+		t.Fatal(err)
+	}
+	var got []interface{}
+	for _, r := range state["resources"].([]interface{}) {
+		resource := r.(map[string]interface{})
+		got = append(got, resource["type"], resource["provider"])
+	}
+	want := []interface{}{
+		"ibm_network_vlan", `provider["registry.terraform.io/ibm-cloud/ibm"].eu`,
+		"ibm_container_cluster_config", "module.provider.provider.ibm",
+		"ibm_is_vpc", `provider["registry.terraform.io/ibm-cloud/ibm"]`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Unexpected resources %v", got)
+	}
+}
+
+func TestMigrateStateUnchanged(t *testing.T) {
+	src := `{"version": 4, "serial": 1, "resources": [{"mode": "managed", "type": "ibm_is_vpc", "name": "vpc", "instances": []}]}`
+	out, changes, err := MigrateState([]byte(src), Renames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != src || len(changes) != 0 {
+		t.Fatalf("Expected the state to be unchanged, got %s", out)
+	}
+}
+
+func TestMigrateAddress(t *testing.T) {
+	byType := index(Renames)
+	for address, want := range map[string]string{
+		"ibm_kp_key.key":                        "ibm_kms_key.key",
+		"data.ibm_kp_key.keys":                  "data.ibm_kms_key.keys",
+		`module.ibm_kp_key["a.b"].ibm_kp_key.k`: `module.ibm_kp_key["a.b"].ibm_kms_key.k`,
+		"ibm_is_vpc.vpc":                        "ibm_is_vpc.vpc",
+	} {
+		if got := migrateAddress(address, byType); got != want {
+			t.Errorf("migrateAddress(%s) = %s, want %s", address, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+// Rename renames a resource type and its data source, or some of their attributes, in the
+// configuration and in the state.
+type Rename struct {
+	// From and To are the old and the new type, the same when only attributes are renamed
+	From string
+	To   string
+	// Attributes are the new names of the renamed attributes and nested blocks, by old name
+	Attributes map[string]string
+	// Provider is true when the provider itself is renamed, in the provider blocks, the
+	// required_providers and the provider arguments of the resources and the modules
+	Provider bool
+}
+
+// Renames are the renames of the provider, replacing the sed script migration/migration.txt.
+var Renames = []Rename{
+	{From: "ibmcloud_cf_account", To: "ibm_account"},
+	{From: "ibmcloud_cf_app", To: "ibm_app"},
+	{From: "ibmcloud_cf_org", To: "ibm_org"},
+	{From: "ibmcloud_cf_private_domain", To: "ibm_app_domain_private"},
+	{From: "ibmcloud_cf_route", To: "ibm_app_route"},
+	{From: "ibmcloud_cf_service_instance", To: "ibm_service_instance"},
+	{From: "ibmcloud_cf_service_key", To: "ibm_service_key"},
+	{From: "ibmcloud_cf_service_plan", To: "ibm_service_plan"},
+	{From: "ibmcloud_cf_shared_domain", To: "ibm_app_domain_shared"},
+	{From: "ibmcloud_cf_space", To: "ibm_space"},
+	{From: "ibmcloud_cs_cluster_config", To: "ibm_container_cluster_config"},
+	{From: "ibmcloud_cs_cluster", To: "ibm_container_cluster"},
+	{From: "ibmcloud_cs_worker", To: "ibm_container_cluster_worker"},
+	{From: "ibmcloud_infra_dns_domain", To: "ibm_dns_domain"},
+	{From: "ibmcloud_infra_image_template", To: "ibm_compute_image_template"},
+	{From: "ibmcloud_infra_ssh_key", To: "ibm_compute_ssh_key"},
+	{From: "ibmcloud_infra_virtual_guest", To: "ibm_compute_vm_instance"},
+	{From: "ibmcloud_infra_vlan", To: "ibm_network_vlan"},
+	{From: "ibmcloud_cs_cluster_bind_service", To: "ibm_container_bind_service"},
+	{From: "ibmcloud_infra_bare_metal", To: "ibm_compute_bare_metal"},
+	{From: "ibmcloud_infra_basic_monitor", To: "ibm_compute_monitor"},
+	{From: "ibmcloud_infra_block_storage", To: "ibm_storage_block"},
+	{From: "ibmcloud_infra_dns_domain_record", To: "ibm_dns_record"},
+	{From: "ibmcloud_infra_file_storage", To: "ibm_storage_file"},
+	{From: "ibmcloud_infra_fw_hardware_dedicated_rules", To: "ibm_firewall_policy"},
+	{From: "ibmcloud_infra_fw_hardware_dedicated", To: "ibm_firewall"},
+	{From: "ibmcloud_infra_global_ip", To: "ibm_network_public_ip"},
+	{From: "ibmcloud_infra_lb_local_service_group", To: "ibm_lb_service_group"},
+	{From: "ibmcloud_infra_lb_local_service", To: "ibm_lb_service"},
+	{From: "ibmcloud_infra_lb_local", To: "ibm_lb"},
+	{From: "ibmcloud_infra_lb_vpx_ha", To: "ibm_lb_vpx_ha"},
+	{From: "ibmcloud_infra_lb_vpx_service", To: "ibm_lb_vpx_service"},
+	{From: "ibmcloud_infra_lb_vpx_vip", To: "ibm_lb_vpx_vip"},
+	{From: "ibmcloud_infra_lb_vpx", To: "ibm_lb_vpx"},
+	{From: "ibmcloud_infra_objectstorage_account", To: "ibm_object_storage_account"},
+	{From: "ibmcloud_infra_provisioning_hook", To: "ibm_compute_provisioning_hook"},
+	{From: "ibmcloud_infra_scale_group", To: "ibm_compute_autoscale_group"},
+	{From: "ibmcloud_infra_scale_policy", To: "ibm_compute_autoscale_policy"},
+	{From: "ibmcloud_infra_security_certificate", To: "ibm_compute_ssl_certificate"},
+	{From: "ibmcloud_infra_user", To: "ibm_compute_user"},
+	{From: "ibmcloud", To: "ibm", Provider: true},
+	{From: "ibm_kp_key", To: "ibm_kms_key", Attributes: map[string]string{"key_protect_id": "instance_id"}},
+	{From: "ibm_database", To: "ibm_database", Attributes: map[string]string{"whitelist": "allowlist"}},
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MigrateState rewrites the state src, as written by terraform state pull, for renames: the
+// types, the attributes and the providers of the resources and data sources, and the
+// dependencies on them. The
+// serial of the state is incremented when it changes, for terraform state push to accept it. It
+// returns the new state and a description of the changes.
+func MigrateState(src []byte, renames []Rename) ([]byte, []string, error) {
+	var state map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(src))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error reading the state: %s", err)
+	}
+	if version := fmt.Sprint(state["version"]); version != "4" {
+		return nil, nil, fmt.Errorf("[ERROR] Unsupported state version %s, only the version 4 written by Terraform 0.12 and later is supported", version)
+	}
+	byType := index(renames)
+	renamedProviders := providers(renames)
+
+	var changes []string
+	resources, _ := state["resources"].([]interface{})
+	for _, r := range resources {
+		resource, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		address := resourceAddress(resource)
+		rename, renamed := byType[fmt.Sprint(resource["type"])]
+		if renamed && rename.To != rename.From {
+			resource["type"] = rename.To
+			changes = append(changes, fmt.Sprintf("%s: renamed to %s", address, rename.To))
+		}
+		if provider, ok := resource["provider"].(string); ok {
+			if migrated := migrateProviderAddress(provider, renamedProviders); migrated != provider {
+				resource["provider"] = migrated
+				changes = append(changes, fmt.Sprintf("%s: provider %s renamed to %s", address, provider, migrated))
+			}
+		}
+
+		instances, _ := resource["instances"].([]interface{})
+		for _, i := range instances {
+			instance, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if attributes, ok := instance["attributes"].(map[string]interface{}); ok && renamed {
+				for name, newName := range rename.Attributes {
+					value, ok := attributes[name]
+					if !ok {
+						continue
+					}
+					delete(attributes, name)
+					if !isEmpty(value) || attributes[newName] == nil {
+						attributes[newName] = value
+						changes = append(changes, fmt.Sprintf("%s: attribute %s renamed to %s", address, name, newName))
+					}
+				}
+			}
+			if dependencies, ok := instance["dependencies"].([]interface{}); ok {
+				for j, dependency := range dependencies {
+					if migrated := migrateAddress(fmt.Sprint(dependency), byType); migrated != dependency {
+						dependencies[j] = migrated
+						changes = append(changes, fmt.Sprintf("%s: dependency %s renamed to %s", address, dependency, migrated))
+					}
+				}
+			}
+		}
+	}
+	if len(changes) == 0 {
+		return src, nil, nil
+	}
+
+	if serial, err := state["serial"].(json.Number).Int64(); err == nil {
+		state["serial"] = serial + 1
+	}
+	out, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(out, '\n'), changes, nil
+}
+
+// migrateAddress renames the resource type in the resource address, such as
+// module.vpc.ibm_kp_key.key or data.ibm_kp_key.keys.
+func migrateAddress(address string, byType map[string]Rename) string {
+	parts := splitAddress(address)
+	for i, part := range parts {
+		if i > 0 && parts[i-1] == "module" {
+			continue
+		}
+		if rename, ok := byType[part]; ok && i+1 < len(parts) {
+			parts[i] = rename.To
+			break
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// migrateProviderAddress renames the provider type in the provider address of a resource, such
+// as provider["registry.terraform.io/ibm-cloud/ibmcloud"].eu, or provider.ibmcloud in the states
+// written by Terraform 0.12.
+func migrateProviderAddress(address string, renamed map[string]string) string {
+	parts := splitAddress(address)
+	for i, part := range parts {
+		if i > 0 && parts[i-1] == "module" {
+			continue
+		}
+		switch {
+		case part == "provider" && i+1 < len(parts):
+			if to := renamed[parts[i+1]]; to != "" {
+				parts[i+1] = to
+			}
+			return strings.Join(parts, ".")
+		case strings.HasPrefix(part, `provider["`) && strings.HasSuffix(part, `"]`):
+			source := strings.Split(strings.TrimSuffix(strings.TrimPrefix(part, `provider["`), `"]`), "/")
+			if to := renamed[source[len(source)-1]]; to != "" {
+				source[len(source)-1] = to
+				parts[i] = fmt.Sprintf(`provider[%q]`, strings.Join(source, "/"))
+			}
+			return strings.Join(parts, ".")
+		}
+	}
+	return address
+}
+
+// splitAddress splits a resource address on the dots outside of the instance keys.
+func splitAddress(address string) []string {
+	var parts []string
+	start, quoted := 0, false
+	for i, c := range address {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			parts = append(parts, address[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, address[start:])
+}
+
+func resourceAddress(resource map[string]interface{}) string {
+	address := fmt.Sprintf("%s.%s", resource["type"], resource["name"])
+	if resource["mode"] == "data" {
+		address = "data." + address
+	}
+	if module, ok := resource["module"].(string); ok && module != "" {
+		address = module + "." + address
+	}
+	return address
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	}
+	return false
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -160,6 +162,18 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The delay (in seconds) before the first retry of a failed API call. The delay doubles on every further retry.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_RETRY_DELAY", "IBMCLOUD_RETRY_DELAY"}, 1),
+			},
+			"max_retry_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum delay (in seconds) between two retries of a failed API call, including the delay requested by the Retry-After header.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_MAX_RETRY_DELAY", "IBMCLOUD_MAX_RETRY_DELAY"}, 30),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -187,6 +201,49 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_cr_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "File holding the compute resource token exchanged for a token of the trusted profile set in iam_profile_id",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_CR_TOKEN_FILE", "IBMCLOUD_IAM_CR_TOKEN_FILE"}, nil),
+				ConflictsWith: []string{"iam_vpc_instance_metadata"},
+			},
+			"iam_vpc_instance_metadata": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Exchange the identity token of the VPC instance running the provider for a token of the trusted profile set in iam_profile_id",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_VPC_INSTANCE_METADATA", "IBMCLOUD_IAM_VPC_INSTANCE_METADATA"}, false),
+			},
+			"assume_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The trusted profile the provider operates as. The profile can belong to another account than the credentials of the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the trusted profile. Exactly one of profile_id and profile_crn must be set.",
+						},
+						"profile_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the trusted profile. Exactly one of profile_id and profile_crn must be set.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The account of the trusted profile. The provider fails to configure when the profile belongs to another account.",
+						},
+						"cr_token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The file holding the compute resource token exchanged for the token of the profile. The file is read again on every token refresh.",
+						},
+					},
+				},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -207,10 +264,85 @@ func Provider() *schema.Provider {
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
 			},
 			"endpoints_file_path": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Path of the JSON or YAML file that contains private and public regional endpoints mapping",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
+				ValidateFunc: validate.ValidateEndpointsFile,
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Path of the file that contains private and public regional endpoints mapping",
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
+				Description: "Client side rate limit of the API calls made to a service family.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues(conns.RateLimitServices),
+							Description:  "The service family to rate limit.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "The sustained number of requests per second sent to the service family.",
+						},
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The number of requests which can be sent at once before the rate applies.",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags attached to every taggable resource managed by the provider, in addition to the tags of the resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "User tags attached to every taggable resource.",
+						},
+						"access_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "Access tags attached to every resource which supports access tags.",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags applied outside of Terraform which the provider leaves alone, and which do not show in the plans.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tags to ignore, matched on the whole tag or on its key, the part before the first ':'.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Prefixes of the tags to ignore.",
+						},
+					},
+				},
 			},
 		},
 
@@ -921,6 +1053,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                     vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_pool_members":                             vpc.ResourceIBMISLBPoolMembers(),
 			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
@@ -1265,6 +1398,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_lb_listener_policy":                vpc.ResourceIBMISLBListenerPolicyValidator(),
 				"ibm_is_lb_listener":                       vpc.ResourceIBMISLBListenerValidator(),
 				"ibm_is_lb_pool_member":                    vpc.ResourceIBMISLBPoolMemberValidator(),
+				"ibm_is_lb_pool_members":                   vpc.ResourceIBMISLBPoolMembersValidator(),
 				"ibm_is_lb_pool":                           vpc.ResourceIBMISLBPoolValidator(),
 				"ibm_is_lb":                                vpc.ResourceIBMISLBValidator(),
 				"ibm_is_network_acl":                       vpc.ResourceIBMISNetworkACLValidator(),
@@ -1477,6 +1611,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if ttoken, ok := d.GetOk("iam_profile_id"); ok {
 		iamTrustedProfileId = ttoken.(string)
 	}
+	var iamCRTokenFile string
+	if f, ok := d.GetOk("iam_cr_token_file"); ok {
+		iamCRTokenFile = f.(string)
+	}
+	iamVPCInstanceMetadata := d.Get("iam_vpc_instance_metadata").(bool)
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -1519,10 +1658,51 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		file = f.(string)
 	}
 
+	rateLimits := map[string]conns.RateLimit{}
+	for _, l := range d.Get("rate_limit").([]interface{}) {
+		limit := l.(map[string]interface{})
+		service := limit["service"].(string)
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("[ERROR] rate_limit for service %q is configured more than once", service)
+		}
+		rateLimits[service] = conns.RateLimit{
+			RequestsPerSecond: limit["requests_per_second"].(float64),
+			Burst:             limit["burst"].(int),
+		}
+	}
+
+	var assumeProfile *conns.AssumeProfile
+	if p, ok := d.GetOk("assume_profile"); ok && p.([]interface{})[0] != nil {
+		profile := p.([]interface{})[0].(map[string]interface{})
+		assumeProfile = &conns.AssumeProfile{
+			ProfileID:   profile["profile_id"].(string),
+			ProfileCRN:  profile["profile_crn"].(string),
+			AccountID:   profile["account_id"].(string),
+			CRTokenFile: profile["cr_token_file"].(string),
+		}
+		if err := assumeProfile.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var tags conns.TagsConfig
+	if t, ok := d.GetOk("default_tags"); ok && t.([]interface{})[0] != nil {
+		defaults := t.([]interface{})[0].(map[string]interface{})
+		tags.DefaultTags = flex.ExpandStringList(defaults["tags"].(*schema.Set).List())
+		tags.DefaultAccessTags = flex.ExpandStringList(defaults["access_tags"].(*schema.Set).List())
+	}
+	if t, ok := d.GetOk("ignore_tags"); ok && t.([]interface{})[0] != nil {
+		ignore := t.([]interface{})[0].(map[string]interface{})
+		tags.IgnoreTagKeys = flex.ExpandStringList(ignore["keys"].(*schema.Set).List())
+		tags.IgnoreTagPrefixes = flex.ExpandStringList(ignore["key_prefixes"].(*schema.Set).List())
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryDelay := d.Get("retry_delay").(int)
+	maxRetryDelay := d.Get("max_retry_delay").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1536,24 +1716,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:          bluemixAPIKey,
+		Region:                 region,
+		ResourceGroup:          resourceGrp,
+		BluemixTimeout:         time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:       time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:      softlayerUsername,
+		SoftLayerAPIKey:        softlayerAPIKey,
+		RetryCount:             retryCount,
+		SoftLayerEndpointURL:   softlayerEndpointUrl,
+		RetryDelay:             time.Duration(retryDelay) * time.Second,
+		RetryMaxDelay:          time.Duration(maxRetryDelay) * time.Second,
+		FunctionNameSpace:      wskNameSpace,
+		RiaasEndPoint:          riaasEndPoint,
+		IAMToken:               iamToken,
+		IAMRefreshToken:        iamRefreshToken,
+		Zone:                   zone,
+		Visibility:             visibility,
+		EndpointsFile:          file,
+		IAMTrustedProfileID:    iamTrustedProfileId,
+		IAMCRTokenFile:         iamCRTokenFile,
+		IAMVPCInstanceMetadata: iamVPCInstanceMetadata,
+		RateLimits:             rateLimits,
+		AssumeProfile:          assumeProfile,
+		Tags:                   tags,
 	}
 
 	return config.ClientSession()
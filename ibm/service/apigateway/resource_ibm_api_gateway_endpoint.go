@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
 	"github.com/ghodss/yaml"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -153,7 +154,7 @@ func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{
 
 	result, response, err := endpointservice.CreateEndpoint(payload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Endpoint: %s,%s", err, response)
+		return flex.NewAPIErrorV3(err, response).Summary("Error creating Endpoint").Operation("CreateEndpoint").Resource("ibm_api_gateway_endpoint", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s//%s", *result.ServiceInstanceCrn, *result.ArtifactID))
@@ -194,7 +195,7 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Endpoint: %s\n%s", err, response)
+		return flex.NewAPIErrorV3(err, response).Summary("Error Getting Endpoint").Operation("GetEndpoint").Resource("ibm_api_gateway_endpoint", d.Id())
 	}
 	d.Set("service_instance_crn", serviceInstanceCrn)
 	d.Set("endpoint_id", apiID)
@@ -311,7 +312,7 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 
 		_, response, err := endpointservice.EndpointActions(actionPayload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Endpoint Action: %s,%s", err, response)
+			return flex.NewAPIErrorV3(err, response).Summary("Error updating Endpoint Action").Operation("EndpointActions").Resource("ibm_api_gateway_endpoint", d.Id())
 		}
 	}
 
@@ -356,7 +357,7 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 	if update {
 		_, response, err := endpointservice.UpdateEndpoint(payload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Endpoint: %s,%s", err, response)
+			return flex.NewAPIErrorV3(err, response).Summary("Error updating Endpoint").Operation("UpdateEndpoint").Resource("ibm_api_gateway_endpoint", d.Id())
 		}
 	}
 	return resourceIBMApiGatewayEndPointGet(d, meta)
@@ -391,7 +392,7 @@ func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Endpoint: %s\n%s", err, response)
+		return flex.NewAPIErrorV3(err, response).Summary("Error deleting Endpoint").Operation("DeleteEndpoint").Resource("ibm_api_gateway_endpoint", d.Id())
 	}
 	d.SetId("")
 
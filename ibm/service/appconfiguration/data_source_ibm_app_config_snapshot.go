@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigSnapshot() *schema.Resource {
@@ -122,7 +124,7 @@ func dataSourceIbmAppConfigSnapshotRead(d *schema.ResourceData, meta interface{}
 	result, response, err := appconfigClient.GetGitconfig(options)
 
 	if err != nil {
-		return fmt.Errorf("GetGitconfig failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("GetGitconfig").Resource("data.ibm_app_config_snapshot", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", guid, *result.GitConfigID))
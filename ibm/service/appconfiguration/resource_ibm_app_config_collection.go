@@ -92,7 +92,7 @@ func resourceIbmIbmAppConfigCollectiontCreate(d *schema.ResourceData, meta inter
 	collection, response, err := appconfigClient.CreateCollection(options)
 
 	if err != nil {
-		return fmt.Errorf("CreateCollection failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("CreateCollection").Resource("ibm_app_config_collection", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *collection.CollectionID))
 
@@ -121,7 +121,7 @@ func resourceIbmIbmAppConfigCollectiontRead(d *schema.ResourceData, meta interfa
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 		}
-		return fmt.Errorf("[DEBUG] GetCollection failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("GetCollection").Resource("ibm_app_config_collection", d.Id())
 	}
 
 	d.Set("guid", parts[0])
@@ -257,7 +257,7 @@ func resourceIbmIbmAppConfigCollectionUpdate(d *schema.ResourceData, meta interf
 
 		_, response, err := appconfigClient.UpdateCollection(options)
 		if err != nil {
-			return fmt.Errorf("UpdateCollection failed %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Operation("UpdateCollection").Resource("ibm_app_config_collection", d.Id())
 		}
 		return resourceIbmIbmAppConfigCollectiontRead(d, meta)
 	}
@@ -283,7 +283,7 @@ func resourceIbmIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteCollection failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("DeleteCollection").Resource("ibm_app_config_collection", d.Id())
 	}
 
 	d.SetId("")
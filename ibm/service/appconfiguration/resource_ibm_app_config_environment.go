@@ -108,7 +108,7 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	_, response, err := appconfigClient.CreateEnvironment(options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("CreateEnvironment").Resource("ibm_app_config_environment", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *options.EnvironmentID))
 
@@ -142,7 +142,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err := appconfigClient.UpdateEnvironment(options)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Operation("UpdateEnvironment").Resource("ibm_app_config_environment", d.Id())
 		}
 		return resourceEnvironmentRead(d, meta)
 	}
@@ -167,7 +167,7 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := appconfigClient.GetEnvironment(options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("GetEnvironment").Resource("ibm_app_config_environment", d.Id())
 	}
 	d.Set("guid", parts[0])
 	if result.Name != nil {
@@ -234,7 +234,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteEnvironment failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("DeleteEnvironment").Resource("ibm_app_config_environment", d.Id())
 	}
 	d.SetId("")
 	return nil
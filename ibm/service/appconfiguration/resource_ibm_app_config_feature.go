@@ -289,7 +289,7 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 
 	result, response, err := appconfigClient.GetFeature(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("GetFeature").Resource("ibm_app_config_feature", d.Id())
 	}
 
 	d.Set("guid", parts[0])
@@ -420,7 +420,7 @@ func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteFeature failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("DeleteFeature").Resource("ibm_app_config_feature", d.Id())
 	}
 
 	d.SetId("")
@@ -153,7 +153,7 @@ func resourceIbmIbmAppConfigSnapshotCreate(d *schema.ResourceData, meta interfac
 	snapshot, response, err := appconfigClient.CreateGitconfig(options)
 
 	if err != nil {
-		return fmt.Errorf("CreateGitconfig failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("CreateGitconfig").Resource("ibm_app_config_snapshot", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *snapshot.GitConfigID))
 	return resourceIbmIbmAppConfigSnapshotRead(d, meta)
@@ -232,7 +232,7 @@ func resourceIbmIbmAppConfigSnapshotRead(d *schema.ResourceData, meta interface{
 
 	result, response, err := appconfigClient.GetGitconfig(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetGitconfigs failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("GetGitconfig").Resource("ibm_app_config_snapshot", d.Id())
 	}
 
 	d.Set("guid", parts[0])
@@ -299,7 +299,7 @@ func resourceIbmIbmAppConfigSnapshotDelete(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteGitconfig failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Operation("DeleteGitconfig").Resource("ibm_app_config_snapshot", d.Id())
 	}
 	d.SetId("")
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,7 +53,7 @@ func dataSourceIBMAppIDActionURLRead(ctx context.Context, d *schema.ResourceData
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID actionURL: %s\n%s", err, rawResp)
+		return flex.NewAPIError(err, rawResp).Summary("Error getting AppID actionURL").Operation("GetCloudDirectoryActionURLWithContext").Resource("data.ibm_appid_action_url", d.Id()).Diagnostics()
 	}
 
 	if resp.ActionURL != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -114,7 +115,7 @@ func dataSourceIBMAppIDAPMRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID APM configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID APM configuration").Operation("GetCloudDirectoryAdvancedPasswordManagementWithContext").Resource("data.ibm_appid_apm", d.Id()).Diagnostics()
 	}
 
 	if apm.AdvancedPasswordManagement != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -74,7 +75,7 @@ func dataSourceIBMAppIDApplicationRead(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID application: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID application").Operation("GetApplicationWithContext").Resource("data.ibm_appid_application", d.Id()).Diagnostics()
 	}
 
 	if app.Name != nil {
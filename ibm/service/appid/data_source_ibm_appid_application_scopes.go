@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,7 +53,7 @@ func dataSourceIBMAppIDApplicationScopesRead(ctx context.Context, d *schema.Reso
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID application scopes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID application scopes").Operation("GetApplicationScopesWithContext").Resource("data.ibm_appid_application_scopes", d.Id()).Diagnostics()
 	}
 
 	if err := d.Set("scopes", scopes.Scopes); err != nil {
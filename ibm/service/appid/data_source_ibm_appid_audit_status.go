@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,7 +43,7 @@ func dataSourceIBMAppIDAuditStatusRead(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID audit status: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID audit status").Operation("GetAuditStatusWithContext").Resource("data.ibm_appid_audit_status", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *auditStatus.IsActive)
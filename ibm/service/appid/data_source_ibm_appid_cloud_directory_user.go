@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -113,7 +114,7 @@ func dataSourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Res
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID Cloud Directory user").Operation("GetCloudDirectoryUserWithContext").Resource("data.ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 	}
 
 	d.Set("tenant_id", tenantID)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,7 +78,7 @@ func dataSourceIBMAppIDIDPCloudDirectoryRead(ctx context.Context, d *schema.Reso
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID Cloud Directory IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Cloud Directory IDP").Operation("GetCloudDirectoryIDPWithContext").Resource("data.ibm_appid_idp_cloud_directory", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *config.IsActive)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,7 +68,7 @@ func dataSourceIBMAppIDIDPFacebookRead(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID Facebook IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Facebook IDP").Operation("GetFacebookIDPWithContext").Resource("data.ibm_appid_idp_facebook", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *fb.IsActive)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,7 +67,7 @@ func dataSourceIBMAppIDIDPGoogleRead(ctx context.Context, d *schema.ResourceData
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID Google IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Google IDP").Operation("GetGoogleIDPWithContext").Resource("data.ibm_appid_idp_google", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *gg.IsActive)
@@ -110,7 +110,7 @@ func dataSourceIBMAppIDIDPSAMLRead(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading SAML IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading SAML IDP").Operation("GetSAMLIDPWithContext").Resource("data.ibm_appid_idp_saml", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *saml.IsActive)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,7 +43,7 @@ func dataSourceIBMAppIDIDPSAMLMetadataRead(ctx context.Context, d *schema.Resour
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID SAML metadata: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID SAML metadata").Operation("GetSAMLMetadataWithContext").Resource("data.ibm_appid_idp_saml_metadata", d.Id()).Diagnostics()
 	}
 
 	if err := d.Set("metadata", metadata); err != nil {
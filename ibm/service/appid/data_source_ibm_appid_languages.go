@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,7 +46,7 @@ func dataSourceIBMAppIDLanguagesRead(ctx context.Context, d *schema.ResourceData
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID languages: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID languages").Operation("GetLocalizationWithContext").Resource("data.ibm_appid_languages", d.Id()).Diagnostics()
 	}
 
 	d.Set("languages", langs.Languages)
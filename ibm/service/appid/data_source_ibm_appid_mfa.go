@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,7 +42,7 @@ func dataSourceIBMAppIDMFARead(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting IBM AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting IBM AppID MFA configuration").Operation("GetMFAConfigWithContext").Resource("data.ibm_appid_mfa", d.Id()).Diagnostics()
 	}
 
 	if mfa.IsActive != nil {
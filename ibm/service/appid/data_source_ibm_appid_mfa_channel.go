@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -69,7 +70,7 @@ func dataSourceIBMAppIDMFAChannelRead(ctx context.Context, d *schema.ResourceDat
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID MFA channels: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID MFA channels").Operation("ListChannelsWithContext").Resource("data.ibm_appid_mfa_channel", d.Id()).Diagnostics()
 	}
 
 	for _, channel := range ch.Channels {
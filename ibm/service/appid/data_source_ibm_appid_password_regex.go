@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,7 +53,7 @@ func dataSourceIBMAppIDPasswordRegexRead(ctx context.Context, d *schema.Resource
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID Cloud Directory password regex: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Cloud Directory password regex").Operation("GetCloudDirectoryPasswordRegexWithContext").Resource("data.ibm_appid_password_regex", d.Id()).Diagnostics()
 	}
 
 	if pw.Base64EncodedRegex != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,7 +45,7 @@ func dataSourceIBMAppIDRedirectURLsRead(ctx context.Context, d *schema.ResourceD
 		TenantID: &tenantID,
 	})
 	if err != nil {
-		return diag.Errorf("Error loading Cloud Directory AppID redirect urls: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading Cloud Directory AppID redirect urls").Operation("GetRedirectUrisWithContext").Resource("data.ibm_appid_redirect_urls", d.Id()).Diagnostics()
 	}
 
 	if err := d.Set("urls", urls.RedirectUris); err != nil {
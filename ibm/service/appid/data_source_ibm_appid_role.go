@@ -76,7 +76,7 @@ func dataSourceIBMAppIDRoleRead(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID role: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID role").Operation("GetRoleWithContext").Resource("data.ibm_appid_role", d.Id()).Diagnostics()
 	}
 
 	d.Set("name", *role.Name)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,7 +42,7 @@ func dataSourceIBMAppIDThemeColorRead(ctx context.Context, d *schema.ResourceDat
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID theme colors: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID theme colors").Operation("GetThemeColorWithContext").Resource("data.ibm_appid_theme_color", d.Id()).Diagnostics()
 	}
 
 	if colors.HeaderColor != nil {
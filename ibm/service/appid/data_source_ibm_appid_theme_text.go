@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,7 +46,7 @@ func dataSourceIBMAppIDThemeTextRead(ctx context.Context, d *schema.ResourceData
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID theme text: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID theme text").Operation("GetThemeTextWithContext").Resource("data.ibm_appid_theme_text", d.Id()).Diagnostics()
 	}
 
 	if text.TabTitle != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -128,7 +129,7 @@ func dataSourceIBMAppIDTokenConfigRead(ctx context.Context, d *schema.ResourceDa
 	tokenConfig, resp, err := appidClient.GetTokensConfigWithContext(ctx, &appid.GetTokensConfigOptions{TenantID: &tenantID})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID token config: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID token config").Operation("GetTokensConfigWithContext").Resource("data.ibm_appid_token_config", d.Id()).Diagnostics()
 	}
 
 	if tokenConfig.AccessTokenClaims != nil {
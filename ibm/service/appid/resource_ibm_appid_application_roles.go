@@ -69,7 +69,7 @@ func resourceIBMAppIDApplicationRolesCreate(ctx context.Context, d *schema.Resou
 	_, resp, err := appIDClient.PutApplicationsRolesWithContext(ctx, roleOpts)
 
 	if err != nil {
-		return diag.Errorf("Error setting application roles: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error setting application roles").Operation("PutApplicationsRolesWithContext").Resource("ibm_appid_application_roles", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, clientID))
@@ -106,7 +106,7 @@ func resourceIBMAppIDApplicationRolesRead(ctx context.Context, d *schema.Resourc
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID application roles: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID application roles").Operation("GetApplicationRolesWithContext").Resource("ibm_appid_application_roles", d.Id()).Diagnostics()
 	}
 
 	var appRoles []interface{}
@@ -149,7 +149,7 @@ func resourceIBMAppIDApplicationRolesUpdate(ctx context.Context, d *schema.Resou
 	_, resp, err := appIDClient.PutApplicationsRolesWithContext(ctx, roleOpts)
 
 	if err != nil {
-		return diag.Errorf("Error updating application roles: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating application roles").Operation("PutApplicationsRolesWithContext").Resource("ibm_appid_application_roles", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMAppIDApplicationRolesRead(ctx, d, meta)
@@ -176,7 +176,7 @@ func resourceIBMAppIDApplicationRolesDelete(ctx context.Context, d *schema.Resou
 	_, resp, err := appIDClient.PutApplicationsRolesWithContext(ctx, roleOpts)
 
 	if err != nil {
-		return diag.Errorf("Error clearing application roles: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error clearing application roles").Operation("PutApplicationsRolesWithContext").Resource("ibm_appid_application_roles", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -67,7 +67,7 @@ func resourceIBMAppIDApplicationScopesCreate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error setting application scopes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error setting application scopes").Operation("PutApplicationsScopesWithContext").Resource("ibm_appid_application_scopes", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, clientID))
@@ -104,7 +104,7 @@ func resourceIBMAppIDApplicationScopesRead(ctx context.Context, d *schema.Resour
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID application scopes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID application scopes").Operation("GetApplicationScopesWithContext").Resource("ibm_appid_application_scopes", d.Id()).Diagnostics()
 	}
 
 	if err := d.Set("scopes", scopes.Scopes); err != nil {
@@ -137,7 +137,7 @@ func resourceIBMAppIDApplicationScopesUpdate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error updating application scopes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating application scopes").Operation("PutApplicationsScopesWithContext").Resource("ibm_appid_application_scopes", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMAppIDApplicationScopesRead(ctx, d, meta)
@@ -162,7 +162,7 @@ func resourceIBMAppIDApplicationScopesDelete(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error clearing application scopes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error clearing application scopes").Operation("PutApplicationsScopesWithContext").Resource("ibm_appid_application_scopes", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -56,7 +57,7 @@ func resourceIBMAppIDAuditStatusRead(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID audit status: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID audit status").Operation("GetAuditStatusWithContext").Resource("ibm_appid_audit_status", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *auditStatus.IsActive)
@@ -87,7 +88,7 @@ func resourceIBMAppIDAuditStatusCreate(ctx context.Context, d *schema.ResourceDa
 			return nil
 		}
 
-		return diag.Errorf("Error setting AppID audit status: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error setting AppID audit status").Operation("SetAuditStatusWithContext").Resource("ibm_appid_audit_status", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -109,7 +110,7 @@ func resourceIBMAppIDAuditStatusDelete(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID audit status: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID audit status").Operation("SetAuditStatusWithContext").Resource("ibm_appid_audit_status", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
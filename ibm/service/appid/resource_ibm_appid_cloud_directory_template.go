@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -100,7 +101,7 @@ func resourceIBMAppIDCloudDirectoryTemplateRead(ctx context.Context, d *schema.R
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Cloud Directory template: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Cloud Directory template").Operation("GetTemplateWithContext").Resource("ibm_appid_cloud_directory_template", d.Id()).Diagnostics()
 	}
 
 	if template.Subject != nil {
@@ -156,7 +157,7 @@ func resourceIBMAppIDCloudDirectoryTemplateCreate(ctx context.Context, d *schema
 	_, resp, err := appIDClient.UpdateTemplateWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory email template: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating AppID Cloud Directory email template").Operation("UpdateTemplateWithContext").Resource("ibm_appid_cloud_directory_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", tenantID, templateName, language))
@@ -182,7 +183,7 @@ func resourceIBMAppIDCloudDirectoryTemplateDelete(ctx context.Context, d *schema
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID Cloud Directory email template: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error deleting AppID Cloud Directory email template").Operation("DeleteTemplateWithContext").Resource("ibm_appid_cloud_directory_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
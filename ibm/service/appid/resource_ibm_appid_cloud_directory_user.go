@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -199,7 +200,7 @@ func resourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Resou
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID user attributes: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID user attributes").Operation("CloudDirectoryGetUserinfoWithContext").Resource("ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 	}
 
 	if attr.Sub != nil {
@@ -243,7 +244,7 @@ func resourceIBMAppIDCloudDirectoryUserCreate(ctx context.Context, d *schema.Res
 	user, resp, err := appIDClient.StartSignUpWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error creating AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating AppID Cloud Directory user").Operation("StartSignUpWithContext").Resource("ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, *user.ID))
@@ -266,7 +267,7 @@ func resourceIBMAppIDCloudDirectoryUserDelete(ctx context.Context, d *schema.Res
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error deleting AppID Cloud Directory user").Operation("DeleteCloudDirectoryUserWithContext").Resource("ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -307,7 +308,7 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 	_, resp, err := appIDClient.UpdateCloudDirectoryUserWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating AppID Cloud Directory user").Operation("UpdateCloudDirectoryUserWithContext").Resource("ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 	}
 
 	if d.HasChanges("password") {
@@ -320,7 +321,7 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 		})
 
 		if err != nil {
-			return diag.Errorf("Error updating AppID Cloud Directory user: %s\n%s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error updating AppID Cloud Directory user").Operation("ChangePasswordWithContext").Resource("ibm_appid_cloud_directory_user", d.Id()).Diagnostics()
 		}
 	}
 
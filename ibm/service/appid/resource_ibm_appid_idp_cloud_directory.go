@@ -98,7 +98,7 @@ func resourceIBMAppIDIDPCloudDirectoryRead(ctx context.Context, d *schema.Resour
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Cloud Directory IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Cloud Directory IDP").Operation("GetCloudDirectoryIDPWithContext").Resource("ibm_appid_idp_cloud_directory", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *config.IsActive)
@@ -169,7 +169,7 @@ func resourceIBMAppIDIDPCloudDirectoryCreate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.SetCloudDirectoryIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying AppID Cloud Directory IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error applying AppID Cloud Directory IDP configuration").Operation("SetCloudDirectoryIDPWithContext").Resource("ibm_appid_idp_cloud_directory", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -195,7 +195,7 @@ func resourceIBMAppIDIDPCloudDirectoryDelete(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.SetCloudDirectoryIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Cloud Directory IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID Cloud Directory IDP configuration").Operation("SetCloudDirectoryIDPWithContext").Resource("ibm_appid_idp_cloud_directory", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
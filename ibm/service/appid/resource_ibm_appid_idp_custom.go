@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,7 +61,7 @@ func resourceIBMAppIDIDPCustomRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID custom IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID custom IDP").Operation("GetCustomIDPWithContext").Resource("ibm_appid_idp_custom", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *config.IsActive)
@@ -102,7 +103,7 @@ func resourceIBMAppIDIDPCustomCreate(ctx context.Context, d *schema.ResourceData
 	_, resp, err := appIDClient.SetCustomIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying AppID custom IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error applying AppID custom IDP configuration").Operation("SetCustomIDPWithContext").Resource("ibm_appid_idp_custom", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -130,7 +131,7 @@ func resourceIBMAppIDIDPCustomDelete(ctx context.Context, d *schema.ResourceData
 	_, resp, err := appIDClient.SetCustomIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID custom IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID custom IDP configuration").Operation("SetCustomIDPWithContext").Resource("ibm_appid_idp_custom", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,7 +84,7 @@ func resourceIBMAppIDIDPFacebookRead(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Facebook IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID Facebook IDP").Operation("GetFacebookIDPWithContext").Resource("ibm_appid_idp_facebook", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *fb.IsActive)
@@ -127,7 +128,7 @@ func resourceIBMAppIDIDPFacebookCreate(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appIDClient.SetFacebookIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying AppID Facebook IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error applying AppID Facebook IDP configuration").Operation("SetFacebookIDPWithContext").Resource("ibm_appid_idp_facebook", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -148,7 +149,7 @@ func resourceIBMAppIDIDPFacebookDelete(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appIDClient.SetFacebookIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Facebook IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID Facebook IDP configuration").Operation("SetFacebookIDPWithContext").Resource("ibm_appid_idp_facebook", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
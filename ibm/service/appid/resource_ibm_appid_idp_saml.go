@@ -133,7 +133,7 @@ func resourceIBMAppIDIDPSAMLRead(ctx context.Context, d *schema.ResourceData, me
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID SAML IDP: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID SAML IDP").Operation("GetSAMLIDPWithContext").Resource("ibm_appid_idp_saml", d.Id()).Diagnostics()
 	}
 
 	d.Set("is_active", *saml.IsActive)
@@ -173,7 +173,7 @@ func resourceIBMAppIDIDPSAMLCreate(ctx context.Context, d *schema.ResourceData,
 	_, resp, err := appIDClient.SetSAMLIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying SAML IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error applying SAML IDP configuration").Operation("SetSAMLIDPWithContext").Resource("ibm_appid_idp_saml", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -266,7 +266,7 @@ func resourceIBMAppIDIDPSAMLDelete(ctx context.Context, d *schema.ResourceData,
 	_, resp, err := appIDClient.SetSAMLIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting SAML IDP configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting SAML IDP configuration").Operation("SetSAMLIDPWithContext").Resource("ibm_appid_idp_saml", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
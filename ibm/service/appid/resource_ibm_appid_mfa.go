@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -56,7 +57,7 @@ func resourceIBMAppIDMFARead(ctx context.Context, d *schema.ResourceData, meta i
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID MFA configuration").Operation("GetMFAConfigWithContext").Resource("ibm_appid_mfa", d.Id()).Diagnostics()
 	}
 
 	if mfa.IsActive != nil {
@@ -86,7 +87,7 @@ func resourceIBMAppIDMFACreate(ctx context.Context, d *schema.ResourceData, meta
 	_, resp, err := appIDClient.UpdateMFAConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating AppID MFA configuration").Operation("UpdateMFAConfigWithContext").Resource("ibm_appid_mfa", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -111,7 +112,7 @@ func resourceIBMAppIDMFADelete(ctx context.Context, d *schema.ResourceData, meta
 	_, resp, err := appIDClient.UpdateMFAConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID MFA configuration").Operation("UpdateMFAConfigWithContext").Resource("ibm_appid_mfa", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -92,7 +93,7 @@ func resourceIBMAppIDRoleCreate(ctx context.Context, d *schema.ResourceData, met
 	role, resp, err := appIDClient.CreateRoleWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error creating AppID role: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating AppID role").Operation("CreateRoleWithContext").Resource("ibm_appid_role", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, *role.ID))
@@ -123,7 +124,7 @@ func resourceIBMAppIDRoleRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID role: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error loading AppID role").Operation("GetRoleWithContext").Resource("ibm_appid_role", d.Id()).Diagnostics()
 	}
 
 	d.Set("name", *role.Name)
@@ -165,7 +166,7 @@ func resourceIBMAppIDRoleDelete(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID role: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error deleting AppID role").Operation("DeleteRoleWithContext").Resource("ibm_appid_role", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -206,7 +207,7 @@ func resourceIBMAppIDRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 	_, resp, err := appIDClient.UpdateRoleWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID role: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating AppID role").Operation("UpdateRoleWithContext").Resource("ibm_appid_role", d.Id()).Diagnostics()
 	}
 
 	return dataSourceIBMAppIDRoleRead(ctx, d, meta)
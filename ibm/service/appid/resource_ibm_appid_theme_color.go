@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -58,7 +59,7 @@ func resourceIBMAppIDThemeColorRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID theme colors: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID theme colors").Operation("GetThemeColorWithContext").Resource("ibm_appid_theme_color", d.Id()).Diagnostics()
 	}
 
 	if colors.HeaderColor != nil {
@@ -87,7 +88,7 @@ func resourceIBMAppIDThemeColorCreate(ctx context.Context, d *schema.ResourceDat
 	resp, err := appIDClient.PostThemeColorWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error setting AppID theme color: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error setting AppID theme color").Operation("PostThemeColorWithContext").Resource("ibm_appid_theme_color", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -116,7 +117,7 @@ func resourceIBMAppIDThemeColorDelete(ctx context.Context, d *schema.ResourceDat
 	resp, err := appIDClient.PostThemeColorWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID theme color: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID theme color").Operation("PostThemeColorWithContext").Resource("ibm_appid_theme_color", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
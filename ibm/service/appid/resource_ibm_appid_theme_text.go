@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +19,9 @@ func ResourceIBMAppIDThemeText() *schema.Resource {
 		ReadContext:   resourceIBMAppIDThemeTextRead,
 		UpdateContext: resourceIBMAppIDThemeTextUpdate,
 		DeleteContext: resourceIBMAppIDThemeTextDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Type:        schema.TypeString,
@@ -57,7 +61,7 @@ func resourceIBMAppIDThemeTextRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID theme text: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting AppID theme text").Operation("GetThemeTextWithContext").Resource("ibm_appid_theme_text", d.Id()).Diagnostics()
 	}
 
 	if text.TabTitle != nil {
@@ -91,7 +95,7 @@ func resourceIBMAppIDThemeTextCreate(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.PostThemeTextWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error setting AppID theme text: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error setting AppID theme text").Operation("PostThemeTextWithContext").Resource("ibm_appid_theme_text", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -121,7 +125,7 @@ func resourceIBMAppIDThemeTextDelete(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.PostThemeTextWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID theme text: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID theme text").Operation("PostThemeTextWithContext").Resource("ibm_appid_theme_text", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
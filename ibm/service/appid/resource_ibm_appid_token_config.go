@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -124,7 +125,7 @@ func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID token configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating AppID token configuration").Operation("PutTokensConfigWithContext").Resource("ibm_appid_token_config", d.Id()).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -153,7 +154,7 @@ func resourceIBMAppIDTokenConfigRead(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.Errorf("Error reading AppID token configuration: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error reading AppID token configuration").Operation("GetTokensConfigWithContext").Resource("ibm_appid_token_config", d.Id()).Diagnostics()
 	}
 
 	if tokenConfig.Access != nil {
@@ -315,7 +316,7 @@ func resourceIBMAppIDTokenConfigDelete(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID token configuration: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error resetting AppID token configuration").Operation("PutTokensConfigWithContext").Resource("ibm_appid_token_config", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
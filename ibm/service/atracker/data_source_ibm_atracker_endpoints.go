@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAtrackerEndpoints() *schema.Resource {
@@ -64,7 +66,7 @@ func dataSourceIBMAtrackerEndpointsRead(context context.Context, d *schema.Resou
 	endpoints, response, err := atrackerClient.GetEndpointsWithContext(context, getEndpointsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetEndpointsWithContext").Resource("data.ibm_atracker_endpoints", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMAtrackerEndpointsID(d))
@@ -15,6 +15,8 @@ import (
 
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAtrackerRoutes() *schema.Resource {
@@ -129,7 +131,7 @@ func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.Resource
 	routeList, response, err := atrackerClientv2.ListRoutesWithContext(context, listRoutesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListRoutesWithContext").Resource("data.ibm_atracker_routes", d.Id()).Diagnostics()
 	}
 	// TODO: Remove after deprecation
 	if len(routeList.Routes) == 0 {
@@ -141,7 +143,7 @@ func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.Resource
 			return nil
 		} else if err != nil {
 			log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListRoutesWithContext").Resource("data.ibm_atracker_routes", d.Id()).Diagnostics()
 		}
 		var matchRoutesV1 []atrackerv1.Route
 		var name string
@@ -15,6 +15,8 @@ import (
 
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAtrackerTargets() *schema.Resource {
@@ -290,7 +292,7 @@ func DataSourceIBMAtrackerTargetsRead(context context.Context, d *schema.Resourc
 		targetList, response, err := atrackerClientv2.ListTargetsWithContext(context, listTargetsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListTargetsWithContext").Resource("data.ibm_atracker_targets", d.Id()).Diagnostics()
 		}
 
 		// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -337,7 +339,7 @@ func DataSourceIBMAtrackerTargetsRead(context context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, responseV1)
-	return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, responseV1))
+	return flex.NewAPIError(err, responseV1).Operation("ListTargetsWithContext").Resource("data.ibm_atracker_targets", d.Id()).Diagnostics()
 }
 
 // DataSourceIBMAtrackerTargetsID returns a reasonable ID for the list.
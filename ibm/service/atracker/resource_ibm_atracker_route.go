@@ -140,7 +140,7 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 	route, response, err := atrackerClient.CreateRouteWithContext(context, createRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*route.ID)
@@ -165,7 +165,7 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 
 	if err != nil && response != nil && response.StatusCode != 404 {
 		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 	}
 	if err == nil && response != nil {
 		if err = d.Set("name", route.Name); err != nil {
@@ -205,7 +205,7 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 				return nil
 			}
 			log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, responseV1)
-			return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, responseV1))
+			return flex.NewAPIError(err, responseV1).Operation("GetRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 		}
 		if err = d.Set("name", routeV1.Name); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
@@ -265,7 +265,7 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := atrackerClient.ReplaceRouteWithContext(context, replaceRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ReplaceRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 		}
 		return resourceIBMAtrackerRouteRead(context, d, meta)
 	}
@@ -286,7 +286,7 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 	_, response, err := atrackerClientV1.ReplaceRouteWithContext(context, replaceRouteOptionsV1)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ReplaceRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMAtrackerRouteRead(context, d, meta)
@@ -308,7 +308,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 		response, err := atrackerClient.DeleteRouteWithContext(context, deleteRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("DeleteRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 		}
 	} else {
 		deleteRouteOptions := &atrackerv1.DeleteRouteOptions{}
@@ -318,7 +318,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 		response, err := atrackerClientV1.DeleteRouteWithContext(context, deleteRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("DeleteRouteWithContext").Resource("ibm_atracker_route", d.Id()).Diagnostics()
 		}
 	}
 
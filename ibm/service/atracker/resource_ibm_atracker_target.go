@@ -310,7 +310,7 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTargetWithContext").Resource("ibm_atracker_target", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*target.ID)
@@ -335,7 +335,7 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 			return nil
 		}
 		log.Printf("[DEBUG] GetTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTargetWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTargetWithContext").Resource("ibm_atracker_target", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("name", target.Name); err != nil {
@@ -479,7 +479,7 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 		_, response, err := atrackerClient.ReplaceTargetWithContext(context, replaceTargetOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTargetWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTargetWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ReplaceTargetWithContext").Resource("ibm_atracker_target", d.Id()).Diagnostics()
 		}
 	}
 
@@ -499,7 +499,7 @@ func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.Resource
 	_, response, err := atrackerClient.DeleteTargetWithContext(context, deleteTargetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTargetWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTargetWithContext").Resource("ibm_atracker_target", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -394,7 +394,7 @@ func dataSourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData,
 	catalog, response, err := catalogManagementClient.GetCatalogWithContext(context, getCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCatalogWithContext").Resource("data.ibm_cm_catalog", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getCatalogOptions.CatalogIdentifier))
@@ -2379,7 +2379,7 @@ func dataSourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("data.ibm_cm_offering", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*getOfferingOptions.OfferingID)
@@ -419,7 +419,7 @@ func resourceIBMCmCatalogCreate(context context.Context, d *schema.ResourceData,
 	catalog, response, err := catalogManagementClient.CreateCatalogWithContext(context, createCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateCatalogWithContext").Resource("ibm_cm_catalog", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*catalog.ID)
@@ -444,7 +444,7 @@ func resourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData, m
 			return nil
 		}
 		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCatalogWithContext").Resource("ibm_cm_catalog", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("label", catalog.Label); err != nil {
@@ -590,7 +590,7 @@ func resourceIBMCmCatalogUpdate(context context.Context, d *schema.ResourceData,
 	_, response, err := catalogManagementClient.ReplaceCatalogWithContext(context, replaceCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ReplaceCatalogWithContext").Resource("ibm_cm_catalog", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMCmCatalogRead(context, d, meta)
@@ -609,7 +609,7 @@ func resourceIBMCmCatalogDelete(context context.Context, d *schema.ResourceData,
 	response, err := catalogManagementClient.DeleteCatalogWithContext(context, deleteCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteCatalogWithContext").Resource("ibm_cm_catalog", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
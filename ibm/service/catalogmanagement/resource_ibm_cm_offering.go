@@ -2421,7 +2421,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 				return nil
 			}
 			log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 
 		d.SetId(*offering.ID)
@@ -2628,7 +2628,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 	offering, response, err := catalogManagementClient.CreateOfferingWithContext(context, createOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*offering.ID)
@@ -2647,7 +2647,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 		_, response, err = catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 		if err != nil {
 			log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("AddOfferingAccessListWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 	}
 
@@ -2665,7 +2665,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 		_, response, err = catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ShareOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 	}
 
@@ -2690,7 +2690,7 @@ func resourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("catalog_id", getOfferingOptions.CatalogIdentifier); err != nil {
@@ -2924,7 +2924,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 	}
 
 	updateOfferingOptions.SetCatalogIdentifier(*offering.CatalogID)
@@ -3434,7 +3434,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		_, response, err = catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 		if err != nil {
 			log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("AddOfferingAccessListWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 	}
 
@@ -3452,7 +3452,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		_, response, err = catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ShareOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 	}
 
@@ -3460,7 +3460,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 		}
 	}
 
@@ -3481,7 +3481,7 @@ func resourceIBMCmOfferingDelete(context context.Context, d *schema.ResourceData
 	response, err := catalogManagementClient.DeleteOfferingWithContext(context, deleteOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteOfferingWithContext").Resource("ibm_cm_offering", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
@@ -188,7 +189,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 				return nil
 			}
 			log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVersionWithContext").Resource("ibm_cm_validation", d.Id()).Diagnostics()
 		}
 
 		version = offering.Kinds[0].Versions[0]
@@ -240,7 +241,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	response, err := catalogManagementClient.ValidateInstallWithContext(context, validateInstallOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ValidateInstallWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ValidateInstallWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ValidateInstallWithContext").Resource("ibm_cm_validation", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*validateInstallOptions.VersionLocID)
@@ -251,7 +252,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	result, response, err := catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetValidationStatusWithContext").Resource("ibm_cm_validation", d.Id()).Diagnostics()
 	}
 
 	status := *result.State
@@ -274,7 +275,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 		result, response, err = catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetValidationStatusWithContext").Resource("ibm_cm_validation", d.Id()).Diagnostics()
 		}
 	}
 
@@ -307,7 +308,7 @@ func resourceIBMCmValidationRead(context context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVersionWithContext").Resource("ibm_cm_validation", d.Id()).Diagnostics()
 	}
 
 	version := offering.Kinds[0].Versions[0]
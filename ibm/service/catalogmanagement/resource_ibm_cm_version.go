@@ -1634,13 +1634,13 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 
 	offering, response, err := catalogManagementClient.ImportOfferingVersionWithContext(context, importOfferingVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ImportOfferingVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ImportOfferingVersionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ImportOfferingVersionWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 
 	activeVersionID, err := getVersionFromOffering(*oldOffering, *offering)
@@ -1829,7 +1829,7 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateOfferingWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 		}
 	}
 
@@ -1853,7 +1853,7 @@ func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, m
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVersionWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 
 	version := offering.Kinds[0].Versions[0]
@@ -2103,7 +2103,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVersionWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 	activeVersion := partialOffering.Kinds[0].Versions[0]
 
@@ -2118,7 +2118,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetOfferingWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 
 	updateOfferingOptions := &catalogmanagementv1.UpdateOfferingOptions{}
@@ -2275,7 +2275,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateOfferingWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 		}
 	}
 
@@ -2295,7 +2295,7 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	response, err := catalogManagementClient.DeleteVersionWithContext(context, deleteVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVersionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteVersionWithContext").Resource("ibm_cm_version", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
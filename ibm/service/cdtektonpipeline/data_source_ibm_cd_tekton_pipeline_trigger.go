@@ -272,7 +272,7 @@ func dataSourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema
 	TriggerIntf, response, err := cdTektonPipelineClient.GetTektonPipelineTriggerWithContext(context, getTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelineTriggerWithContext").Resource("data.ibm_cd_tekton_pipeline_trigger", d.Id()).Diagnostics()
 	}
 	trigger := TriggerIntf.(*cdtektonpipelinev2.Trigger)
 
@@ -514,7 +514,7 @@ func resourceIBMCdTektonPipelineCreate(context context.Context, d *schema.Resour
 	tektonPipeline, response, err := cdTektonPipelineClient.CreateTektonPipelineWithContext(context, createTektonPipelineOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTektonPipelineWithContext").Resource("ibm_cd_tekton_pipeline", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*tektonPipeline.ID)
@@ -539,7 +539,7 @@ func resourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelineWithContext").Resource("ibm_cd_tekton_pipeline", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("enable_notifications", tektonPipeline.EnableNotifications); err != nil {
@@ -669,7 +669,7 @@ func resourceIBMCdTektonPipelineUpdate(context context.Context, d *schema.Resour
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineWithContext(context, updateTektonPipelineOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTektonPipelineWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateTektonPipelineWithContext").Resource("ibm_cd_tekton_pipeline", d.Id()).Diagnostics()
 		}
 	}
 
@@ -689,7 +689,7 @@ func resourceIBMCdTektonPipelineDelete(context context.Context, d *schema.Resour
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineWithContext(context, deleteTektonPipelineOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTektonPipelineWithContext").Resource("ibm_cd_tekton_pipeline", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -142,7 +142,7 @@ func resourceIBMCdTektonPipelineDefinitionCreate(context context.Context, d *sch
 	definition, response, err := cdTektonPipelineClient.CreateTektonPipelineDefinitionWithContext(context, createTektonPipelineDefinitionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTektonPipelineDefinitionWithContext").Resource("ibm_cd_tekton_pipeline_definition", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelineDefinitionOptions.PipelineID, *definition.ID))
@@ -173,7 +173,7 @@ func resourceIBMCdTektonPipelineDefinitionRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelineDefinitionWithContext").Resource("ibm_cd_tekton_pipeline_definition", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineDefinitionOptions.PipelineID); err != nil {
@@ -230,7 +230,7 @@ func resourceIBMCdTektonPipelineDefinitionUpdate(context context.Context, d *sch
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineDefinitionWithContext(context, replaceTektonPipelineDefinitionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ReplaceTektonPipelineDefinitionWithContext").Resource("ibm_cd_tekton_pipeline_definition", d.Id()).Diagnostics()
 		}
 	}
 
@@ -256,7 +256,7 @@ func resourceIBMCdTektonPipelineDefinitionDelete(context context.Context, d *sch
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineDefinitionWithContext(context, deleteTektonPipelineDefinitionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTektonPipelineDefinitionWithContext").Resource("ibm_cd_tekton_pipeline_definition", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -154,7 +154,7 @@ func resourceIBMCdTektonPipelinePropertyCreate(context context.Context, d *schem
 	property, response, err := cdTektonPipelineClient.CreateTektonPipelinePropertiesWithContext(context, createTektonPipelinePropertiesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTektonPipelinePropertiesWithContext").Resource("ibm_cd_tekton_pipeline_property", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelinePropertiesOptions.PipelineID, *property.Name))
@@ -185,7 +185,7 @@ func resourceIBMCdTektonPipelinePropertyRead(context context.Context, d *schema.
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelinePropertyWithContext").Resource("ibm_cd_tekton_pipeline_property", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelinePropertyOptions.PipelineID); err != nil {
@@ -269,7 +269,7 @@ func resourceIBMCdTektonPipelinePropertyUpdate(context context.Context, d *schem
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelinePropertyWithContext(context, replaceTektonPipelinePropertyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ReplaceTektonPipelinePropertyWithContext").Resource("ibm_cd_tekton_pipeline_property", d.Id()).Diagnostics()
 		}
 	}
 
@@ -295,7 +295,7 @@ func resourceIBMCdTektonPipelinePropertyDelete(context context.Context, d *schem
 	response, err := cdTektonPipelineClient.DeleteTektonPipelinePropertyWithContext(context, deleteTektonPipelinePropertyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTektonPipelinePropertyWithContext").Resource("ibm_cd_tekton_pipeline_property", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
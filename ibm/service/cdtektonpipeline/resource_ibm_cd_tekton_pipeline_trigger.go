@@ -409,7 +409,7 @@ func resourceIBMCdTektonPipelineTriggerCreate(context context.Context, d *schema
 	triggerIntf, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerWithContext(context, createTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTektonPipelineTriggerWithContext").Resource("ibm_cd_tekton_pipeline_trigger", d.Id()).Diagnostics()
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -441,7 +441,7 @@ func resourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelineTriggerWithContext").Resource("ibm_cd_tekton_pipeline_trigger", d.Id()).Diagnostics()
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -637,7 +637,7 @@ func resourceIBMCdTektonPipelineTriggerUpdate(context context.Context, d *schema
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineTriggerWithContext(context, updateTektonPipelineTriggerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateTektonPipelineTriggerWithContext").Resource("ibm_cd_tekton_pipeline_trigger", d.Id()).Diagnostics()
 		}
 	}
 
@@ -663,7 +663,7 @@ func resourceIBMCdTektonPipelineTriggerDelete(context context.Context, d *schema
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerWithContext(context, deleteTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTektonPipelineTriggerWithContext").Resource("ibm_cd_tekton_pipeline_trigger", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
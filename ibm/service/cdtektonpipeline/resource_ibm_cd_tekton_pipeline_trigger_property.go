@@ -171,7 +171,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyCreate(context context.Context, d
 	triggerProperty, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerPropertiesWithContext(context, createTektonPipelineTriggerPropertiesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTektonPipelineTriggerPropertiesWithContext").Resource("ibm_cd_tekton_pipeline_trigger_property", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *createTektonPipelineTriggerPropertiesOptions.PipelineID, *createTektonPipelineTriggerPropertiesOptions.TriggerID, *triggerProperty.Name))
@@ -203,7 +203,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyRead(context context.Context, d *
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTektonPipelineTriggerPropertyWithContext").Resource("ibm_cd_tekton_pipeline_trigger_property", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineTriggerPropertyOptions.PipelineID); err != nil {
@@ -295,7 +295,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyUpdate(context context.Context, d
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineTriggerPropertyWithContext(context, replaceTektonPipelineTriggerPropertyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ReplaceTektonPipelineTriggerPropertyWithContext").Resource("ibm_cd_tekton_pipeline_trigger_property", d.Id()).Diagnostics()
 		}
 	}
 
@@ -322,7 +322,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyDelete(context context.Context, d
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerPropertyWithContext(context, deleteTektonPipelineTriggerPropertyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTektonPipelineTriggerPropertyWithContext").Resource("ibm_cd_tekton_pipeline_trigger_property", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
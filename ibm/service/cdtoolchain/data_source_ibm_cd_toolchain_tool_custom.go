@@ -152,7 +152,7 @@ func dataSourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.R
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("data.ibm_cd_toolchain_tool_custom", d.Id()).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "customtool" {
@@ -182,7 +182,7 @@ func dataSourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.R
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("data.ibm_cd_toolchain_tool_gitlab", d.Id()).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "gitlab" {
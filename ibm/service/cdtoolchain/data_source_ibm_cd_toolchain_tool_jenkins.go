@@ -139,7 +139,7 @@ func dataSourceIBMCdToolchainToolJenkinsRead(context context.Context, d *schema.
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("data.ibm_cd_toolchain_tool_jenkins", d.Id()).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "jenkins" {
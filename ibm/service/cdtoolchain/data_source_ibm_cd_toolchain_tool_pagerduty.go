@@ -128,7 +128,7 @@ func dataSourceIBMCdToolchainToolPagerdutyRead(context context.Context, d *schem
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("data.ibm_cd_toolchain_tool_pagerduty", d.Id()).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "pagerduty" {
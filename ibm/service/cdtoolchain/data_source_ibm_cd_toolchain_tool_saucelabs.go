@@ -123,7 +123,7 @@ func dataSourceIBMCdToolchainToolSaucelabsRead(context context.Context, d *schem
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("data.ibm_cd_toolchain_tool_saucelabs", d.Id()).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "saucelabs" {
@@ -142,7 +142,7 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 	toolchainPost, response, err := cdToolchainClient.CreateToolchainWithContext(context, createToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolchainWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolchainWithContext").Resource("ibm_cd_toolchain", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*toolchainPost.ID)
@@ -167,7 +167,7 @@ func resourceIBMCdToolchainRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolchainByIDWithContext").Resource("ibm_cd_toolchain", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("name", toolchain.Name); err != nil {
@@ -240,7 +240,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := cdToolchainClient.UpdateToolchainWithContext(context, updateToolchainOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolchainWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolchainWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolchainWithContext").Resource("ibm_cd_toolchain", d.Id()).Diagnostics()
 		}
 	}
 
@@ -260,7 +260,7 @@ func resourceIBMCdToolchainDelete(context context.Context, d *schema.ResourceDat
 	response, err := cdToolchainClient.DeleteToolchainWithContext(context, deleteToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolchainWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolchainWithContext").Resource("ibm_cd_toolchain", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
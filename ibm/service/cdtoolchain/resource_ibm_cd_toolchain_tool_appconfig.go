@@ -191,7 +191,7 @@ func resourceIBMCdToolchainToolAppconfigCreate(context context.Context, d *schem
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolWithContext").Resource("ibm_cd_toolchain_tool_appconfig", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -222,7 +222,7 @@ func resourceIBMCdToolchainToolAppconfigRead(context context.Context, d *schema.
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("ibm_cd_toolchain_tool_appconfig", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -320,7 +320,7 @@ func resourceIBMCdToolchainToolAppconfigUpdate(context context.Context, d *schem
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolWithContext").Resource("ibm_cd_toolchain_tool_appconfig", d.Id()).Diagnostics()
 		}
 	}
 
@@ -346,7 +346,7 @@ func resourceIBMCdToolchainToolAppconfigDelete(context context.Context, d *schem
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolWithContext").Resource("ibm_cd_toolchain_tool_appconfig", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -206,7 +206,7 @@ func resourceIBMCdToolchainToolArtifactoryCreate(context context.Context, d *sch
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolWithContext").Resource("ibm_cd_toolchain_tool_artifactory", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -237,7 +237,7 @@ func resourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("ibm_cd_toolchain_tool_artifactory", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -321,7 +321,7 @@ func resourceIBMCdToolchainToolArtifactoryUpdate(context context.Context, d *sch
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolWithContext").Resource("ibm_cd_toolchain_tool_artifactory", d.Id()).Diagnostics()
 		}
 	}
 
@@ -347,7 +347,7 @@ func resourceIBMCdToolchainToolArtifactoryDelete(context context.Context, d *sch
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolWithContext").Resource("ibm_cd_toolchain_tool_artifactory", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -283,7 +283,7 @@ func resourceIBMCdToolchainToolBitbucketgitCreate(context context.Context, d *sc
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolWithContext").Resource("ibm_cd_toolchain_tool_bitbucketgit", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -314,7 +314,7 @@ func resourceIBMCdToolchainToolBitbucketgitRead(context context.Context, d *sche
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("ibm_cd_toolchain_tool_bitbucketgit", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -404,7 +404,7 @@ func resourceIBMCdToolchainToolBitbucketgitUpdate(context context.Context, d *sc
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolWithContext").Resource("ibm_cd_toolchain_tool_bitbucketgit", d.Id()).Diagnostics()
 		}
 	}
 
@@ -430,7 +430,7 @@ func resourceIBMCdToolchainToolBitbucketgitDelete(context context.Context, d *sc
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolWithContext").Resource("ibm_cd_toolchain_tool_bitbucketgit", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -200,7 +200,7 @@ func resourceIBMCdToolchainToolCustomCreate(context context.Context, d *schema.R
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolWithContext").Resource("ibm_cd_toolchain_tool_custom", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -231,7 +231,7 @@ func resourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("ibm_cd_toolchain_tool_custom", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -327,7 +327,7 @@ func resourceIBMCdToolchainToolCustomUpdate(context context.Context, d *schema.R
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolWithContext").Resource("ibm_cd_toolchain_tool_custom", d.Id()).Diagnostics()
 		}
 	}
 
@@ -353,7 +353,7 @@ func resourceIBMCdToolchainToolCustomDelete(context context.Context, d *schema.R
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolWithContext").Resource("ibm_cd_toolchain_tool_custom", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -141,7 +141,7 @@ func resourceIBMCdToolchainToolDevopsinsightsCreate(context context.Context, d *
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateToolWithContext").Resource("ibm_cd_toolchain_tool_devopsinsights", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -172,7 +172,7 @@ func resourceIBMCdToolchainToolDevopsinsightsRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetToolByIDWithContext").Resource("ibm_cd_toolchain_tool_devopsinsights", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -247,7 +247,7 @@ func resourceIBMCdToolchainToolDevopsinsightsUpdate(context context.Context, d *
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateToolWithContext").Resource("ibm_cd_toolchain_tool_devopsinsights", d.Id()).Diagnostics()
 		}
 	}
 
@@ -273,7 +273,7 @@ func resourceIBMCdToolchainToolDevopsinsightsDelete(context context.Context, d *
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteToolWithContext").Resource("ibm_cd_toolchain_tool_devopsinsights", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -100,7 +100,7 @@ func dataIBMCISFiltersRead(d *schema.ResourceData, meta interface{}) error {
 
 	result, resp, err := cisClient.ListAllFilters(cisClient.NewListAllFiltersOptions(xAuthtoken, crn, zoneID))
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error Listing all filters %q: %s %s", d.Id(), err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error Listing all filters %q", d.Id()).Operation("ListAllFilters").Resource("data.ibm_cis_filters", d.Id())
 	}
 
 	filtersList := make([]map[string]interface{}, 0)
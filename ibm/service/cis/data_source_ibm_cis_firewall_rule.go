@@ -5,7 +5,6 @@ package cis
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -104,7 +103,7 @@ func dataSourceIBMCISFirewallRulesRead(context context.Context, d *schema.Resour
 
 	result, resp, err := cisClient.ListAllFirewallRules(cisClient.NewListAllFirewallRulesOptions(xAuthtoken, crn, zoneID))
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the  firewall rules %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error listing the firewall rules").Operation("ListAllFirewallRules").Resource("data.ibm_cis_firewall_rule", d.Id()).Diagnostics()
 	}
 
 	fwrList := make([]map[string]interface{}, 0)
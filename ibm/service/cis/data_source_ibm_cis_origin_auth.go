@@ -150,7 +150,7 @@ func dataIBMCISOriginAuthRead(context context.Context, d *schema.ResourceData, m
 		zoneSettingsResult, zoneSettingsResponse, zoneSettingsErr := sess.GetZoneOriginPullSettings(zoneSettingsOpt)
 
 		if zoneSettingsErr != nil || zoneSettingsResponse == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Zone Level Origin Pull Settings: %s, Response: %s", zoneSettingsErr, zoneSettingsResponse))
+			return flex.NewAPIError(zoneSettingsErr, zoneSettingsResponse).Summary("Error Getting Zone Level Origin Pull Settings").Operation("GetZoneOriginPullSettings").Resource("data.ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 
 		zoneSettings := zoneSettingsResult.Result.Enabled
@@ -161,7 +161,7 @@ func dataIBMCISOriginAuthRead(context context.Context, d *schema.ResourceData, m
 		zoneCertListResult, zoneCertListResponse, zoneCertListErr := sess.ListZoneOriginPullCertificates(zoneCertListOpt)
 
 		if zoneCertListErr != nil || zoneCertListResponse == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Zone Level Origin Pull Settings: %s, Response: %s", zoneCertListErr, zoneCertListResponse))
+			return flex.NewAPIError(zoneCertListErr, zoneCertListResponse).Summary("Error Listing Zone Level Origin Pull Certificates").Operation("ListZoneOriginPullCertificates").Resource("data.ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 
 		zoneCertLists := make([]map[string]interface{}, 0)
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -51,8 +50,9 @@ func ResourceIBMCISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			validate.InvokeCloudData("ibm_cis"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -69,9 +69,10 @@ func ResourceIBMCISInstance() *schema.Resource {
 			},
 
 			"plan": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The plan type of the service",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The plan type of the service",
+				ValidateFunc: validate.InvokeValidator("ibm_cis", "plan"),
 			},
 
 			"guid": {
@@ -109,6 +110,13 @@ func ResourceIBMCISInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_cis", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -160,6 +168,14 @@ func ResourceIBMCISValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "plan",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              validate.CloudDataResourcePlan,
+			CloudDataRange:             []string{"service:internet-svcs"}})
 
 	ibmCISResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cis", Schema: validateSchema}
 	return &ibmCISResourceValidator
@@ -234,10 +250,9 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error creating resource instance").Operation("CreateResourceInstance").Resource("ibm_cis", d.Id())
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -278,7 +293,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -291,6 +306,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			"Error on get of ibm cis tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -371,7 +387,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -382,7 +398,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error updating resource instance").Operation("UpdateResourceInstance").Resource("ibm_cis", d.Id())
 	}
 
 	_, err = waitForCISInstanceUpdate(d, meta)
@@ -414,7 +430,7 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error deleting resource instance").Operation("DeleteResourceInstance").Resource("ibm_cis", d.Id())
 		}
 	}
 
@@ -444,7 +460,7 @@ func ResourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("[ERROR] Error getting cis instance: %s %s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting cis instance").Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, cisInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -473,12 +489,12 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("The resource instance %s does not exist anymore", d.Id()).Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 				}
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 			}
 			return instance, *instance.State, nil
 		},
@@ -508,12 +524,12 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("The resource instance %s does not exist anymore", d.Id()).Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 				}
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 			}
 			return instance, *instance.State, nil
 		},
@@ -547,7 +563,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed to delete", d.Id()).Operation("GetResourceInstance").Resource("ibm_cis", d.Id())
 			}
 			return instance, *instance.State, nil
 		},
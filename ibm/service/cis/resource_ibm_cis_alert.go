@@ -206,7 +206,7 @@ func ResourceIBMCISAlertPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	opt.Mechanisms = mechanismsOpt
 	result, resp, err := sess.CreateAlertPolicy(opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Alert Policy %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating Alert Policy").Operation("CreateAlertPolicy").Resource("ibm_cis_alert", d.Id())
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
 	d.Set(cisAlertID, *result.Result.ID)
@@ -232,7 +232,7 @@ func ResourceIBMCISAlertPolicyRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting alert policy detail %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error getting alert policy detail").Operation("GetAlertPolicy").Resource("ibm_cis_alert", d.Id())
 	}
 
 	d.Set(cisID, crn)
@@ -346,7 +346,7 @@ func ResourceIBMCISAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 
 		result, resp, err := sess.UpdateAlertPolicy(opt)
 		if err != nil || result == nil {
-			return fmt.Errorf("[ERROR] Error while Update Alert Policy %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error while Update Alert Policy").Operation("UpdateAlertPolicy").Resource("ibm_cis_alert", d.Id())
 		}
 	}
 
@@ -369,7 +369,7 @@ func ResourceIBMCISAlertPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting the alert %s:%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting the alert").Operation("DeleteAlertPolicy").Resource("ibm_cis_alert", d.Id())
 	}
 	return nil
 }
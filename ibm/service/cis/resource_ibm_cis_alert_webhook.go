@@ -103,7 +103,7 @@ func ResourceIBMCISWebhookCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	result, resp, err := sess.CreateAlertWebhook(opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Webhooks  %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating Webhooks").Operation("CreateAlertWebhook").Resource("ibm_cis_alert_webhook", d.Id())
 	}
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.Result.ID, crn))
 	return ResourceIBMCISWebhookRead(d, meta)
@@ -127,7 +127,7 @@ func ResourceIBMCISWebhookRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting webhook detail %s, %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting webhook detail").Operation("GetWebhook").Resource("ibm_cis_alert_webhook", d.Id())
 	}
 	d.Set(cisID, crn)
 	d.Set(cisWebhookID, result.Result.ID)
@@ -186,7 +186,7 @@ func ResourceIBMCISWebhookDelete(d *schema.ResourceData, meta interface{}) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting the Webhook %s:%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting the Webhook").Operation("DeleteWebhook").Resource("ibm_cis_alert_webhook", d.Id())
 	}
 	return nil
 
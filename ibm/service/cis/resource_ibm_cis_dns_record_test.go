@@ -4,12 +4,14 @@
 package cis_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -378,3 +380,24 @@ func testAccCheckIBMCisDNSRecordConfigProxied(resourceID string, CisDomainStatic
 	  }
 `, resourceID)
 }
+
+func TestIBMCisDNSRecordStateUpgradeV0(t *testing.T) {
+	crn := "crn:v1:bluemix:public:internet-svcs:global:a/account:instance::"
+	upgrade := cis.ResourceIBMCISDnsRecord().StateUpgraders[0].Upgrade
+	for _, c := range []struct {
+		state map[string]interface{}
+		id    string
+	}{
+		{map[string]interface{}{"id": "record", "cis_id": crn, "domain_id": "zone:" + crn}, "record:zone:" + crn},
+		{map[string]interface{}{"id": "record:zone", "cis_id": crn}, "record:zone:" + crn},
+		{map[string]interface{}{"id": "record:zone:" + crn, "cis_id": crn, "domain_id": "other"}, "record:zone:" + crn},
+	} {
+		state, err := upgrade(context.Background(), c.state, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state["id"] != c.id {
+			t.Fatalf("Expected the ID %s, got %v", c.id, state["id"])
+		}
+	}
+}
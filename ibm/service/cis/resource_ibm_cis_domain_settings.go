@@ -70,7 +70,7 @@ const (
 )
 
 func ResourceIBMCISSettings() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -453,7 +453,7 @@ func ResourceIBMCISSettings() *schema.Resource {
 		Update:   resourceCISSettingsUpdate,
 		Delete:   resourceCISSettingsDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.UpgradeCisID(true))
 }
 
 func ResourceIBMCISDomainSettingValidator() *validate.ResourceValidator {
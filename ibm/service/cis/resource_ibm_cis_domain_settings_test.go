@@ -4,10 +4,12 @@
 package cis_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -160,3 +162,20 @@ func testAccCheckCisSettingsConfigBasic4(id string, CisDomainStatic string) stri
 	  }
 `, id)
 }
+
+func TestIBMCisSettingsStateUpgradeV0(t *testing.T) {
+	crn := "crn:v1:bluemix:public:internet-svcs:global:a/account:instance::"
+	upgrade := cis.ResourceIBMCISSettings().StateUpgraders[0].Upgrade
+
+	state, err := upgrade(context.Background(), map[string]interface{}{"id": "zone", "cis_id": crn, "domain_id": "zone"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["id"] != "zone:"+crn {
+		t.Fatalf("Expected the ID zone:%s, got %v", crn, state["id"])
+	}
+
+	if _, err := upgrade(context.Background(), map[string]interface{}{"id": "zone"}, nil); err == nil {
+		t.Fatal("Expected an error without cis_id")
+	}
+}
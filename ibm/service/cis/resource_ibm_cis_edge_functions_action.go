@@ -121,7 +121,7 @@ func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
 	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", resp)
+		return flex.NewAPIError(err, resp).Summary("Error reading the edge functions action").Operation("GetEdgeFunctionsAction").Resource("ibm_cis_edge_functions_action", d.Id())
 	}
 
 	// read script content
@@ -163,7 +163,7 @@ func ResourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 			log.Printf("Edge functions action script is not found")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error: %v", response)
+		return false, flex.NewAPIError(err, response).Summary("Error reading the edge functions action").Operation("GetEdgeFunctionsAction").Resource("ibm_cis_edge_functions_action", d.Id())
 	}
 	return true, nil
 }
@@ -181,7 +181,7 @@ func ResourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
 	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in edge function action script deletion: %v", response)
+		return flex.NewAPIError(err, response).Summary("Error in edge function action script deletion").Operation("DeleteEdgeFunctionsAction").Resource("ibm_cis_edge_functions_action", d.Id())
 	}
 	return nil
 }
@@ -148,7 +148,7 @@ func ResourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
 	result, resp, err := cisClient.GetEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", resp)
+		return flex.NewAPIError(err, resp).Summary("Error reading the edge functions trigger").Operation("GetEdgeFunctionsTrigger").Resource("ibm_cis_edge_functions_trigger", d.Id())
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -176,7 +176,7 @@ func ResourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta inter
 			log.Printf("Edge functions trigger route is not found")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error: %v", response)
+		return false, flex.NewAPIError(err, response).Summary("Error reading the edge functions trigger").Operation("GetEdgeFunctionsTrigger").Resource("ibm_cis_edge_functions_trigger", d.Id())
 	}
 	return true, nil
 }
@@ -194,7 +194,7 @@ func ResourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta inter
 	opt := cisClient.NewDeleteEdgeFunctionsTriggerOptions(routeID)
 	_, response, err := cisClient.DeleteEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in edge function trigger route deletion: %v", response)
+		return flex.NewAPIError(err, response).Summary("Error in edge function trigger route deletion").Operation("DeleteEdgeFunctionsTrigger").Resource("ibm_cis_edge_functions_trigger", d.Id())
 	}
 	return nil
 }
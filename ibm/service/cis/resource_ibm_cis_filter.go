@@ -103,7 +103,7 @@ func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error
 
 	result, resp, err := cisClient.CreateFilter(opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Filter for zone %q: %s %s", zoneID, err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating Filter for zone %q", zoneID).Operation("CreateFilter").Resource("ibm_cis_filter", d.Id())
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result[0].ID, zoneID, crn))
 	return ResourceIBMCISFilterRead(d, meta)
@@ -133,7 +133,7 @@ func ResourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error finding GetFilter %q: %s %s", d.Id(), err, response)
+		return flex.NewAPIError(err, response).Summary("Error finding GetFilter %q", d.Id()).Operation("GetFilter").Resource("ibm_cis_filter", d.Id())
 	}
 	if result.Result != nil {
 		d.Set(cisID, crn)
@@ -188,7 +188,7 @@ func ResourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error
 
 		result, resp, err := cisClient.UpdateFilters(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Filter for zone %q: %s %s", zoneID, err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error updating Filter for zone %q", zoneID).Operation("UpdateFilters").Resource("ibm_cis_filter", d.Id())
 		}
 
 		if *result.Result[0].ID == "" {
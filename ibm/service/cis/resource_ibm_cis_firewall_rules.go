@@ -158,7 +158,7 @@ func ResourceIBMCISFirewallrulesRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the firewall rules %s:%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error reading the firewall rules").Operation("GetFirewallRuleWithContext").Resource("ibm_cis_firewall_rules", d.Id()).Diagnostics()
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -249,7 +249,7 @@ func ResourceIBMCISFirewallrulesDelete(context context.Context, d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the  custom resolver %s:%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error deleting the firewall rules").Operation("DeleteFirewallRulesWithContext").Resource("ibm_cis_firewall_rules", d.Id()).Diagnostics()
 	}
 
 	if id, ok := d.GetOk(cisFilterID); ok {
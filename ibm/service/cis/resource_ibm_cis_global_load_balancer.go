@@ -36,7 +36,7 @@ const (
 )
 
 func ResourceIBMCISGlb() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -174,7 +174,7 @@ func ResourceIBMCISGlb() *schema.Resource {
 		Exists:   resourceCISGlbExists,
 		Delete:   resourceCISGlbDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.UpgradeCisID(false))
 }
 func ResourceIBMCISGlbValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -128,7 +128,7 @@ func resourceIBMCISMtlsCreate(context context.Context, d *schema.ResourceData, m
 
 	result, resp, err := sess.CreateAccessCertificate(options)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating MTLS access certificate %v", resp))
+		return flex.NewAPIError(err, resp).Summary("Error creating MTLS access certificate").Operation("CreateAccessCertificate").Resource("ibm_cis_mtls", d.Id()).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
@@ -194,7 +194,7 @@ func resourceIBMCISMtlsUpdate(context context.Context, d *schema.ResourceData, m
 
 		_, updateResp, updateErr := sess.UpdateAccessCertificate(updateOption)
 		if updateErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the MTLS cert options %v", updateResp))
+			return flex.NewAPIError(updateErr, updateResp).Summary("Error while updating the MTLS cert options").Operation("UpdateAccessCertificate").Resource("ibm_cis_mtls", d.Id()).Diagnostics()
 		}
 	}
 
@@ -216,7 +216,7 @@ func resourceIBMCISMtlsDelete(context context.Context, d *schema.ResourceData, m
 	_, delResp, delErr := sess.DeleteAccessCertificate(delOpt)
 	if delErr != nil {
 
-		return diag.FromErr(fmt.Errorf("[ERROR] Error While deleting the MTLS cert : %v", delResp))
+		return flex.NewAPIError(delErr, delResp).Summary("Error While deleting the MTLS cert").Operation("DeleteAccessCertificate").Resource("ibm_cis_mtls", d.Id()).Diagnostics()
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -42,3 +43,11 @@ func testAccCheckCisMtlsAppBasic1(id string, CisDomainStatic string) string {
 	  }
 `, id)
 }
+
+func TestIBMCisMtlsAppNoStateUpgrade(t *testing.T) {
+	// The ID <app_id>:<policy_id>:<zone_id>:<crn> has always held the CRN, a three part upgrade
+	// would corrupt it.
+	if r := cis.ResourceIBMCISMtlsApp(); r.SchemaVersion != 0 || len(r.StateUpgraders) != 0 {
+		t.Fatalf("Expected no state upgrade, got the schema version %d", r.SchemaVersion)
+	}
+}
@@ -166,7 +166,7 @@ func resourceIBMCISOriginAuthPullCreate(context context.Context, d *schema.Resou
 
 		result, resp, opErr := sess.UploadZoneOriginPullCertificate(options)
 		if opErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while uploading certificate zone level %v", resp))
+			return flex.NewAPIError(opErr, resp).Summary("Error while uploading certificate zone level").Operation("UploadZoneOriginPullCertificate").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 
 		d.SetId(flex.ConvertCisToTfFourVar(*result.Result.ID, level_val, zoneID, crn))
@@ -177,7 +177,7 @@ func resourceIBMCISOriginAuthPullCreate(context context.Context, d *schema.Resou
 		options.SetPrivateKey(key_val)
 		result, resp, opErr := sess.UploadHostnameOriginPullCertificate(options)
 		if opErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while uploading certificate host level %v", resp))
+			return flex.NewAPIError(opErr, resp).Summary("Error while uploading certificate host level").Operation("UploadHostnameOriginPullCertificate").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 
 		d.SetId(flex.ConvertCisToTfFourVar(*result.Result.ID, level_val, zoneID, crn))
@@ -210,7 +210,7 @@ func resourceIBMCISOriginAuthPullRead(context context.Context, d *schema.Resourc
 		result, response, err := sess.GetZoneOriginPullCertificate(getOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while getting detail of zone origin auth pull %v:%v", err, response))
+			return flex.NewAPIError(err, response).Summary("Error while getting detail of zone origin auth pull").Operation("GetZoneOriginPullCertificate").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 		d.Set(cisOriginAuthID, *result.Result.ID)
 		d.Set(cisOriginAuthCertContent, *result.Result.Certificate)
@@ -226,7 +226,7 @@ func resourceIBMCISOriginAuthPullRead(context context.Context, d *schema.Resourc
 		result, response, err := sess.GetHostnameOriginPullCertificate(getOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while getting detail of host origin auth pull %v:%v", err, response))
+			return flex.NewAPIError(err, response).Summary("Error while getting detail of host origin auth pull").Operation("GetHostnameOriginPullCertificate").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 		}
 		d.Set(cisOriginAuthID, *result.Result.ID)
 		d.Set(cisOriginAuthCertContent, *result.Result.Certificate)
@@ -267,7 +267,7 @@ func resourceIBMCISOriginAuthPullUpdate(context context.Context, d *schema.Resou
 			_, response, err := sess.SetZoneOriginPullSettings(updateOption)
 
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error while updaing the zone origin auth pull setting %v:%v", err, response))
+				return flex.NewAPIError(err, response).Summary("Error while updaing the zone origin auth pull setting").Operation("SetZoneOriginPullSettings").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 			}
 
 		}
@@ -288,7 +288,7 @@ func resourceIBMCISOriginAuthPullUpdate(context context.Context, d *schema.Resou
 			setOption.SetConfig([]authenticatedoriginpullapiv1.HostnameOriginPullSettings{*model})
 			_, setResp, setErr := sess.SetHostnameOriginPullSettings(setOption)
 			if setErr != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error while updaing the host origin auth pull setting %v:%v", setErr, setResp))
+				return flex.NewAPIError(setErr, setResp).Summary("Error while updaing the host origin auth pull setting").Operation("SetHostnameOriginPullSettings").Resource("ibm_cis_origin_auth", d.Id()).Diagnostics()
 			}
 
 		}
@@ -27,6 +27,8 @@ func ResourceIBMCDN() *schema.Resource {
 		Delete: resourceIBMCDNDelete,
 		Exists: resourceIBMCDNExists,
 
+		Importer: &schema.ResourceImporter{},
+
 		Schema: map[string]*schema.Schema{
 			"host_name": {
 				Type:        schema.TypeString,
@@ -351,32 +353,40 @@ func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
 	cdnId := sl.String(d.Id())
 	///read the changes in the remote resource and update in the local resource.
 	read, err := service.ListDomainMappingByUniqueId(cdnId)
-	///Print the response of the requested the service.
-	d.Set("originaddress", *read[0].OriginHost)
-	d.Set("vendorname", *read[0].VendorName)
-	d.Set("domain", *read[0].Domain)
-	d.Set("header", *read[0].Header)
-	d.Set("cname", *read[0].Cname)
-	d.Set("origin_type", *read[0].OriginType)
-	d.Set("status", *read[0].Status)
-	if *read[0].OriginType == "OBJECT_STORAGE" {
-		d.Set("bucketname", *read[0].BucketName)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving CDN mapping %s: %s", d.Id(), err)
 	}
-	if *read[0].Protocol == "HTTP" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpport", *read[0].HttpPort)
+	if len(read) == 0 {
+		d.SetId("")
+		return nil
 	}
-	if *read[0].Protocol == "HTTPS" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpsport", *read[0].HttpsPort)
+	cdn := read[0]
+	d.Set("host_name", sl.Get(cdn.Domain, ""))
+	d.Set("vendor_name", sl.Get(cdn.VendorName, ""))
+	d.Set("origin_address", sl.Get(cdn.OriginHost, ""))
+	d.Set("header", sl.Get(cdn.Header, ""))
+	d.Set("cname", sl.Get(cdn.Cname, ""))
+	d.Set("origin_type", sl.Get(cdn.OriginType, ""))
+	d.Set("status", sl.Get(cdn.Status, ""))
+	if sl.Get(cdn.OriginType, "") == "OBJECT_STORAGE" {
+		d.Set("bucket_name", sl.Get(cdn.BucketName, ""))
+		d.Set("file_extension", sl.Get(cdn.FileExtension, ""))
 	}
-	d.Set("protocol", *read[0].Protocol)
-	d.Set("respectheaders", *read[0].RespectHeaders)
-	d.Set("certificationtype", *read[0].CertificateType)
-	d.Set("cachekeyqueryrule", *read[0].CacheKeyQueryRule)
-	d.Set("path", *read[0].Path)
-	d.Set("performanceconfiguration", *read[0].PerformanceConfiguration)
-	if err != nil {
-		log.Println(err)
+	protocol := sl.Get(cdn.Protocol, "").(string)
+	if protocol == "HTTP" || protocol == "HTTP_AND_HTTPS" {
+		d.Set("http_port", sl.Get(cdn.HttpPort, 80))
+	}
+	if protocol == "HTTPS" || protocol == "HTTP_AND_HTTPS" {
+		d.Set("https_port", sl.Get(cdn.HttpsPort, 443))
+	}
+	d.Set("protocol", protocol)
+	d.Set("respect_headers", sl.Get(cdn.RespectHeaders, true))
+	if cdn.CertificateType != nil {
+		d.Set("certificate_type", *cdn.CertificateType)
 	}
+	d.Set("cache_key_query_rule", sl.Get(cdn.CacheKeyQueryRule, ""))
+	d.Set("path", sl.Get(cdn.Path, ""))
+	d.Set("performance_configuration", sl.Get(cdn.PerformanceConfiguration, ""))
 	return nil
 }
 
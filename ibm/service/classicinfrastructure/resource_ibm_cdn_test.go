@@ -31,7 +31,7 @@ func TestAccIBMCDN_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMCDNExists("ibm_cdn.test_cdn111", &cdn),
 					resource.TestCheckResourceAttr(
-						"ibm_cdn.test_cdn111", "hostname", hostname),
+						"ibm_cdn.test_cdn111", "host_name", hostname),
 					resource.TestCheckResourceAttr(
 						"ibm_cdn.test_cdn111", "vendor_name", vendor_name),
 					resource.TestCheckResourceAttr(
@@ -41,6 +41,11 @@ func TestAccIBMCDN_Basic(t *testing.T) {
 				),
 				Destroy: false,
 			},
+			{
+				ResourceName:      "ibm_cdn.test_cdn111",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -96,7 +101,7 @@ func testAccCheckIBMCDNExists(n string, cdn *datatypes.Network_CdnMarketplace_Co
 
 var testingcdn = `
   resource "ibm_cdn" "test_cdn111" {
-	hostname = "www.test1.com"
+	host_name = "www.test1.com"
 	vendor_name = "akamai"
 	origin_address = "222.222.222.2"
 	origin_type = "HOST_SERVER"
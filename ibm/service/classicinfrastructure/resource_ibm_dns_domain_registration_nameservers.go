@@ -20,6 +20,9 @@ func ResourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 		Read:   resourceIBMDNSDomainRegistrationNSRead,
 		Update: resourceIBMDNSDomainRegistrationNSUpdate,
 		Delete: resourceIBMDNSDomainRegistrationNSDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMDNSDomainRegistrationNSImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"dns_registration_id": {
 				Type:        schema.TypeString,
@@ -177,10 +180,24 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 	}
 
 	d.SetId(fmt.Sprintf("%d", dnsId))
+	d.Set("dns_registration_id", d.Id())
 	d.Set("name_servers", ns)
 	return nil
 }
 
+func resourceIBMDNSDomainRegistrationNSImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err != nil {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be the DNS registration ID", d.Id())
+	}
+	if err := resourceIBMDNSDomainRegistrationNSRead(d, meta); err != nil {
+		return nil, err
+	}
+	// The name servers prior to the import are not known, the name servers found at import
+	// are restored on delete
+	d.Set("original_name_servers", d.Get("name_servers"))
+	return []*schema.ResourceData{d}, nil
+}
+
 // No delete on IBM Cloud
 func resourceIBMDNSDomainRegistrationNSUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
@@ -7,10 +7,10 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -24,6 +24,13 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
 		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
 		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// soft_reboot only applies when the attachment is created
+				d.Set("soft_reboot", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -53,8 +60,10 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 
 func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -124,6 +133,8 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 	}
 	for _, b := range bindings {
 		if *b.NetworkComponentId == interfaceID {
+			d.Set("security_group_id", sgID)
+			d.Set("network_interface_id", interfaceID)
 			return nil
 		}
 	}
@@ -132,8 +143,10 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
@@ -175,8 +188,8 @@ func resourceIBMNetworkInterfaceSGAttachmentExists(d *schema.ResourceData, meta
 }
 
 func decomposeNetworkSGAttachmentID(attachmentID string) (sgID, interfaceID int, err error) {
-	ids := strings.Split(attachmentID, "_")
-	if len(ids) != 2 {
+	ids, err := flex.SepIdParts(attachmentID, "_")
+	if err != nil || len(ids) != 2 {
 		return -1, -1, fmt.Errorf("[ERROR] The ibm_network_interface_sg_attachment id must be of the form <sg_id>_<network_interface_id> but it is %s", attachmentID)
 	}
 	sgID, err = strconv.Atoi(ids[0])
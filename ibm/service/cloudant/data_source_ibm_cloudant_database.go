@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMCloudantDatabase() *schema.Resource {
@@ -164,7 +166,7 @@ func dataSourceIBMCloudantDatabaseRead(context context.Context, d *schema.Resour
 	databaseInformation, response, err := cloudantClient.GetDatabaseInformationWithContext(context, getDatabaseInformationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetDatabaseInformationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDatabaseInformationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDatabaseInformationWithContext").Resource("data.ibm_cloudant_database", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMCloudantDatabaseID(d))
@@ -80,7 +80,7 @@ func resourceIBMCloudantDatabaseCreate(context context.Context, d *schema.Resour
 	_, response, err := cloudantClient.PutDatabaseWithContext(context, putDatabaseOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutDatabaseWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutDatabaseWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("PutDatabaseWithContext").Resource("ibm_cloudant_database", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, dbName))
@@ -114,7 +114,7 @@ func resourceIBMCloudantDatabaseRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetDatabaseInformationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDatabaseInformationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDatabaseInformationWithContext").Resource("ibm_cloudant_database", d.Id()).Diagnostics()
 	}
 
 	d.Set("instance_crn", instanceCRN)
@@ -156,7 +156,7 @@ func resourceIBMCloudantDatabaseDelete(context context.Context, d *schema.Resour
 	_, response, err := cloudantClient.DeleteDatabaseWithContext(context, deleteDatabaseOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteDatabaseWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteDatabaseWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDatabaseWithContext").Resource("ibm_cloudant_database", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -176,7 +176,7 @@ func GetCloudantInstanceUrl(instanceCRN string, meta interface{}) (string, error
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return "", fmt.Errorf("Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return "", flex.NewAPIError(err, resp).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_cloudant_database", "")
 	}
 
 	if instance.Extensions != nil {
@@ -166,7 +166,7 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 	accountSettings, response, err := ibmCloudShellClient.UpdateAccountSettingsWithContext(context, updateAccountSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateAccountSettingsWithContext").Resource("ibm_cloud_shell_account_settings", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*accountSettings.ID)
@@ -217,7 +217,7 @@ func resourceIBMCloudShellAccountSettingsRead(context context.Context, d *schema
 			return nil
 		}
 		log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetAccountSettingsWithContext").Resource("ibm_cloud_shell_account_settings", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("account_id", accountSettings.AccountID); err != nil {
@@ -348,7 +348,7 @@ func resourceIBMCloudShellAccountSettingsUpdate(context context.Context, d *sche
 		_, response, err := ibmCloudShellClient.UpdateAccountSettingsWithContext(context, updateAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateAccountSettingsWithContext").Resource("ibm_cloud_shell_account_settings", d.Id()).Diagnostics()
 		}
 	}
 
@@ -190,7 +190,7 @@ func dataSourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, m
 	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetRuleWithContext").Resource("data.ibm_cbr_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getRuleOptions.RuleID))
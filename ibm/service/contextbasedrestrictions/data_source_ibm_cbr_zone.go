@@ -170,7 +170,7 @@ func dataSourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, m
 	zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetZoneWithContext").Resource("data.ibm_cbr_zone", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getZoneOptions.ZoneID))
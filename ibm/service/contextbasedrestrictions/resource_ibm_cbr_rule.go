@@ -296,7 +296,7 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 	rule, response, err := contextBasedRestrictionsClient.CreateRuleWithContext(context, createRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateRuleWithContext").Resource("ibm_cbr_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*rule.ID)
@@ -321,7 +321,7 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetRuleWithContext").Resource("ibm_cbr_rule", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("x_correlation_id", getRuleOptions.XCorrelationID); err != nil {
@@ -453,7 +453,7 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 	_, response, err := contextBasedRestrictionsClient.ReplaceRuleWithContext(context, replaceRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ReplaceRuleWithContext").Resource("ibm_cbr_rule", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMCbrRuleRead(context, d, meta)
@@ -472,7 +472,7 @@ func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, m
 	response, err := contextBasedRestrictionsClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteRuleWithContext").Resource("ibm_cbr_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
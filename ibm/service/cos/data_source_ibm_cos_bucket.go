@@ -536,7 +536,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+		return flex.NewAPIErrorV3(err, response).Summary("Error in getting bucket info rule").Operation("GetBucketConfig").Resource("data.ibm_cos_bucket", d.Id())
 	}
 
 	if bucketPtr != nil {
@@ -1001,7 +1001,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Update COS Bucket: %s\n%s", err, response)
+			return flex.NewAPIErrorV3(err, response).Summary("Error Update COS Bucket").Operation("UpdateBucketConfig").Resource("ibm_cos_bucket", d.Id())
 		}
 	}
 
@@ -1144,7 +1144,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+		return flex.NewAPIErrorV3(err, response).Summary("Error in getting bucket info rule").Operation("GetBucketConfig").Resource("ibm_cos_bucket", d.Id())
 	}
 
 	if bucketPtr != nil {
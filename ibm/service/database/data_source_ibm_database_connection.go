@@ -1649,7 +1649,7 @@ func DataSourceIBMDatabaseConnectionRead(context context.Context, d *schema.Reso
 	connection, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConnectionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConnectionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetConnectionWithContext").Resource("data.ibm_database_connection", d.Id()).Diagnostics()
 	}
 
 	d.SetId(DataSourceIBMDatabaseConnectionID(d))
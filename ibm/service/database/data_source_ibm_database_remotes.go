@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,7 +76,7 @@ func dataSourceIBMDatabaseRemotesRead(context context.Context, d *schema.Resourc
 	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListRemotesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRemotesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListRemotesWithContext").Resource("data.ibm_database_remotes", d.Id()).Diagnostics()
 	}
 
 	d.SetId(d.Get("deployment_id").(string))
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -100,7 +101,7 @@ func dataSourceIBMDatabaseTasksRead(context context.Context, d *schema.ResourceD
 
 	tasks, response, err := cloudDatabasesClient.ListDeploymentTasksWithContext(context, listDeploymentTasksOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("ListDeploymentTasksWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListDeploymentTasksWithContext").Resource("data.ibm_database_tasks", d.Id()).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"reflect"
 	"regexp"
 	"sort"
@@ -317,6 +316,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -1187,7 +1193,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1415,8 +1421,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return diag.FromErr(
-			fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error creating database instance").Operation("CreateResourceInstance").Resource("ibm_database", d.Id()).Diagnostics()
 	}
 	d.SetId(*instance.ID)
 
@@ -1505,8 +1510,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1554,7 +1558,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPassword(changeUserPasswordOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", *changeUserPasswordOptions.Username, err, response))
+			return flex.NewAPIError(err, response).Summary("ChangeUserPassword (%s) failed", *changeUserPasswordOptions.Username).Operation("ChangeUserPassword").Resource("ibm_database", d.Id()).Diagnostics()
 		}
 
 		taskID := *changeUserPasswordResponse.Task.ID
@@ -1715,7 +1719,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 			createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
+				return flex.NewAPIError(err, response).Summary("CreateLogicalReplicationSlot (%s) failed", *createLogicalReplicationOptions.LogicalReplicationSlot.Name).Operation("CreateLogicalReplicationSlot").Resource("ibm_database", d.Id()).Diagnostics()
 			}
 
 			taskID := *createLogicalRepSlotResponse.Task.ID
@@ -1749,7 +1753,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_database", d.Id()).Diagnostics()
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -1763,6 +1767,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 			"Error on get of ibm Database tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -1951,7 +1956,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if update {
 		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating resource instance").Operation("UpdateResourceInstance").Resource("ibm_database", d.Id()).Diagnostics()
 		}
 
 		_, err = waitForDatabaseInstanceUpdate(d, meta)
@@ -1961,7 +1966,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -2121,7 +2126,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				setDeploymentScalingGroupResponse, response, err := cloudDatabasesClient.SetDeploymentScalingGroup(setDeploymentScalingGroupOptions)
 
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] SetDeploymentScalingGroup (%s) failed %s\n%s", group.ID, err, response))
+					return flex.NewAPIError(err, response).Summary("SetDeploymentScalingGroup (%s) failed", group.ID).Operation("SetDeploymentScalingGroup").Resource("ibm_database", d.Id()).Diagnostics()
 				}
 
 				// API may return HTTP 204 No Content if no change made
@@ -2211,7 +2216,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPassword(changeUserPasswordOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", *changeUserPasswordOptions.Username, err, response))
+			return flex.NewAPIError(err, response).Summary("ChangeUserPassword (%s) failed", *changeUserPasswordOptions.Username).Operation("ChangeUserPassword").Resource("ibm_database", d.Id()).Diagnostics()
 		}
 
 		taskID := *changeUserPasswordResponse.Task.ID
@@ -2369,7 +2374,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 				createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
+					return flex.NewAPIError(err, response).Summary("CreateLogicalReplicationSlot (%s) failed", *createLogicalReplicationOptions.LogicalReplicationSlot.Name).Operation("CreateLogicalReplicationSlot").Resource("ibm_database", d.Id()).Diagnostics()
 				}
 
 				taskID := *createLogicalRepSlotResponse.Task.ID
@@ -2535,7 +2540,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s ", err, response))
+			return flex.NewAPIError(err, response).Summary("Error deleting resource instance").Operation("DeleteResourceInstance").Resource("ibm_database", d.Id()).Diagnostics()
 		}
 	}
 
@@ -2565,7 +2570,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("[ERROR] Error getting database: %s %s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting database").Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, databaseInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -2616,12 +2621,12 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil || instance == nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("The resource instance %s does not exist anymore", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("GetResourceInstance on %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
+				return *instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			return *instance, *instance.State, nil
 		},
@@ -2656,12 +2661,12 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("The resource instance %s does not exist anymore", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("GetResourceInstance on %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
+				return *instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			return *instance, *instance.State, nil
 		},
@@ -2729,10 +2734,10 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, databaseInstanceSuccessStatus, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("GetResourceInstance on %s failed", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %s %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewAPIError(err, response).Summary("The resource instance %s failed to delete", d.Id()).Operation("GetResourceInstance").Resource("ibm_database", d.Id())
 			}
 			return *instance, *instance.State, nil
 		},
@@ -3100,7 +3105,7 @@ func userUpdateCreate(userData map[string]interface{}, instanceID string, meta i
 
 	// user was found but an error occurs while triggering task
 	if response.StatusCode != 404 && err != nil {
-		return fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", *changeUserPasswordOptions.Username, err, response)
+		return flex.NewAPIError(err, response).Summary("ChangeUserPassword (%s) failed", *changeUserPasswordOptions.Username).Operation("ChangeUserPassword").Resource("ibm_database", d.Id())
 	}
 
 	taskID := *changeUserPasswordResponse.Task.ID
@@ -3131,7 +3136,7 @@ func userUpdateCreate(userData map[string]interface{}, instanceID string, meta i
 
 		createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUser(createDatabaseUserOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %s\n%s", *userEntry.Username, err, response)
+			return flex.NewAPIError(err, response).Summary("CreateDatabaseUser (%s) failed", *userEntry.Username).Operation("CreateDatabaseUser").Resource("ibm_database", d.Id())
 		}
 
 		taskID := *createDatabaseUserResponse.Task.ID
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -350,7 +351,7 @@ func dataSourceIBMDLGatewayVirtualConnectionsRead(d *schema.ResourceData, meta i
 	listVcOptions.SetGatewayID(dlGatewayId)
 	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnections(listVcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing directlink gateway's virtual connections XXX %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while listing directlink gateway's virtual connections XXX").Operation("ListGatewayVirtualConnections").Resource("data.ibm_dl_gateway", d.Id())
 	}
 	gatewayVCs := make([]map[string]interface{}, 0)
 	for _, instance := range listGatewayVirtualConnections.VirtualConnections {
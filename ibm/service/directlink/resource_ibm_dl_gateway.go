@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -39,7 +38,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -390,6 +389,13 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -721,7 +727,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
+		return flex.NewAPIError(err, response).Summary("[DEBUG] Create Direct Link Gateway (%s) err", dtype).Operation("CreateGateway").Resource("ibm_dl_gateway", d.Id())
 	}
 	d.SetId(*gateway.ID)
 
@@ -741,8 +747,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -776,7 +781,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Direct Link Gateway (%s Template)", dtype).Operation("GetGateway").Resource("ibm_dl_gateway", d.Id())
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -930,6 +935,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(dlTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -984,7 +990,7 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 		}
 		instance, response, err := client.GetGateway(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Direct Link").Operation("GetGateway").Resource("ibm_dl_gateway", id)
 		}
 		if *instance.OperationalStatus == "provisioned" || *instance.OperationalStatus == "failed" || *instance.OperationalStatus == "create_rejected" {
 			return instance, dlGatewayProvisioningDone, nil
@@ -1015,7 +1021,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	updateGatewayOptionsModel.ID = &ID
 	dtype := *instance.Type
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -1049,7 +1055,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayAsPrepends(listGatewayAsPrependsOptions)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error listing Direct Link Gateway AS Prepends err").Operation("ListGatewayAsPrepends").Resource("ibm_dl_gateway", d.Id())
 		}
 		etag := response.GetHeaders().Get("etag")
 		asPrependsCreateItems := make([]directlinkv1.AsPrependPrefixArrayTemplate, 0)
@@ -1088,7 +1094,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, responseRep, operationErr := directLink.ReplaceGatewayAsPrepends(replaceGatewayAsPrependsOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
-			return fmt.Errorf("[ERROR] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
+			return flex.NewAPIError(operationErr, responseRep).Summary("Error while replacing AS Prepends to a gateway id %s", ID).Operation("ReplaceGatewayAsPrepends").Resource("ibm_dl_gateway", d.Id())
 		}
 	}
 	/*
@@ -1232,7 +1238,7 @@ func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool,
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Direct Link Gateway").Operation("GetGateway").Resource("ibm_dl_gateway", d.Id())
 	}
 	return true, nil
 }
@@ -166,7 +166,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Directlink Gateway Connection (%s)", ID).Operation("GetGatewayVirtualConnection").Resource("ibm_dl_gateway_virtual_connection", d.Id())
 	}
 
 	if instance.Name != nil {
@@ -194,7 +194,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	}
 	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Direct Link Gateway (Dedicated Template)").Operation("GetGateway").Resource("ibm_dl_gateway_virtual_connection", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *dlgw.Crn)
 	return nil
@@ -297,7 +297,7 @@ func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (boo
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection").Operation("GetGatewayVirtualConnection").Resource("ibm_dl_gateway_virtual_connection", d.Id())
 	}
 
 	if response.StatusCode == 404 {
@@ -5,9 +5,7 @@ package directlink
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -25,7 +23,7 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -123,6 +121,13 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -231,8 +236,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -265,7 +269,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Direct Link Gateway (%s Template)", dtype).Operation("GetProviderGateway").Resource("ibm_dl_provider_gateway", d.Id())
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -320,6 +324,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(dlTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -345,12 +350,12 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting provider gateway %s, %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting provider gateway").Operation("GetProviderGateway").Resource("ibm_dl_provider_gateway", d.Id())
 	}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -428,7 +433,7 @@ func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Provider Gateway : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Direct Link Provider Gateway").Operation("GetProviderGateway").Resource("ibm_dl_provider_gateway", d.Id())
 	}
 	return true, nil
 }
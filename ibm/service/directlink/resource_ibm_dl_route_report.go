@@ -216,7 +216,7 @@ func isDirectLinkGatewayRouteReportRefreshFunc(client *directlinkv1.DirectLinkV1
 		}
 		routeReport, response, err := client.GetGatewayRouteReport(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] error fetching directlink route report: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("error fetching directlink route report").Operation("GetGatewayRouteReport").Resource("ibm_dl_route_report", ID)
 		}
 		if *routeReport.Status == "complete" {
 			return routeReport, dlRouteReportComplete, nil
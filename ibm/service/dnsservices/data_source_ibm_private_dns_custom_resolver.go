@@ -5,10 +5,10 @@ package dnsservices
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -98,7 +98,7 @@ func dataSourceIBMDNSCustomResolverRead(context context.Context, d *schema.Resou
 	opt := sess.NewListCustomResolversOptions(instanceID)
 	result, resp, err := sess.ListCustomResolversWithContext(context, opt)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the custom resolvers %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error listing the custom resolvers").Operation("ListCustomResolversWithContext").Resource("data.ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 
 	customResolvers := make([]interface{}, 0)
@@ -5,10 +5,10 @@ package dnsservices
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -80,7 +80,7 @@ func dataSourceIbmDnsCrForwardingRulesRead(context context.Context, d *schema.Re
 
 	result, resp, err := sess.ListForwardingRulesWithContext(context, opt)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the forwarding rules %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error listing the forwarding rules").Operation("ListForwardingRulesWithContext").Resource("data.ibm_private_dns_custom_resolver_forwarding_rules", d.Id()).Diagnostics()
 	}
 
 	forwardRules := make([]interface{}, 0)
@@ -241,7 +241,7 @@ func resouceIBMPrivateDNSCustomResolverCreate(context context.Context, d *schema
 	// Create a custom resolver
 	result, resp, err := sess.CreateCustomResolverWithContext(context, customResolverOption)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error creating the custom resolver").Operation("CreateCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.ID, crn))
@@ -277,13 +277,13 @@ func resouceIBMPrivateDNSCustomResolverRead(context context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %s:%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error reading the custom resolver").Operation("GetCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 	fwopt := sess.NewListForwardingRulesOptions(crn, customResolverID)
 
 	fwresult, fwresp, fwerr := sess.ListForwardingRulesWithContext(context, fwopt)
 	if fwerr != nil || fwresult == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the forwarding rules %s:%s", fwerr, fwresp))
+		return flex.NewAPIError(fwerr, fwresp).Summary("Error listing the forwarding rules").Operation("ListForwardingRulesWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 
 	forwardRules := make([]interface{}, 0)
@@ -370,7 +370,7 @@ func resouceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema
 		}
 		result, resp, err := sess.UpdateCustomResolverWithContext(context, opt)
 		if err != nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver %s:%s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error updating the custom resolver").Operation("UpdateCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 		}
 
 	}
@@ -492,7 +492,7 @@ func resouceIBMPrivateDNSCustomResolverDelete(context context.Context, d *schema
 	optEnabled.SetEnabled(false)
 	result, resp, errEnabled := sess.UpdateCustomResolverWithContext(context, optEnabled)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver to disable before deleting %s:%s", errEnabled, resp))
+		return flex.NewAPIError(errEnabled, resp).Summary("Error updating the custom resolver to disable before deleting").Operation("UpdateCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 
 	opt := sess.NewDeleteCustomResolverOptions(crn, customResolverID)
@@ -502,7 +502,7 @@ func resouceIBMPrivateDNSCustomResolverDelete(context context.Context, d *schema
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the custom resolver %s:%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error deleting the custom resolver").Operation("DeleteCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -618,7 +618,7 @@ func PDNSCustomResolverDisableLocation(meta interface{}, instanceID string, cust
 	updatelocation.SetEnabled(false)
 	result, resp, err := sess.UpdateCustomResolverLocation(updatelocation)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Disabling the custom resolver location %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error Disabling the custom resolver location").Operation("UpdateCustomResolverLocation").Resource("ibm_private_dns_custom_resolver", "").Diagnostics()
 	}
 	return nil
 }
@@ -634,7 +634,7 @@ func deleteCRLocation(meta interface{}, instanceID string, customResolverID stri
 		if resp != nil && resp.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the custom resolver location %s:%s", errDel, resp))
+		return flex.NewAPIError(errDel, resp).Summary("Error Deleting the custom resolver location").Operation("DeleteCustomResolverLocation").Resource("ibm_private_dns_custom_resolver", "").Diagnostics()
 	}
 	return nil
 }
@@ -650,7 +650,7 @@ func addCRLocation(meta interface{}, instanceID string, customResolverID string,
 	result, resp, err := sess.AddCustomResolverLocation(opt)
 	locationID := *result.ID
 	if err != nil || result == nil {
-		return "", diag.FromErr(fmt.Errorf("[ERROR] Error creating the custom resolver location %s:%s", err, resp))
+		return "", flex.NewAPIError(err, resp).Summary("Error creating the custom resolver location").Operation("AddCustomResolverLocation").Resource("ibm_private_dns_custom_resolver", "").Diagnostics()
 	}
 	return locationID, nil
 }
@@ -665,7 +665,7 @@ func updateLocationSubnet(meta interface{}, instanceID string, customResolverID
 	updatelocation.SetEnabled(false)
 	result, resp, err := sess.UpdateCustomResolverLocation(updatelocation)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Disable and updating the custom resolver location %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error Disable and updating the custom resolver location").Operation("UpdateCustomResolverLocation").Resource("ibm_private_dns_custom_resolver", "").Diagnostics()
 	}
 	return nil
 }
@@ -5,7 +5,6 @@ package dnsservices
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -119,7 +118,7 @@ func resourceIbmDnsCrForwardingRuleCreate(context context.Context, d *schema.Res
 	result, resp, err := dnsSvcsClient.CreateForwardingRuleWithContext(context, opt)
 
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the forwarding rules %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error creating the forwarding rules").Operation("CreateForwardingRuleWithContext").Resource("ibm_private_dns_custom_resolver_forwarding_rule", d.Id()).Diagnostics()
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.ID, resolverID, instanceID))
 
@@ -140,7 +139,7 @@ func resourceIbmDnsCrForwardingRuleRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the forwarding rules %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error reading the forwarding rules").Operation("GetForwardingRuleWithContext").Resource("ibm_private_dns_custom_resolver_forwarding_rule", d.Id()).Diagnostics()
 	}
 	d.Set(pdnsInstanceID, instanceID)
 	d.Set(pdnsCRFRResolverID, resolverID)
@@ -185,7 +184,7 @@ func resourceIbmDnsCrForwardingRuleUpdate(context context.Context, d *schema.Res
 		}
 		result, resp, err := dnsSvcsClient.UpdateForwardingRuleWithContext(context, opt)
 		if err != nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the forwarding rule %s:%s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error updating the forwarding rule").Operation("UpdateForwardingRuleWithContext").Resource("ibm_private_dns_custom_resolver_forwarding_rule", d.Id()).Diagnostics()
 		}
 
 	}
@@ -204,7 +203,7 @@ func resourceIbmDnsCrForwardingRuleDelete(context context.Context, d *schema.Res
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the  Forwarding Rules %s:%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error deleting the Forwarding Rules").Operation("DeleteForwardingRuleWithContext").Resource("ibm_private_dns_custom_resolver_forwarding_rule", d.Id()).Diagnostics()
 	}
 	d.SetId("")
 	return nil
@@ -92,8 +92,10 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 	resolverID := d.Get(pdnsResolverID).(string)
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockContext(context, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(mk)
 
 	opt := sess.NewAddCustomResolverLocationOptions(instanceID, resolverID)
 
@@ -120,9 +122,9 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %v:%v", err, response))
+				return flex.NewAPIError(err, response).Summary("Error reading the custom resolver").Operation("GetCustomResolver").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 			}
-			return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %v:%v", err, response))
+			return flex.NewAPIError(err, response).Summary("Error reading the custom resolver").Operation("GetCustomResolver").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 		}
 		// Disable the Custom Resolver location and add it, otherwise you will get API error:
 		// "Not allowed to create enabled location while custom resolver is enabled."
@@ -133,7 +135,7 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 
 	result, resp, err := sess.AddCustomResolverLocationWithContext(context, opt)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the custom resolver location %s:%s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error creating the custom resolver location").Operation("AddCustomResolverLocationWithContext").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 	}
 	locationID := *result.ID
 	d.SetId(flex.ConvertCisToTfThreeVar(locationID, resolverID, instanceID))
@@ -155,7 +157,7 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 		optCr.SetEnabled(false)
 		resultCr, respCr, errCr := sess.UpdateCustomResolverWithContext(context, optCr)
 		if errCr != nil || resultCr == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver with cr_enable false %s:%s", errCr, respCr))
+			return flex.NewAPIError(errCr, respCr).Summary("Error updating the custom resolver with cr_enable false").Operation("UpdateCustomResolverWithContext").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 		}
 	}
 	return resourceIBMPrivateDNSLocationRead(context, d, meta)
@@ -173,8 +175,10 @@ func resourceIBMPrivateDNSLocationUpdate(context context.Context, d *schema.Reso
 	locationID, resolverID, instanceID, err := flex.ConvertTfToCisThreeVar(d.Id())
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockContext(context, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(mk)
 
 	updatelocation := sess.NewUpdateCustomResolverLocationOptions(instanceID, resolverID, locationID)
 
@@ -188,7 +192,7 @@ func resourceIBMPrivateDNSLocationUpdate(context context.Context, d *schema.Reso
 		}
 		result, resp, err := sess.UpdateCustomResolverLocationWithContext(context, updatelocation)
 		if err != nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver location %s:%s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error updating the custom resolver location").Operation("UpdateCustomResolverLocationWithContext").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 		}
 	}
 	return resourceIBMPrivateDNSLocationRead(context, d, meta)
@@ -206,9 +210,9 @@ func resourceIBMPrivateDNSLocationDelete(context context.Context, d *schema.Reso
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %v:%v", err, response))
+			return flex.NewAPIError(err, response).Summary("Error reading the custom resolver").Operation("GetCustomResolver").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %v:%v", err, response))
+		return flex.NewAPIError(err, response).Summary("Error reading the custom resolver").Operation("GetCustomResolver").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 	}
 
 	crLocations := cr_result.Locations
@@ -245,7 +249,7 @@ func resourceIBMPrivateDNSLocationDelete(context context.Context, d *schema.Reso
 		updatelocation.SetEnabled(false)
 		result, resp, err := sess.UpdateCustomResolverLocationWithContext(context, updatelocation)
 		if err != nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Disable and updating the custom resolver location %s:%s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error Disable and updating the custom resolver location").Operation("UpdateCustomResolverLocationWithContext").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 		}
 	}
 	deleteCRlocation := sess.NewDeleteCustomResolverLocationOptions(instanceID, resolverID, locationID)
@@ -254,7 +258,7 @@ func resourceIBMPrivateDNSLocationDelete(context context.Context, d *schema.Reso
 		if resp != nil && resp.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the custom resolver location %s:%s", errDel, resp))
+		return flex.NewAPIError(errDel, resp).Summary("Error Deleting the custom resolver location").Operation("DeleteCustomResolverLocationWithContext").Resource("ibm_private_dns_custom_resolver_location", d.Id()).Diagnostics()
 	}
 	d.SetId("")
 	return nil
@@ -112,12 +112,14 @@ func resourceIBMPrivateDNSSecondaryZoneCreate(ctx context.Context, d *schema.Res
 	createSecondaryZoneOptions.SetTransferFrom(transferFrom)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockContext(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(mk)
 
 	resource, response, err := sess.CreateSecondaryZone(createSecondaryZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error creating DNS Services secondary zone").Operation("CreateSecondaryZone").Resource("ibm_private_dns_custom_resolver_secondary_zone", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, resolverID, *resource.ID))
@@ -144,7 +146,7 @@ func resourceIBMPrivateDNSSecondaryZoneRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error reading DNS Services secondary zone").Operation("GetSecondaryZone").Resource("ibm_private_dns_custom_resolver_secondary_zone", d.Id()).Diagnostics()
 	}
 
 	transferFrom := []string{}
@@ -183,7 +185,7 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 	getZoneOptions := sess.NewGetSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 	_, response, err := sess.GetSecondaryZone(getZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching secondary zone:%s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error fetching secondary zone").Operation("GetSecondaryZone").Resource("ibm_private_dns_custom_resolver_secondary_zone", d.Id()).Diagnostics()
 	}
 
 	// Update DNS zone if attributes has any change
@@ -199,13 +201,15 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 		updateSecondaryZoneOptions.SetEnabled(enabled)
 
 		mk := "private_dns_secondary_zone_" + instanceID + resolverID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		if err := conns.Locks.LockContext(ctx, mk); err != nil {
+			return diag.FromErr(err)
+		}
+		defer conns.Locks.Unlock(mk)
 
 		_, response, err := sess.UpdateSecondaryZone(updateSecondaryZoneOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating DNS Services zone:%s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating DNS Services zone").Operation("UpdateSecondaryZone").Resource("ibm_private_dns_custom_resolver_secondary_zone", d.Id()).Diagnostics()
 		}
 	}
 
@@ -227,15 +231,17 @@ func resourceIBMPrivateDNSSecondaryZoneDelete(ctx context.Context, d *schema.Res
 	deleteSecondaryZoneOptions := sess.NewDeleteSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockContext(ctx, mk); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(mk)
 	response, err := sess.DeleteSecondaryZone(deleteSecondaryZoneOptions)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error reading DNS Services secondary zone").Operation("DeleteSecondaryZone").Resource("ibm_private_dns_custom_resolver_secondary_zone", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -197,7 +197,7 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB :%s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error fetching pdns GLB").Operation("GetLoadBalancer").Resource("ibm_private_dns_glb", d.Id())
 	}
 
 	response := *presponse
@@ -259,7 +259,7 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB :%s\n%s", err, detail)
+			return flex.NewAPIError(err, detail).Summary("Error updating pdns GLB").Operation("UpdateLoadBalancer").Resource("ibm_private_dns_glb", d.Id())
 		}
 	}
 
@@ -276,7 +276,7 @@ func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) er
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
 	response, err := sess.DeleteLoadBalancer(deletelbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB :%s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting pdns GLB").Operation("DeleteLoadBalancer").Resource("ibm_private_dns_glb", d.Id())
 	}
 	_, err = isWaitForLoadBalancerDeleted(sess, d, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -368,7 +368,7 @@ func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *sche
 			if response != nil && response.StatusCode == 404 {
 				return "", pdnsGLBDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] Error Getting PDNS Load Balancer : %s\n%s", err, response)
+			return "", "", flex.NewAPIError(err, response).Summary("Error Getting PDNS Load Balancer").Operation("GetLoadBalancer").Resource("ibm_private_dns_glb", d.Id())
 		}
 		return LoadBalancer, pdnsGLBDeleting, err
 
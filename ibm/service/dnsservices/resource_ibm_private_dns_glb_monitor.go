@@ -270,7 +270,7 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 
 	response, detail, err := sess.CreateMonitor(createMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns GLB monitor:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error creating pdns GLB monitor").Operation("CreateMonitor").Resource("ibm_private_dns_glb_monitor", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *response.ID))
@@ -303,7 +303,7 @@ func resourceIBMPrivateDNSGLBMonitorRead(d *schema.ResourceData, meta interface{
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
 	response, detail, err := sess.GetMonitor(getMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB Monitor:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error fetching pdns GLB Monitor").Operation("GetMonitor").Resource("ibm_private_dns_glb_monitor", d.Id())
 	}
 	d.Set(pdnsInstanceID, idset[0])
 	d.Set(pdnsGlbMonitorID, response.ID)
@@ -425,7 +425,7 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 		_, detail, err := sess.UpdateMonitor(updateMonitorOptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB Monitor:%s\n%s", err, detail)
+			return flex.NewAPIError(err, detail).Summary("Error updating pdns GLB Monitor").Operation("UpdateMonitor").Resource("ibm_private_dns_glb_monitor", d.Id())
 		}
 	}
 
@@ -444,7 +444,7 @@ func resourceIBMPrivateDNSGLBMonitorDelete(d *schema.ResourceData, meta interfac
 	response, err := sess.DeleteMonitor(DeleteMonitorOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB Monitor:%s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting pdns GLB Monitor").Operation("DeleteMonitor").Resource("ibm_private_dns_glb_monitor", d.Id())
 	}
 
 	d.SetId("")
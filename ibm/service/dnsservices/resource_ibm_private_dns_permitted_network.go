@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -109,8 +110,10 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
 	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
@@ -122,7 +125,7 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	createPermittedNetworkOptions.SetType(nwType)
 	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error creating pdns permitted network").Operation("CreatePermittedNetwork").Resource("ibm_private_dns_permitted_network", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
@@ -141,7 +144,7 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error reading pdns permitted network").Operation("GetPermittedNetwork").Resource("ibm_private_dns_permitted_network", d.Id())
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -164,13 +167,15 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 
 	idSet := strings.Split(d.Id(), "/")
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting pdns permitted network").Operation("DeletePermittedNetwork").Resource("ibm_private_dns_permitted_network", d.Id())
 	}
 
 	d.SetId("")
@@ -189,8 +194,10 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutRead), mk); err != nil {
+		return false, err
+	}
+	defer conns.Locks.Unlock(mk)
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 	if err != nil {
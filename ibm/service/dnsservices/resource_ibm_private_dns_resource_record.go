@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -301,11 +302,13 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + instanceID + zoneID + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error creating pdns resource record").Operation("CreateResourceRecord").Resource("ibm_private_dns_resource_record", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
@@ -322,7 +325,7 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail)
+		return flex.NewAPIError(err, detail).Summary("Error reading pdns resource record").Operation("GetResourceRecord").Resource("ibm_private_dns_resource_record", d.Id())
 	}
 
 	// extract the record name by removing zone details
@@ -392,8 +395,10 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 
@@ -483,7 +488,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail)
+			return flex.NewAPIError(err, detail).Summary("Error updating pdns resource record").Operation("UpdateResourceRecord").Resource("ibm_private_dns_resource_record", d.Id())
 		}
 	}
 
@@ -501,11 +506,13 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), mk); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(mk)
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting pdns resource record").Operation("DeleteResourceRecord").Resource("ibm_private_dns_resource_record", d.Id())
 	}
 
 	d.SetId("")
@@ -526,8 +533,10 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutRead), mk); err != nil {
+		return false, err
+	}
+	defer conns.Locks.Unlock(mk)
 	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
 
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -71,7 +72,7 @@ func dataSourceIBMEnFCMSubscriptionRead(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, getSubscriptionOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("data.ibm_en_FCM_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getSubscriptionOptions.InstanceID, *getSubscriptionOptions.ID))
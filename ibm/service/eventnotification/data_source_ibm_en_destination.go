@@ -124,7 +124,7 @@ func dataSourceIBMEnDestinationRead(context context.Context, d *schema.ResourceD
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetDestination failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("data.ibm_en_destination", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
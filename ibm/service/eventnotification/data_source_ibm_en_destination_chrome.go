@@ -108,7 +108,7 @@ func dataSourceIBMEnChromeDestinationRead(context context.Context, d *schema.Res
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetDestination failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("data.ibm_en_destination_chrome", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
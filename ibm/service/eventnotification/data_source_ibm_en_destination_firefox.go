@@ -102,7 +102,7 @@ func dataSourceIBMEnFirefoxDestinationRead(context context.Context, d *schema.Re
 
 	result, response, err := enClient.GetDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetDestination failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("data.ibm_en_destination_firefox", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
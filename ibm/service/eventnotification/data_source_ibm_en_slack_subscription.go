@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -84,7 +85,7 @@ func dataSourceIBMEnSlackSubscriptionRead(context context.Context, d *schema.Res
 
 	result, response, err := enClient.GetSubscriptionWithContext(context, getSubscriptionOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("data.ibm_en_slack_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getSubscriptionOptions.InstanceID, *getSubscriptionOptions.ID))
@@ -67,7 +67,7 @@ func dataSourceIBMEnSourceRead(context context.Context, d *schema.ResourceData,
 
 	result, response, err := enClient.GetSourceWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetSource failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSourceWithContext").Resource("data.ibm_en_source", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
@@ -111,7 +111,7 @@ func dataSourceIBMEnTopicsRead(context context.Context, d *schema.ResourceData,
 		topicList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListTopicsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListTopicsWithContext").Resource("data.ibm_en_topics", d.Id()).Diagnostics()
 		}
 		offset = offset + limit
 
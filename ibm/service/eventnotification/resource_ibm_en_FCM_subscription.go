@@ -101,7 +101,7 @@ func resourceIBMEnFCMSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSubscriptionWithContext").Resource("ibm_en_FCM_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -131,7 +131,7 @@ func resourceIBMEnFCMSubscriptionRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("ibm_en_FCM_subscription", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -214,7 +214,7 @@ func resourceIBMEnFCMSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSubscriptionWithContext").Resource("ibm_en_FCM_subscription", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnFCMSubscriptionRead(context, d, meta)
@@ -245,7 +245,7 @@ func resourceIBMEnFCMSubscriptionDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSubscriptionWithContext").Resource("ibm_en_FCM_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
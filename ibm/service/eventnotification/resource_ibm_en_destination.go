@@ -154,7 +154,7 @@ func resourceIBMEnDestinationCreate(context context.Context, d *schema.ResourceD
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateDestinationWithContext").Resource("ibm_en_destination", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -184,7 +184,7 @@ func resourceIBMEnDestinationRead(context context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("ibm_en_destination", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -259,7 +259,7 @@ func resourceIBMEnDestinationUpdate(context context.Context, d *schema.ResourceD
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateDestinationWithContext").Resource("ibm_en_destination", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnDestinationRead(context, d, meta)
@@ -290,7 +290,7 @@ func resourceIBMEnDestinationDelete(context context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDestinationWithContext").Resource("ibm_en_destination", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -177,7 +177,7 @@ func resourceIBMEnAPNSDestinationCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateDestinationWithContext").Resource("ibm_en_destination_apns", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -207,7 +207,7 @@ func resourceIBMEnAPNSDestinationRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("ibm_en_destination_apns", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -300,7 +300,7 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateDestinationWithContext").Resource("ibm_en_destination_apns", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnAPNSDestinationRead(context, d, meta)
@@ -331,7 +331,7 @@ func resourceIBMEnAPNSDestinationDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDestinationWithContext").Resource("ibm_en_destination_apns", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -124,7 +124,7 @@ func resourceIBMEnChromeDestinationCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateDestinationWithContext").Resource("ibm_en_destination_chrome", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -154,7 +154,7 @@ func resourceIBMEnChromeDestinationRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("ibm_en_destination_chrome", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -228,7 +228,7 @@ func resourceIBMEnChromeDestinationUpdate(context context.Context, d *schema.Res
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateDestinationWithContext").Resource("ibm_en_destination_chrome", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnChromeDestinationRead(context, d, meta)
@@ -259,7 +259,7 @@ func resourceIBMEnChromeDestinationDelete(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDestinationWithContext").Resource("ibm_en_destination_chrome", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -123,7 +123,7 @@ func resourceIBMEnFCMDestinationCreate(context context.Context, d *schema.Resour
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateDestinationWithContext").Resource("ibm_en_destination_fcm", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -153,7 +153,7 @@ func resourceIBMEnFCMDestinationRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("ibm_en_destination_fcm", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -228,7 +228,7 @@ func resourceIBMEnFCMDestinationUpdate(context context.Context, d *schema.Resour
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateDestinationWithContext").Resource("ibm_en_destination_fcm", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnFCMDestinationRead(context, d, meta)
@@ -259,7 +259,7 @@ func resourceIBMEnFCMDestinationDelete(context context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDestinationWithContext").Resource("ibm_en_destination_fcm", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
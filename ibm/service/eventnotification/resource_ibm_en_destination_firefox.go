@@ -118,7 +118,7 @@ func resourceIBMEnFirefoxDestinationCreate(context context.Context, d *schema.Re
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateDestinationWithContext").Resource("ibm_en_destination_firefox", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -148,7 +148,7 @@ func resourceIBMEnFirefoxDestinationRead(context context.Context, d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetDestinationWithContext").Resource("ibm_en_destination_firefox", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -222,7 +222,7 @@ func resourceIBMEnFirefoxDestinationUpdate(context context.Context, d *schema.Re
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateDestinationWithContext").Resource("ibm_en_destination_firefox", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnFirefoxDestinationRead(context, d, meta)
@@ -253,7 +253,7 @@ func resourceIBMEnFirefoxDestinationDelete(context context.Context, d *schema.Re
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteDestinationWithContext").Resource("ibm_en_destination_firefox", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
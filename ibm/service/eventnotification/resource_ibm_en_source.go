@@ -78,7 +78,7 @@ func resourceIBMEnSourceCreate(context context.Context, d *schema.ResourceData,
 
 	result, response, err := enClient.CreateSourcesWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSourcesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSourcesWithContext").Resource("ibm_en_source", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -108,7 +108,7 @@ func resourceIBMEnSourceRead(context context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSourceWithContext").Resource("ibm_en_source", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -165,7 +165,7 @@ func resourceIBMEnSourceUpdate(context context.Context, d *schema.ResourceData,
 
 		_, response, err := enClient.UpdateSourceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSourceWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSourceWithContext").Resource("ibm_en_source", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnSourceRead(context, d, meta)
@@ -196,7 +196,7 @@ func resourceIBMEnSourceDelete(context context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSourceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSourceWithContext").Resource("ibm_en_source", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -155,7 +155,7 @@ func resourceIBMEnSubscriptionCreate(context context.Context, d *schema.Resource
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSubscriptionWithContext").Resource("ibm_en_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -185,7 +185,7 @@ func resourceIBMEnSubscriptionRead(context context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("ibm_en_subscription", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -278,7 +278,7 @@ func resourceIBMEnSubscriptionUpdate(context context.Context, d *schema.Resource
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSubscriptionWithContext").Resource("ibm_en_subscription", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnSubscriptionRead(context, d, meta)
@@ -309,7 +309,7 @@ func resourceIBMEnSubscriptionDelete(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSubscriptionWithContext").Resource("ibm_en_subscription", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
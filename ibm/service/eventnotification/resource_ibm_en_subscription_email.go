@@ -163,7 +163,7 @@ func resourceIBMEnEmailSubscriptionCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSubscriptionWithContext").Resource("ibm_en_subscription_email", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -193,7 +193,7 @@ func resourceIBMEnEmailSubscriptionRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("ibm_en_subscription_email", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -279,7 +279,7 @@ func resourceIBMEnEmailSubscriptionUpdate(context context.Context, d *schema.Res
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSubscriptionWithContext").Resource("ibm_en_subscription_email", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnEmailSubscriptionRead(context, d, meta)
@@ -310,7 +310,7 @@ func resourceIBMEnEmailSubscriptionDelete(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSubscriptionWithContext").Resource("ibm_en_subscription_email", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -119,7 +119,7 @@ func resourceIBMEnSMSSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSubscriptionWithContext").Resource("ibm_en_subscription_sms", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -149,7 +149,7 @@ func resourceIBMEnSMSSubscriptionRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("ibm_en_subscription_sms", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -235,7 +235,7 @@ func resourceIBMEnSMSSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSubscriptionWithContext").Resource("ibm_en_subscription_sms", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnSMSSubscriptionRead(context, d, meta)
@@ -266,7 +266,7 @@ func resourceIBMEnSMSSubscriptionDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSubscriptionWithContext").Resource("ibm_en_subscription_sms", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
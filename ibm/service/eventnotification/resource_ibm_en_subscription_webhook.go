@@ -120,7 +120,7 @@ func resourceIBMEnWebhookSubscriptionCreate(context context.Context, d *schema.R
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSubscriptionWithContext").Resource("ibm_en_subscription_webhook", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -150,7 +150,7 @@ func resourceIBMEnWebhookSubscriptionRead(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSubscriptionWithContext").Resource("ibm_en_subscription_webhook", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -236,7 +236,7 @@ func resourceIBMEnWebhookSubscriptionUpdate(context context.Context, d *schema.R
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSubscriptionWithContext").Resource("ibm_en_subscription_webhook", d.Id()).Diagnostics()
 		}
 
 		return resourceIBMEnWebhookSubscriptionRead(context, d, meta)
@@ -267,7 +267,7 @@ func resourceIBMEnWebhookSubscriptionDelete(context context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSubscriptionWithContext").Resource("ibm_en_subscription_webhook", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
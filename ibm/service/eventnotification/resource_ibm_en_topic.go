@@ -177,7 +177,7 @@ func resourceIBMEnTopicCreate(context context.Context, d *schema.ResourceData, m
 	result, response, err := enClient.CreateTopicWithContext(context, options)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateTopicWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTopicWithContext").Resource("ibm_en_topic", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -209,7 +209,7 @@ func resourceIBMEnTopicRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 
-		return diag.FromErr(fmt.Errorf("GetTopicWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTopicWithContext").Resource("ibm_en_topic", d.Id()).Diagnostics()
 	}
 
 	d.Set("instance_guid", options.InstanceID)
@@ -294,7 +294,7 @@ func resourceIBMEnTopicUpdate(context context.Context, d *schema.ResourceData, m
 
 	_, response, err := enClient.ReplaceTopicWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("ReplaceTopicWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ReplaceTopicWithContext").Resource("ibm_en_topic", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMEnTopicRead(context, d, meta)
@@ -322,7 +322,7 @@ func resourceIBMEnTopicDelete(context context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteTopicWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTopicWithContext").Resource("ibm_en_topic", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -196,7 +196,7 @@ func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.Reso
 	schemaMetadata, response, err := schemaregistryClient.CreateSchemaWithContext(context, createSchemaOptions)
 	if err != nil || schemaMetadata == nil {
 		log.Printf("[DEBUG] CreateSchemaWithContext failed with error: %s and response: \n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSchemaWithContext failed with eror: %s and response: \n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSchemaWithContext").Resource("ibm_event_streams_schema", d.Id()).Diagnostics()
 	}
 	uniqueID := getUniqueSchemaID(instanceCRN, *schemaMetadata.ID)
 	d.SetId(uniqueID)
@@ -228,7 +228,7 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSchemaWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLatestSchemaWithContext").Resource("ibm_event_streams_schema", d.Id()).Diagnostics()
 	}
 
 	s, err := json.Marshal(avroSchema)
@@ -270,7 +270,7 @@ func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.Reso
 		schemaMetadata, response, err := schemaregistryClient.UpdateSchemaWithContext(context, updateSchemaOptions)
 		if err != nil || schemaMetadata == nil {
 			log.Printf("[DEBUG] UpdateSchemaWithContext failed with error: %s\n and response: %s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSchemaWithContext failed with error: %s and response: \n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateSchemaWithContext").Resource("ibm_event_streams_schema", d.Id()).Diagnostics()
 		}
 	}
 
@@ -296,7 +296,7 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 	response, err := schemaregistryClient.DeleteSchemaWithContext(context, deleteSchemaOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSchemaWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSchemaWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteSchemaWithContext").Resource("ibm_event_streams_schema", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
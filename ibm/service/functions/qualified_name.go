@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 type QualifiedName struct {
@@ -193,3 +195,22 @@ func getQualifiedName(name string, namespace string) string {
 		return fmt.Sprintf("/%s/%s", namespace, name)
 	}
 }
+
+// upgradeFunctionID upgrades the ID of the functions entities created by the earlier versions of
+// the provider, the name of the entity alone, to <namespace>:<name>. The namespace is the
+// namespace attribute, or the FUNCTION_NAMESPACE environment variable as in these versions.
+var upgradeFunctionID = flex.UpgradeID(
+	func(id string) bool {
+		return !strings.Contains(id, ":")
+	},
+	func(id string, rawState map[string]interface{}) (string, error) {
+		namespace, _ := rawState["namespace"].(string)
+		if namespace == "" {
+			namespace = os.Getenv("FUNCTION_NAMESPACE")
+		}
+		if namespace == "" {
+			return "", errors.New("namespace is not set")
+		}
+		return fmt.Sprintf("%s:%s", namespace, id), nil
+	},
+)
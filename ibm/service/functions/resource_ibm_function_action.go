@@ -25,7 +25,7 @@ const (
 )
 
 func ResourceIBMFunctionAction() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Create:   resourceIBMFunctionActionCreate,
 		Read:     resourceIBMFunctionActionRead,
 		Update:   resourceIBMFunctionActionUpdate,
@@ -183,7 +183,7 @@ func ResourceIBMFunctionAction() *schema.Resource {
 				Description: "Action target endpoint URL.",
 			},
 		},
-	}
+	}, upgradeFunctionID)
 }
 
 func ResourceIBMFuncActionValidator() *validate.ResourceValidator {
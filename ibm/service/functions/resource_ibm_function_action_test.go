@@ -4,6 +4,7 @@
 package functions_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/functions"
 
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -1291,3 +1293,32 @@ func testAccCheckCFFunctionActionNodeJS(name, namespace string) string {
 `, name, namespace)
 
 }
+
+func TestIBMFunctionActionStateUpgradeV0(t *testing.T) {
+	upgrade := functions.ResourceIBMFunctionAction().StateUpgraders[0].Upgrade
+
+	state, err := upgrade(context.Background(), map[string]interface{}{"id": "hello", "namespace": "ns"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["id"] != "ns:hello" {
+		t.Fatalf("Expected the ID ns:hello, got %v", state["id"])
+	}
+
+	t.Setenv("FUNCTION_NAMESPACE", "env-ns")
+	state, err = upgrade(context.Background(), map[string]interface{}{"id": "hello"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["id"] != "env-ns:hello" {
+		t.Fatalf("Expected the ID env-ns:hello, got %v", state["id"])
+	}
+
+	state, err = upgrade(context.Background(), map[string]interface{}{"id": "ns:hello", "namespace": "other"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["id"] != "ns:hello" {
+		t.Fatalf("Expected the ID to be kept, got %v", state["id"])
+	}
+}
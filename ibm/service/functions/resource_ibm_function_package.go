@@ -26,7 +26,7 @@ const (
 )
 
 func ResourceIBMFunctionPackage() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Create:   resourceIBMFunctionPackageCreate,
 		Read:     resourceIBMFunctionPackageRead,
 		Update:   resourceIBMFunctionPackageUpdate,
@@ -115,7 +115,7 @@ func ResourceIBMFunctionPackage() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, upgradeFunctionID)
 }
 
 func ResourceIBMFuncPackageValidator() *validate.ResourceValidator {
@@ -22,7 +22,7 @@ const (
 )
 
 func ResourceIBMFunctionRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Create:   resourceIBMFunctionRuleCreate,
 		Read:     resourceIBMFunctionRuleRead,
 		Update:   resourceIBMFunctionRuleUpdate,
@@ -104,7 +104,7 @@ func ResourceIBMFunctionRule() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, upgradeFunctionID)
 }
 
 func ResourceIBMFuncRuleValidator() *validate.ResourceValidator {
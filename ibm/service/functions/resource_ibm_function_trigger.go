@@ -31,7 +31,7 @@ const (
 )
 
 func ResourceIBMFunctionTrigger() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		Create:   resourceIBMFunctionTriggerCreate,
 		Read:     resourceIBMFunctionTriggerRead,
 		Update:   resourceIBMFunctionTriggerUpdate,
@@ -140,7 +140,7 @@ func ResourceIBMFunctionTrigger() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, upgradeFunctionID)
 }
 
 func ResourceIBMFuncTriggerValidator() *validate.ResourceValidator {
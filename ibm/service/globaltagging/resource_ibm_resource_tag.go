@@ -6,7 +6,6 @@ package globaltagging
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
@@ -37,7 +36,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -142,12 +141,7 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
-	}
+	add = append(add, flex.DefaultTags(meta, d.Get(tagType).(string))...)
 
 	AttachTagOptions := &globaltaggingv1.AttachTagOptions{}
 	AttachTagOptions.Resources = resources
@@ -163,6 +157,7 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 
 	if len(add) > 0 {
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
+		meta.(conns.ClientSession).TagCache().Invalidate(resourceID)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
 		}
@@ -286,8 +281,9 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
+		meta.(conns.ClientSession).TagCache().Invalidate(rID)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", remove, err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error detaching resource tags %v", remove).Operation("DetachTag").Resource("ibm_resource_tag", d.Id())
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
@@ -295,7 +291,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			}
 			_, resp, err := gtClient.DeleteTag(delTagOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting resource tag %v: %s\n%s", v, err, resp)
+				return flex.NewAPIError(err, resp).Summary("Error deleting resource tag %v", v).Operation("DeleteTag").Resource("ibm_resource_tag", d.Id())
 			}
 		}
 	}
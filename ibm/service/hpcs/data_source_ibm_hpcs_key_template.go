@@ -185,7 +185,7 @@ func DataSourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceDat
 	template, response, err := ukoClient.GetKeyTemplateWithContext(context, getKeyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetKeyTemplateWithContext").Resource("data.ibm_hpcs_key_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, d.Get("uko_vault").(string), *getKeyTemplateOptions.ID))
@@ -231,7 +231,7 @@ func DataSourceIbmKeystoreRead(context context.Context, d *schema.ResourceData,
 	keystore := keystoreIntf.(*ukov4.Keystore)
 	if err != nil {
 		log.Printf("[DEBUG] GetKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetKeystoreWithContext").Resource("data.ibm_hpcs_keystore", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getKeystoreOptions.ID))
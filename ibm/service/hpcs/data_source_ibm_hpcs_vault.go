@@ -98,7 +98,7 @@ func DataSourceIbmVaultRead(context context.Context, d *schema.ResourceData, met
 	vault, response, err := ukoClient.GetVaultWithContext(context, getVaultOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVaultWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVaultWithContext").Resource("data.ibm_hpcs_vault", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getVaultOptions.ID))
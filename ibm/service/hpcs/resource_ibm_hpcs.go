@@ -47,7 +47,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -103,6 +103,13 @@ func ResourceIBMHPCS() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_hpcs", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -422,7 +429,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 	// Create HPCS Instance
 	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil || instance == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating HPCS instance: %s with resp code: %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error when creating HPCS instance").Operation("CreateResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 	}
 	d.SetId(*instance.ID)                       // Set Resource ID
 	_, err = waitForHPCSInstanceCreate(d, meta) // Wait for Instance to be available
@@ -432,8 +439,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 	}
 
 	// Update Tags for this Resource using Global Tagging APIs
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -460,7 +466,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving HPCS instance: %s with resp code: %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error retrieving HPCS instance").Operation("GetResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, resourcecontroller.RsInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -486,6 +492,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 			"[ERROR] Error on get of HPCS instance tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	// Set Location
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
@@ -633,9 +640,9 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	}
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error Getting HPCS instance").Operation("GetResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -646,7 +653,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	if update && !d.IsNewResource() { // Update RC API only if its not a new resource
 		_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating HPCS instance: %s with resp code: %s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error updating HPCS instance").Operation("UpdateResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 		}
 
 		_, err = waitForHPCSInstanceUpdate(d, meta)
@@ -727,7 +734,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 	}
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error Getting HPCS instance").Operation("GetResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 	}
 	// Bluemix Session to get Oauth tokens
 	ci, err := hsmClient(d, meta)
@@ -757,7 +764,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 	}
 	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
 	if error != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HPCS instance: %s with resp code: %s", error, resp))
+		return flex.NewAPIError(error, resp).Summary("Error deleting HPCS instance").Operation("DeleteResourceInstance").Resource("ibm_hpcs", d.Id()).Diagnostics()
 	}
 	_, err = waitForHPCSInstanceDelete(d, meta)
 	if err != nil {
@@ -242,7 +242,7 @@ func ResourceIbmKeyTemplateCreate(context context.Context, d *schema.ResourceDat
 	template, response, err := ukoClient.CreateKeyTemplateWithContext(context, createKeyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateKeyTemplateWithContext").Resource("ibm_hpcs_key_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, d.Get("uko_vault").(string), *template.ID))
@@ -279,7 +279,7 @@ func ResourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetKeyTemplateWithContext").Resource("ibm_hpcs_key_template", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("uko_vault", getKeyTemplateOptions.UKOVault); err != nil {
@@ -388,7 +388,7 @@ func ResourceIbmKeyTemplateUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := ukoClient.UpdateKeyTemplateWithContext(context, updateKeyTemplateOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateKeyTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeyTemplateWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateKeyTemplateWithContext").Resource("ibm_hpcs_key_template", d.Id()).Diagnostics()
 		}
 	}
 
@@ -424,7 +424,7 @@ func ResourceIbmKeyTemplateDelete(context context.Context, d *schema.ResourceDat
 	response, err := ukoClient.DeleteKeyTemplateWithContext(context, deleteKeyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteKeyTemplateWithContext").Resource("ibm_hpcs_key_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
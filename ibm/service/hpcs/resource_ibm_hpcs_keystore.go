@@ -258,7 +258,7 @@ func ResourceIbmKeystoreCreate(context context.Context, d *schema.ResourceData,
 	keystoreIntf, response, err := ukoClient.CreateKeystoreWithContext(context, createKeystoreOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateKeystoreWithContext").Resource("ibm_hpcs_keystore", d.Id()).Diagnostics()
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -296,7 +296,7 @@ func ResourceIbmKeystoreRead(context context.Context, d *schema.ResourceData, me
 			return nil
 		}
 		log.Printf("[DEBUG] GetKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetKeystoreWithContext").Resource("ibm_hpcs_keystore", d.Id()).Diagnostics()
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -439,7 +439,7 @@ func ResourceIbmKeystoreUpdate(context context.Context, d *schema.ResourceData,
 		_, response, err := ukoClient.UpdateKeystoreWithContext(context, updateKeystoreOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateKeystoreWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeystoreWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateKeystoreWithContext").Resource("ibm_hpcs_keystore", d.Id()).Diagnostics()
 		}
 	}
 
@@ -475,7 +475,7 @@ func ResourceIbmKeystoreDelete(context context.Context, d *schema.ResourceData,
 	response, err := ukoClient.DeleteKeystoreWithContext(context, deleteKeystoreOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteKeystoreWithContext").Resource("ibm_hpcs_keystore", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
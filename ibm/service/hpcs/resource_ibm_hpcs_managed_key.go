@@ -374,7 +374,7 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 	managedKey, response, err := ukoClient.CreateManagedKeyWithContext(context, createManagedKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, uko_vault, *managedKey.ID))
@@ -411,7 +411,7 @@ func ResourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("uko_vault", getManagedKeyOptions.UKOVault); err != nil {
@@ -570,7 +570,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := ukoClient.UpdateManagedKeyWithContext(context, updateManagedKeyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateManagedKeyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateManagedKeyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 		}
 		etag = response.Headers.Get("Etag")
 	}
@@ -591,7 +591,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Operation("DeactivateManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state"))
@@ -607,7 +607,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Operation("DestroyManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state"))
@@ -623,7 +623,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Operation("ActivateManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state"))
@@ -669,7 +669,7 @@ func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData
 	response, err := ukoClient.DeleteManagedKeyWithContext(context, deleteManagedKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteManagedKeyWithContext").Resource("ibm_hpcs_managed_key", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -151,7 +151,7 @@ func ResourceIbmVaultCreate(context context.Context, d *schema.ResourceData, met
 	vault, response, err := ukoClient.CreateVaultWithContext(context, createVaultOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateVaultWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateVaultWithContext").Resource("ibm_hpcs_vault", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instance_id, *vault.ID))
@@ -186,7 +186,7 @@ func ResourceIbmVaultRead(context context.Context, d *schema.ResourceData, meta
 			return nil
 		}
 		log.Printf("[DEBUG] GetVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVaultWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVaultWithContext").Resource("ibm_hpcs_vault", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("vault_id", vault.ID); err != nil {
@@ -258,7 +258,7 @@ func ResourceIbmVaultUpdate(context context.Context, d *schema.ResourceData, met
 		_, response, err := ukoClient.UpdateVaultWithContext(context, updateVaultOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVaultWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateVaultWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateVaultWithContext").Resource("ibm_hpcs_vault", d.Id()).Diagnostics()
 		}
 	}
 
@@ -291,7 +291,7 @@ func ResourceIbmVaultDelete(context context.Context, d *schema.ResourceData, met
 	response, err := ukoClient.DeleteVaultWithContext(context, deleteVaultOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVaultWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteVaultWithContext").Resource("ibm_hpcs_vault", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
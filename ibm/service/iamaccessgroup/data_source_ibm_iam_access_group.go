@@ -169,7 +169,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error listing Service Ids").Operation("ListServiceIds").Resource("data.ibm_iam_access_group", d.Id())
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -192,7 +192,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error listing Trusted Profiles").Operation("ListProfiles").Resource("data.ibm_iam_access_group", d.Id())
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -207,7 +207,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 	listAccessGroupOption.Offset = &offset
 	retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+		return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access groups").Operation("ListAccessGroups").Resource("data.ibm_iam_access_group", d.Id())
 	}
 
 	if len(retreivedGroups.Groups) == 0 {
@@ -221,7 +221,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 		listAccessGroupOption.SetOffset(offset)
 		retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+			return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access groups").Operation("ListAccessGroups").Resource("data.ibm_iam_access_group", d.Id())
 		}
 
 		allGroups = append(allGroups, retreivedGroups.Groups...)
@@ -2,8 +2,8 @@ package iamaccessgroup
 
 import (
 	"context"
-	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,7 +47,7 @@ func resourceIBMIAMAccessGroupAccountSettingGet(context context.Context, d *sche
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group account setting: %s. API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access group account setting").Operation("GetAccountSettings").Resource("ibm_iam_access_group_account_settings", d.Id()).Diagnostics()
 	}
 	d.SetId(*accountSetting.AccountID)
 	d.Set("public_access_enabled", accountSetting.PublicAccessEnabled)
@@ -71,7 +71,7 @@ func resourceIBMIAMAccessGroupAccountSettingSet(context context.Context, d *sche
 	updateAccountSettingsOptions.PublicAccessEnabled = core.BoolPtr(publicAccessEnabled)
 	accountSetting, detailedResponse, err := iamAccessGroupsClient.UpdateAccountSettings(updateAccountSettingsOptions)
 	if err != nil || accountSetting == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error updating access public account setting: %s. API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).Summary("Error updating access public account setting").Operation("UpdateAccountSettings").Resource("ibm_iam_access_group_account_settings", d.Id()).Diagnostics()
 	}
 	d.SetId(*accountSetting.AccountID)
 	d.Set("public_access_enabled", *accountSetting.PublicAccessEnabled)
@@ -130,7 +130,7 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	rule, detailedResponse, err := iamAccessGroupsClient.AddAccessGroupRule(addAccessGroupRuleOptions)
 	if err != nil || rule == nil {
-		return fmt.Errorf("[ERROR] Error adding rule to Access Group(%s) %s. API Response: %s", grpID, err, detailedResponse)
+		return flex.NewAPIError(err, detailedResponse).Summary("Error adding rule to Access Group(%s)", grpID).Operation("AddAccessGroupRule").Resource("ibm_iam_access_group_dynamic_rule", d.Id())
 	}
 	ruleID := rule.ID
 	d.SetId(fmt.Sprintf("%s/%s", grpID, *ruleID))
@@ -163,7 +163,7 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		} else {
-			return fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailResponse)
+			return flex.NewAPIError(err, detailResponse).Summary("Error retrieving access group Rules").Operation("GetAccessGroupRule").Resource("ibm_iam_access_group_dynamic_rule", d.Id())
 		}
 	}
 
@@ -192,7 +192,7 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	getAccessGroupRuleOptions := iamAccessGroupsClient.NewGetAccessGroupRuleOptions(grpID, ruleID)
 	_, detailedResponse, err := iamAccessGroupsClient.GetAccessGroupRule(getAccessGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group Rules: %s. API Response: %s", err, detailedResponse)
+		return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access group Rules").Operation("GetAccessGroupRule").Resource("ibm_iam_access_group_dynamic_rule", d.Id())
 	}
 
 	etag := detailedResponse.GetHeaders().Get("etag")
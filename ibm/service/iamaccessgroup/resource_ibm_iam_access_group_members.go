@@ -164,7 +164,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	listAccessGroupMembersOptions.SetLimit(limit)
 	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access group members").Operation("ListAccessGroupMembers").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 	}
 	allMembers := members.Members
 	totalMembers := flex.IntValue(members.TotalCount)
@@ -173,7 +173,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		listAccessGroupMembersOptions.SetOffset(offset)
 		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+			return flex.NewAPIError(err, detailedResponse).Summary("Error retrieving access group members").Operation("ListAccessGroupMembers").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		allMembers = append(allMembers, members.Members...)
 	}
@@ -216,7 +216,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error listing Service Ids").Operation("ListServiceIds").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -239,7 +239,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error listing Trusted Profiles").Operation("ListProfiles").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -355,7 +355,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil || serviceID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp))
+				return flex.NewAPIError(err, resp).Summary("Error Getting Service Ids").Operation("GetServiceID").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *serviceID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
@@ -371,7 +371,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 			if err != nil || profileID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp))
+				return flex.NewAPIError(err, resp).Summary("Error Getting Profile Ids").Operation("GetProfile").Resource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *profileID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
@@ -508,7 +508,7 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 	}
 	serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 	if err != nil || serviceID == nil {
-		return serviceids, fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp)
+		return serviceids, flex.NewAPIError(err, resp).Summary("Error Getting Service Ids").Operation("GetServiceID").Resource("ibm_iam_access_group_members", "")
 	}
 	return *serviceID, nil
 }
@@ -548,7 +548,7 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 	}
 	profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 	if err != nil || profileID == nil {
-		return profileids, fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp)
+		return profileids, flex.NewAPIError(err, resp).Summary("Error Getting Profile Ids").Operation("GetProfile").Resource("ibm_iam_access_group_members", "")
 	}
 	return *profileID, nil
 }
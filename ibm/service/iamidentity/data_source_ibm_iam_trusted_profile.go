@@ -153,7 +153,7 @@ func dataSourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resou
 	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetProfile").Resource("data.ibm_iam_trusted_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*trustedProfile.ID)
@@ -131,7 +131,7 @@ func dataSourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *sch
 	profileClaimRule, response, err := iamIdentityClient.GetClaimRule(getClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetClaimRule failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetClaimRule").Resource("data.ibm_iam_trusted_profile_claim_rule", d.Id()).Diagnostics()
 	}
 	d.SetId(fmt.Sprintf("%s/%s", *getClaimRuleOptions.ProfileID, *profileClaimRule.ID))
 	if err = d.Set("entity_tag", profileClaimRule.EntityTag); err != nil {
@@ -115,7 +115,7 @@ func dataSourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.R
 	profileLink, response, err := iamIdentityClient.GetLink(getLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLink failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLink").Resource("data.ibm_iam_trusted_profile_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getLinkOptions.ProfileID, *getLinkOptions.LinkID))
@@ -205,7 +205,7 @@ func dataSourceIBMIamTrustedProfileListRead(context context.Context, d *schema.R
 		trustedProfiles, response, err := iamIdentityClient.ListProfiles(listProfileOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListProfile failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListProfile failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListProfiles").Resource("data.ibm_iam_trusted_profiles", d.Id()).Diagnostics()
 		}
 		start = flex.GetNextIAM(trustedProfiles.Next)
 		allrecs = append(allrecs, trustedProfiles.Profiles...)
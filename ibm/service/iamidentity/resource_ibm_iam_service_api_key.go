@@ -183,7 +183,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Service API Key creation Error").Operation("CreateAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 
 	d.SetId(*apiKey.ID)
@@ -215,7 +215,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving Service API Key").Operation("GetAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -268,7 +268,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 
 	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("[DEBUG] Error retrieving Service API Key").Operation("GetAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -292,7 +292,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating Service API Key").Operation("UpdateAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 		}
 	}
 
@@ -317,7 +317,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving Service API Key").Operation("GetAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -326,7 +326,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("[DEBUG] Error deleting Service API Key").Operation("DeleteAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 	d.SetId("")
 
@@ -349,7 +349,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error retrieving Service API Key").Operation("GetAPIKey").Resource("ibm_iam_service_api_key", d.Id())
 	}
 	return *apiKey.ID == apiKeyID, nil
 }
@@ -5,10 +5,10 @@ package iamidentity
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -93,7 +93,7 @@ func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceDa
 	serviceID, resp, err := iamIdentityClient.CreateServiceID(&createServiceIDOptions)
 	if err != nil || serviceID == nil {
 		log.Printf("Error creating serviceID: %s, %s", err, resp)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating serviceID: %s %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error creating serviceID").Operation("CreateServiceID").Resource("ibm_iam_service_id", d.Id()).Diagnostics()
 	}
 	d.SetId(*serviceID.ID)
 
@@ -116,7 +116,7 @@ func resourceIBMIAMServiceIDRead(context context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("Error retrieving serviceID: %s %s", err, resp)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving serviceID: %s %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error retrieving serviceID").Operation("GetServiceID").Resource("ibm_iam_service_id", d.Id()).Diagnostics()
 	}
 	if serviceID.Name != nil {
 		d.Set("name", *serviceID.Name)
@@ -170,7 +170,7 @@ func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceDa
 		_, resp, err := iamIdentityClient.UpdateServiceID(&updateServiceIDOptions)
 		if err != nil {
 			log.Printf("Error updating serviceID: %s, %s", err, resp)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating serviceID: %s %s", err, resp))
+			return flex.NewAPIError(err, resp).Summary("Error updating serviceID").Operation("UpdateServiceID").Resource("ibm_iam_service_id", d.Id()).Diagnostics()
 		}
 	}
 
@@ -191,7 +191,7 @@ func resourceIBMIAMServiceIDDelete(context context.Context, d *schema.ResourceDa
 	resp, err := iamIdentityClient.DeleteServiceID(&deleteServiceIDOptions)
 	if err != nil {
 		log.Printf("Error deleting serviceID: %s %s", err, resp)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting serviceID: %s %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error deleting serviceID").Operation("DeleteServiceID").Resource("ibm_iam_service_id", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -148,7 +148,7 @@ func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.Resou
 	trustedProfile, response, err := iamIdentityClient.CreateProfile(createProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateProfile").Resource("ibm_iam_trusted_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*trustedProfile.ID)
@@ -173,7 +173,7 @@ func resourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetProfile").Resource("ibm_iam_trusted_profile", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("name", trustedProfile.Name); err != nil {
@@ -294,7 +294,7 @@ func resourceIBMIamTrustedProfileUpdate(context context.Context, d *schema.Resou
 	_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateProfile").Resource("ibm_iam_trusted_profile", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMIamTrustedProfileRead(context, d, meta)
@@ -313,7 +313,7 @@ func resourceIBMIamTrustedProfileDelete(context context.Context, d *schema.Resou
 	response, err := iamIdentityClient.DeleteProfile(deleteProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfile failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteProfile").Resource("ibm_iam_trusted_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
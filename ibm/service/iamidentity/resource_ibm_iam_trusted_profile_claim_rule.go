@@ -163,7 +163,7 @@ func resourceIBMIamTrustedProfileClaimRuleCreate(context context.Context, d *sch
 	profileClaimRule, response, err := iamIdentityClient.CreateClaimRule(createClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateClaimRule failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateClaimRule").Resource("ibm_iam_trusted_profile_claim_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", profile, *profileClaimRule.ID))
@@ -242,7 +242,7 @@ func resourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetClaimRule failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetClaimRule").Resource("ibm_iam_trusted_profile_claim_rule", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("profile_id", getClaimRuleOptions.ProfileID); err != nil {
@@ -376,7 +376,7 @@ func resourceIBMIamTrustedProfileClaimRuleUpdate(context context.Context, d *sch
 	_, response, err := iamIdentityClient.UpdateClaimRule(updateClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateClaimRule failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateClaimRule").Resource("ibm_iam_trusted_profile_claim_rule", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMIamTrustedProfileClaimRuleRead(context, d, meta)
@@ -400,7 +400,7 @@ func resourceIBMIamTrustedProfileClaimRuleDelete(context context.Context, d *sch
 	response, err := iamIdentityClient.DeleteClaimRule(deleteClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteClaimRule failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteClaimRule").Resource("ibm_iam_trusted_profile_claim_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -131,7 +131,7 @@ func resourceIBMIamTrustedProfileLinkCreate(context context.Context, d *schema.R
 	profileLink, response, err := iamIdentityClient.CreateLink(createLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateLink failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateLink").Resource("ibm_iam_trusted_profile_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", profile, *profileLink.ID))
@@ -172,7 +172,7 @@ func resourceIBMIamTrustedProfileLinkRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLink failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLink").Resource("ibm_iam_trusted_profile_link", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("profile_id", getLinkOptions.ProfileID); err != nil {
@@ -234,7 +234,7 @@ func resourceIBMIamTrustedProfileLinkDelete(context context.Context, d *schema.R
 	response, err := iamIdentityClient.DeleteLink(deleteLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteLink failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteLink").Resource("ibm_iam_trusted_profile_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
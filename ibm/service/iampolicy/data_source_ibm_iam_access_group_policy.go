@@ -186,7 +186,7 @@ func dataSourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interfac
 	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing access group policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error listing access group policies").Operation("ListPolicies").Resource("data.ibm_iam_access_group_policy", d.Id())
 	}
 
 	policies := policyList.Policies
@@ -177,7 +177,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		}
 		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 		if err != nil || resp == nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error Getting Service Id").Operation("GetServiceID").Resource("data.ibm_iam_service_policy", d.Id())
 		}
 		iamID = *serviceID.IamID
 	}
@@ -212,7 +212,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error listing service policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error listing service policies").Operation("ListPolicies").Resource("data.ibm_iam_service_policy", d.Id())
 	}
 
 	policies := policyList.Policies
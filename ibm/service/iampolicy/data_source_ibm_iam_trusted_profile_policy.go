@@ -178,7 +178,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		}
 		profile, resp, err := iamClient.GetProfile(&getprofileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting profile ID %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error getting profile ID").Operation("GetProfile").Resource("data.ibm_iam_trusted_profile_policy", d.Id())
 		}
 		iamID = *profile.IamID
 	}
@@ -213,7 +213,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing trusted profile policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error listing trusted profile policies").Operation("ListPolicies").Resource("data.ibm_iam_trusted_profile_policy", d.Id())
 	}
 
 	policies := policyList.Policies
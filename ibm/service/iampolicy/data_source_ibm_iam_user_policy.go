@@ -173,7 +173,7 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing user policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error listing user policies").Operation("ListPolicies").Resource("data.ibm_iam_user_policy", d.Id())
 	}
 
 	policies := policyList.Policies
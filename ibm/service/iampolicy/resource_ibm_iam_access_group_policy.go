@@ -271,7 +271,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
-		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error creating access group policy").Operation("CreatePolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -299,7 +299,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
-		return fmt.Errorf("[ERROR] Error fetching access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error fetching access group policy").Operation("CreatePolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
 
@@ -346,7 +346,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error retrieving access group policy").Operation("GetPolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	retrievedAttribute := flex.GetSubjectAttribute("access_group_id", accessGroupPolicy.Subjects[0])
@@ -457,7 +457,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
+			return flex.NewAPIError(err, res).Summary("Error updating access group policy").Operation("UpdatePolicy").Resource("ibm_iam_access_group_policy", d.Id())
 		}
 	}
 
@@ -487,7 +487,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 
 	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error deleting access group policy").Operation("DeletePolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	d.SetId("")
@@ -519,7 +519,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting access group policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting access group policy").Operation("GetPolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	if accessGroupPolicy != nil && accessGroupPolicy.State != nil && *accessGroupPolicy.State == "deleted" {
@@ -549,7 +549,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return nil, nil, flex.NewAPIError(err, res).Summary("Error retrieving access group policy").Operation("GetPolicy").Resource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	resources := flex.FlattenPolicyResource(accessGroupPolicy.Resources)
@@ -4,7 +4,6 @@
 package iampolicy
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -359,7 +358,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
 
 	if err != nil || roleList == nil {
-		return fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error in listing roles").Operation("ListRoles").Resource("ibm_iam_authorization_policy", d.Id())
 	}
 
 	policyRoles := flex.MapRoleListToPolicyRoles(*roleList)
@@ -387,7 +386,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	authPolicy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating authorization policy").Operation("CreatePolicy").Resource("ibm_iam_authorization_policy", d.Id())
 	}
 
 	d.SetId(*authPolicy.ID)
@@ -412,7 +411,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 
 	authorizationPolicy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
 	if err != nil || resp == nil {
-		return fmt.Errorf("[ERROR] Error retrieving authorizationPolicy: %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error retrieving authorizationPolicy").Operation("GetPolicy").Resource("ibm_iam_authorization_policy", d.Id())
 	}
 	roles := make([]string, len(authorizationPolicy.Roles))
 	for i, role := range authorizationPolicy.Roles {
@@ -490,7 +489,7 @@ func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interf
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting authorisation policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting authorisation policy").Operation("GetPolicy").Resource("ibm_iam_authorization_policy", d.Id())
 	}
 
 	if authorizationPolicy != nil && authorizationPolicy.State != nil && *authorizationPolicy.State == "deleted" {
@@ -5,9 +5,11 @@ package iampolicy
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,6 +19,9 @@ func ResourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
 		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
 		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMIAMAuthorizationPolicyDetachImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -44,7 +49,7 @@ func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta
 		return fmt.Errorf("[ERROR] Error detaching authorization policy: %s", err)
 	}
 
-	d.SetId(time.Now().UTC().String())
+	d.SetId(policyID)
 
 	return resourceIBMIAMAuthorizationPolicyDetachRead(d, meta)
 }
@@ -53,6 +58,30 @@ func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+// resourceIBMIAMAuthorizationPolicyDetachImport records the detach of an authorization policy
+// which is already deleted, the ID is the authorization policy ID.
+func resourceIBMIAMAuthorizationPolicyDetachImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	policyID := d.Id()
+
+	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
+		PolicyID: core.StringPtr(policyID),
+	}
+	authorizationPolicy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return nil, flex.NewAPIError(err, resp).Summary("Error getting authorization policy").Operation("GetPolicy").Resource("ibm_iam_authorization_policy_detach", d.Id())
+	}
+	if err == nil && (authorizationPolicy.State == nil || *authorizationPolicy.State != "deleted") {
+		return nil, fmt.Errorf("[ERROR] Authorization policy %s is not detached", policyID)
+	}
+
+	d.Set("authorization_policy_id", policyID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId("")
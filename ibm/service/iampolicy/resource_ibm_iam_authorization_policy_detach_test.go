@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAuthorizationPolicyDetach_Basic(t *testing.T) {
+	resourceName := "ibm_iam_authorization_policy_detach.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAuthorizationPolicyDetachBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "authorization_policy_id", "ibm_iam_authorization_policy.policy", "id"),
+				),
+				// The detached policy is gone from the refreshed state
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAuthorizationPolicyDetachBasic() string {
+	return `
+	resource "ibm_iam_authorization_policy" "policy" {
+		source_service_name = "cloud-object-storage"
+		target_service_name = "kms"
+		roles               = ["Reader"]
+		description         = "Authorization Policy for test scenario"
+	}
+
+	resource "ibm_iam_authorization_policy_detach" "policy" {
+		authorization_policy_id = ibm_iam_authorization_policy.policy.id
+	}
+	`
+}
@@ -233,7 +233,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		}
 		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error Getting Service Id").Operation("GetServiceID").Resource("ibm_iam_service_policy", d.Id())
 		}
 		iamID = *serviceID.IamID
 	}
@@ -294,7 +294,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error creating servicePolicy").Operation("CreatePolicy").Resource("ibm_iam_service_policy", d.Id())
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -329,7 +329,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 			iamID := v.(string)
 			d.SetId(fmt.Sprintf("%s/%s", iamID, *servicePolicy.ID))
 		}
-		return fmt.Errorf("[ERROR] Error fetching service  policy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error fetching service policy").Operation("GetPolicy").Resource("ibm_iam_service_policy", d.Id())
 	}
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
 		serviceIDUUID := v.(string)
@@ -382,7 +382,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error retrieving servicePolicy").Operation("GetPolicy").Resource("ibm_iam_service_policy", d.Id())
 	}
 	if strings.HasPrefix(serviceIDUUID, "iam-") {
 		d.Set("iam_id", serviceIDUUID)
@@ -454,7 +454,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+				return flex.NewAPIError(err, resp).Summary("Error Getting Service Id").Operation("GetServiceID").Resource("ibm_iam_service_policy", d.Id())
 			}
 			iamID = *serviceID.IamID
 		}
@@ -509,7 +509,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Policy").Operation("GetPolicy").Resource("ibm_iam_service_policy", d.Id())
 		}
 
 		servicePolicyETag := response.Headers.Get("ETag")
@@ -595,7 +595,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting service policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting service policy").Operation("GetPolicy").Resource("ibm_iam_service_policy", d.Id())
 	}
 
 	if servicePolicy != nil && servicePolicy.State != nil && *servicePolicy.State == "deleted" {
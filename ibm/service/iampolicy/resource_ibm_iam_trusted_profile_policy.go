@@ -233,7 +233,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		}
 		profileID, resp, err := iamClient.GetProfile(getProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error getting trusted profile ID").Operation("GetProfile").Resource("ibm_iam_trusted_profile_policy", d.Id())
 		}
 		iamID = *profileID.IamID
 	}
@@ -294,7 +294,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error creating trustedProfilePolicy").Operation("CreatePolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -329,7 +329,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 			iamID := v.(string)
 			d.SetId(fmt.Sprintf("%s/%s", iamID, *trustedProfilePolicy.ID))
 		}
-		return fmt.Errorf("[ERROR] Error fetching trusted profile policy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error fetching trusted profile policy").Operation("GetPolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 	if v, ok := d.GetOk("profile_id"); ok && v != nil {
 		profileIDUUID := v.(string)
@@ -381,7 +381,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error retrieving trusted profile policy").Operation("GetPolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 	if strings.HasPrefix(profileIDUUID, "iam-") {
 		d.Set("iam_id", profileIDUUID)
@@ -447,7 +447,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+				return flex.NewAPIError(err, resp).Summary("Error getting trusted profile ID").Operation("GetProfile").Resource("ibm_iam_trusted_profile_policy", d.Id())
 			}
 			iamID = *profileID.IamID
 		}
@@ -502,7 +502,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Policy").Operation("GetPolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 		}
 
 		trustedProfilePolicyETag := response.Headers.Get("ETag")
@@ -526,7 +526,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error updating trusted profile policy").Operation("UpdatePolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 		}
 
 	}
@@ -557,7 +557,7 @@ func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta inter
 
 	resp, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error deleting trusted profile policy").Operation("DeletePolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 
 	d.SetId("")
@@ -589,7 +589,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting trusted profile policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting trusted profile policy").Operation("GetPolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 
 	if trustedProfilePolicy != nil && trustedProfilePolicy.State != nil && *trustedProfilePolicy.State == "deleted" {
@@ -617,7 +617,7 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 	)
 	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
+		return nil, nil, flex.NewAPIError(err, resp).Summary("Error retrieving trusted profile policy").Operation("GetPolicy").Resource("ibm_iam_trusted_profile_policy", d.Id())
 	}
 	resources := flex.FlattenPolicyResource(trustedProfilePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(trustedProfilePolicy.Resources)
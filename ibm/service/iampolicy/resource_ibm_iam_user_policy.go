@@ -255,7 +255,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error creating user policies").Operation("CreatePolicy").Resource("ibm_iam_user_policy", d.Id())
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -332,7 +332,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
+		return flex.NewAPIError(err, res).Summary("Error retrieving userPolicy").Operation("GetPolicy").Resource("ibm_iam_user_policy", d.Id())
 	}
 	d.Set("ibm_id", userEmail)
 	roles := make([]string, len(userPolicy.Roles))
@@ -432,7 +432,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Policy").Operation("GetPolicy").Resource("ibm_iam_user_policy", d.Id())
 		}
 
 		userPolicyETag := response.Headers.Get("ETag")
@@ -456,7 +456,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error updating user policy").Operation("UpdatePolicy").Resource("ibm_iam_user_policy", d.Id())
 		}
 	}
 	return resourceIBMIAMUserPolicyRead(d, meta)
@@ -516,7 +516,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting user policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting user policy").Operation("GetPolicy").Resource("ibm_iam_user_policy", d.Id())
 	}
 
 	if userPolicy != nil && userPolicy.State != nil && *userPolicy.State == "deleted" {
@@ -265,7 +265,7 @@ func populateKPClient(d *schema.ResourceData, meta interface{}, instanceID strin
 
 	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return nil, nil, flex.NewAPIError(err, resp).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_kms_key", d.Id())
 	}
 	extensions := instanceData.Extensions
 	kpAPI.URL, err = KmsEndpointURL(kpAPI, endpointType, extensions)
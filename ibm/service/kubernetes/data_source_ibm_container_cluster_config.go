@@ -153,8 +153,10 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	network := d.Get("network").(bool)
 
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutRead), clusterId); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(clusterId)
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
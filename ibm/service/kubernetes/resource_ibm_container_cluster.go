@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -72,7 +71,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -449,6 +448,13 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -920,6 +926,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1319,8 +1326,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
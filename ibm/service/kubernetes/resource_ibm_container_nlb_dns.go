@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -17,11 +18,12 @@ import (
 )
 
 func ResourceIBMContainerNlbDns() *schema.Resource {
-	return &schema.Resource{
+	return flex.ResourceWithStateUpgrades(&schema.Resource{
 		CreateContext: resourceIbmContainerNlbDnsCreate,
 		ReadContext:   resourceIbmContainerNlbDnsRead,
 		UpdateContext: resourceIbmContainerNlbDnsUpdate,
 		DeleteContext: resourceIbmContainerNlbDnsDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -74,7 +76,7 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 				Description:      "The ID of the resource group that the cluster is in. To check the resource group ID of the cluster, use the GET /v1/clusters/idOrName API. To list available resource group IDs, run ibmcloud resource groups.",
 			},
 		},
-	}
+	}, upgradeNlbDnsID)
 }
 
 func ResourceIBMContainerNlbDnsValidator() *validate.ResourceValidator {
@@ -119,52 +121,98 @@ func resourceIbmContainerNlbDnsCreate(context context.Context, d *schema.Resourc
 	response, err := satClient.UpdateDNSWithIPWithContext(context, registerDNSWithIPOptions)
 	if err != nil {
 		log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateDNSWithIPWithContext").Resource("ibm_container_nlb_dns", d.Id()).Diagnostics()
 	}
 
-	d.SetId(*registerDNSWithIPOptions.IdOrName)
+	d.SetId(fmt.Sprintf("%s/%s", *registerDNSWithIPOptions.IdOrName, *registerDNSWithIPOptions.NlbHost))
 
 	return resourceIbmContainerNlbDnsRead(context, d, meta)
 }
 
+// upgradeNlbDnsID upgrades the ID of the resources created by earlier versions of the provider, the
+// cluster alone, to <cluster>/<nlb_host>.
+var upgradeNlbDnsID = flex.UpgradeID(
+	func(id string) bool {
+		return !strings.Contains(id, "/")
+	},
+	func(id string, rawState map[string]interface{}) (string, error) {
+		nlbHost, _ := rawState["nlb_host"].(string)
+		if nlbHost == "" {
+			return "", fmt.Errorf("nlb_host is not set")
+		}
+		return fmt.Sprintf("%s/%s", id, nlbHost), nil
+	},
+)
+
+// nlbDnsIDParts returns the cluster and the NLB host of the resource.
+func nlbDnsIDParts(d *schema.ResourceData) (cluster, nlbHost string, err error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return "", "", err
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of cluster/nlbHost", d.Id())
+	}
+	return parts[0], parts[1], nil
+}
+
 func resourceIbmContainerNlbDnsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	kubeClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	cluster, nlbHost, err := nlbDnsIDParts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	nlbData, err := kubeClient.NlbDns().GetNLBDNSList(d.Id())
-	if err != nil || nlbData == nil || len(nlbData) < 1 {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Listing NLB DNS (%s): %s", d.Id(), err))
+	nlbData, err := kubeClient.NlbDns().GetNLBDNSList(cluster)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Listing NLB DNS (%s): %s", cluster, err))
 	}
 
-	if nlbData != nil {
-		for _, nlbConfig := range nlbData {
-			if err = d.Set("cluster", d.Id()); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
-			}
-			if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
-			}
-			if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
-			}
-			if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
-			}
-			if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
-			}
+	for _, nlbConfig := range nlbData {
+		if nlbConfig.Nlb.NlbSubdomain != nlbHost {
+			continue
 		}
+		d.SetId(fmt.Sprintf("%s/%s", cluster, nlbHost))
+		if err = d.Set("cluster", cluster); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
+		}
+		if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
+		}
+		if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
+		}
+		ips := make([]string, 0, len(nlbConfig.Nlb.NlbIPArray))
+		for _, ip := range nlbConfig.Nlb.NlbIPArray {
+			ips = append(ips, fmt.Sprint(ip))
+		}
+		if err = d.Set("nlb_ips", ips); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ips: %s", err))
+		}
+		if err = d.Set("nlb_monitor_state", nlbConfig.Nlb.NlbMonitorState); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_monitor_state: %s", err))
+		}
+		if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
+		}
+		if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
+		}
+		if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
+		}
+		if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
+		}
+		return nil
 	}
 
+	log.Printf("[WARN] NLB DNS %s of the cluster %s is not found", nlbHost, cluster)
+	d.SetId("")
 	return nil
 }
 
@@ -174,10 +222,13 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	cluster, nlbHost, err := nlbDnsIDParts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	updateDNSWithIPOptions := &kubernetesserviceapiv1.UpdateDNSWithIPOptions{}
 
-	updateDNSWithIPOptions.SetIdOrName(d.Id())
-	nlbHost := d.Get("nlb_host").(string)
+	updateDNSWithIPOptions.SetIdOrName(cluster)
 
 	updateDNSWithIPOptions.NlbHost = flex.PtrToString(nlbHost)
 
@@ -192,14 +243,14 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 
 		if len(remove) > 0 {
 			unregisterDNSWithIPOptions := &kubernetesserviceapiv1.UnregisterDNSWithIPOptions{}
-			unregisterDNSWithIPOptions.SetIdOrName(d.Id())
+			unregisterDNSWithIPOptions.SetIdOrName(cluster)
 			unregisterDNSWithIPOptions.SetNlbHost(nlbHost)
 			for _, r := range remove {
 				unregisterDNSWithIPOptions.SetNlbIP(r)
 				response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 				if err != nil {
 					log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Operation("UnregisterDNSWithIPWithContext").Resource("ibm_container_nlb_dns", d.Id()).Diagnostics()
 				}
 			}
 		}
@@ -214,7 +265,7 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 			response, err := satClient.UpdateDNSWithIPWithContext(context, updateDNSWithIPOptions)
 			if err != nil {
 				log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("UpdateDNSWithIPWithContext").Resource("ibm_container_nlb_dns", d.Id()).Diagnostics()
 			}
 		}
 	}
@@ -228,24 +279,25 @@ func resourceIbmContainerNlbDnsDelete(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	cluster, nlbHost, err := nlbDnsIDParts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	unregisterDNSWithIPOptions := &kubernetesserviceapiv1.UnregisterDNSWithIPOptions{}
-	unregisterDNSWithIPOptions.SetIdOrName(d.Id())
+	unregisterDNSWithIPOptions.SetIdOrName(cluster)
+	unregisterDNSWithIPOptions.SetNlbHost(nlbHost)
 	if res, ok := d.GetOk("resource_group_id"); ok {
 		header := map[string]string{}
 		header["X-Auth-Resource-Group"] = res.(string)
 		unregisterDNSWithIPOptions.SetHeaders(header)
 	}
-	if nlbHost, ok := d.GetOk("nlb_host"); ok && nlbHost != nil {
-		unregisterDNSWithIPOptions.SetNlbHost(nlbHost.(string))
-	}
-
 	if ips, ok := d.GetOk("nlb_ips"); ok && ips != nil {
 		for _, i := range ips.(*schema.Set).List() {
 			unregisterDNSWithIPOptions.SetNlbIP(i.(string))
 			response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("UnregisterDNSWithIPWithContext").Resource("ibm_container_nlb_dns", d.Id()).Diagnostics()
 			}
 		}
 	}
@@ -4,12 +4,14 @@
 package kubernetes_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +33,14 @@ func TestAccIbmContainerNlbDnsBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_container_nlb_dns.container_nlb_dns", "nlb_ips.#", "3"),
 				),
 			},
+			{
+				ResourceName:      "ibm_container_nlb_dns.container_nlb_dns",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_group_id",
+				},
+			},
 		},
 	})
 }
@@ -75,3 +85,19 @@ func testAccCheckIbmContainerNlbDnsExists(n string, obj kubernetesserviceapiv1.N
 		return nil
 	}
 }
+
+func TestIBMContainerNlbDnsStateUpgradeV0(t *testing.T) {
+	upgrade := kubernetes.ResourceIBMContainerNlbDns().StateUpgraders[0].Upgrade
+
+	state, err := upgrade(context.Background(), map[string]interface{}{"id": "cluster", "nlb_host": "host.example.com"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state["id"] != "cluster/host.example.com" {
+		t.Fatalf("Expected the ID cluster/host.example.com, got %v", state["id"])
+	}
+
+	if _, err := upgrade(context.Background(), map[string]interface{}{"id": "cluster"}, nil); err == nil {
+		t.Fatal("Expected an error without nlb_host")
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -46,7 +45,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -248,6 +247,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -634,8 +640,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	clusterID := d.Id()
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -1049,6 +1054,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1161,7 +1167,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, "done", nil
 			}
-			return nil, "failed", fmt.Errorf("[ERROR] The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", flex.NewAPIError(err, response).Summary("The vpc load balancer %s failed to delete", id).Operation("GetLoadBalancer").Resource("ibm_container_vpc_cluster", "")
 		}
 		return lb, "deleting", nil
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -28,6 +29,9 @@ func ResourceIBMPIInstanceConsoleLanguage() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceConsoleLanguageRead,
 		UpdateContext: resourceIBMPIInstanceConsoleLanguageUpdate,
 		DeleteContext: resourceIBMPIInstanceConsoleLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIInstanceConsoleLanguageImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -87,6 +91,21 @@ func resourceIBMPIInstanceConsoleLanguageRead(ctx context.Context, d *schema.Res
 	return nil
 }
 
+func resourceIBMPIInstanceConsoleLanguageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of pi_cloud_instance_id/pi_instance_name", d.Id())
+	}
+	d.Set(helpers.PICloudInstanceId, parts[0])
+	d.Set(helpers.PIInstanceName, parts[1])
+
+	// The console language of an instance cannot be read, the code is left for the configuration to set
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIInstanceConsoleLanguageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
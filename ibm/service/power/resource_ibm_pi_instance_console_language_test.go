@@ -31,6 +31,14 @@ func TestAccIBMPIInstanceConsoleLanguage(t *testing.T) {
 						"ibm_pi_console_language.example", "pi_language_code", "e1399"),
 				),
 			},
+			{
+				ResourceName:      "ibm_pi_console_language.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"pi_language_code",
+				},
+			},
 		},
 	})
 }
@@ -194,7 +194,7 @@ func DataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 		}
 		listInstanceResponse, resp, err := rsConClient.ListResourceInstances(&resourceInstanceListOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error retrieving resource instance").Operation("ListResourceInstances").Resource("data.ibm_resource_instance", d.Id())
 		}
 		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
 		if err != nil {
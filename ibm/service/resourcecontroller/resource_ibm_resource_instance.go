@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -51,7 +50,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -123,6 +122,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_resource_instance",
 					"tags"),
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -509,7 +515,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error when creating resource instance").Operation("CreateResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 
 	d.SetId(*instance.ID)
@@ -519,8 +525,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -544,7 +549,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
@@ -553,6 +558,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 			"Error on get of resource instance tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -700,7 +706,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("parameters") {
 		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return flex.NewAPIError(err, resp).Summary("Error retrieving resource instance").Operation("GetResourceInstance").Resource("ibm_resource_instance", d.Id())
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -751,10 +757,10 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error Getting resource instance").Operation("GetResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -765,7 +771,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error updating resource instance").Operation("UpdateResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 
 	_, err = waitForResourceInstanceUpdate(d, meta)
@@ -793,7 +799,7 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 410 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting resource instance: %s with resp code: %s", error, resp)
+		return flex.NewAPIError(error, resp).Summary("Error deleting resource instance").Operation("DeleteResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 
 	_, err = waitForResourceInstanceDelete(d, meta)
@@ -820,7 +826,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource instance: %s with resp code: %s", err, resp)
+		return false, flex.NewAPIError(err, resp).Summary("Error getting resource instance").Operation("GetResourceInstance").Resource("ibm_resource_instance", d.Id())
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, RsInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
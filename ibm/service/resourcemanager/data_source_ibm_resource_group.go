@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -137,10 +138,10 @@ func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	rg, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
 	if err != nil || rg == nil || rg.Resources == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource group: %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Error retrieving resource group").Operation("ListResourceGroups").Resource("data.ibm_resource_group", d.Id())
 	}
 	if len(rg.Resources) < 1 {
-		return fmt.Errorf("[ERROR] Given Resource Group is not found in the account : %s %s", err, resp)
+		return flex.NewAPIError(err, resp).Summary("Given Resource Group is not found in the account").Operation("ListResourceGroups").Resource("data.ibm_resource_group", d.Id())
 	}
 	resourceGroup := rg.Resources[0]
 	d.SetId(*resourceGroup.ID)
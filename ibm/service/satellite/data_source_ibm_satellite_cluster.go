@@ -4,7 +4,6 @@
 package satellite
 
 import (
-	"fmt"
 	"log"
 	"strings"
 
@@ -201,7 +200,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 
 	clusterFields, response, err := satClient.GetCluster(getSatClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving cluster: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving cluster").Operation("GetCluster").Resource("data.ibm_satellite_cluster", d.Id())
 	}
 
 	getWorkersOptions := &kubernetesserviceapiv1.GetWorkers1Options{}
@@ -211,7 +210,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 	}
 	workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for satellite cluster: %s, %s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving workers for satellite cluster").Operation("GetWorkers1").Resource("data.ibm_satellite_cluster", d.Id())
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -230,7 +229,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 
 	workerPools, response, err := satClient.GetWorkerPools1(getSatWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s\n%s", name, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving worker pools of the cluster %s", name).Operation("GetWorkerPools1").Resource("data.ibm_satellite_cluster", d.Id())
 	}
 
 	d.SetId(*clusterFields.ID)
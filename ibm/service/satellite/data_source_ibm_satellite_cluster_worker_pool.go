@@ -4,10 +4,9 @@
 package satellite
 
 import (
-	"fmt"
-
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -131,7 +130,7 @@ func dataSourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta in
 
 	workerPool, response, err := satClient.GetWorkerPool(getSatWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pool  %s: %s\n%s", name, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving worker pool  %s", name).Operation("GetWorkerPool").Resource("data.ibm_satellite_cluster_worker_pool", d.Id())
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -113,7 +113,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		locData, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
 	}
 	if err != nil || locData == nil {
-		return fmt.Errorf("[ERROR] Error getting Satellite location (%s): %s\n%s", location, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Satellite location (%s)", location).Operation("GetSatelliteLocation").Resource("data.ibm_satellite_attach_host_script", d.Id())
 	}
 
 	// script labels
@@ -157,7 +157,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 
 	resp, err := satClient.AttachSatelliteHost(createRegOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Generating Satellite Registration Script: %s\n%s", err, resp)
+		return flex.NewAPIError(err, nil).Summary("Error Generating Satellite Registration Script").Operation("AttachSatelliteHost").Resource("data.ibm_satellite_attach_host_script", d.Id())
 	}
 
 	scriptContent := string(resp)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -145,7 +146,7 @@ func dataSourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceD
 	location, response, err := satelliteLinkClient.GetLinkWithContext(context, getLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLinkWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLinkWithContext").Resource("data.ibm_satellite_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*location.LocationID)
@@ -4,7 +4,6 @@
 package satellite
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -171,7 +170,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
 	}
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM cloud satellite location %s : %s\n%s", location, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving IBM cloud satellite location %s", location).Operation("GetSatelliteLocation").Resource("data.ibm_satellite_location", d.Id())
 	}
 
 	d.SetId(*instance.ID)
@@ -202,7 +201,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 
 	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving location hosts %s", location).Operation("GetSatelliteHosts").Resource("data.ibm_satellite_location", d.Id())
 	}
 	if hostList != nil {
 		d.Set("hosts", flex.FlattenSatelliteHosts(hostList))
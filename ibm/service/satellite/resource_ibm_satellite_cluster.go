@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -55,7 +54,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 				if err != nil || cluster == nil {
 					if response != nil && response.StatusCode == 404 && strings.Contains(err.Error(), "The specified cluster could not be found") {
-						return nil, fmt.Errorf("Error reading satellite cluster: %s\n%s", err, response)
+						return nil, flex.NewAPIError(err, response).Summary("Error reading satellite cluster").Operation("GetCluster").Resource("ibm_satellite_cluster", d.Id())
 					}
 					return nil, fmt.Errorf("Error reading satellite cluster: %s", err)
 				}
@@ -70,7 +69,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -252,6 +251,13 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			"host_labels": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -385,14 +391,14 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		instance, response, err := satClient.CreateSatelliteClusterRemote(createRemoteClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Satellite Cluster for remote location").Operation("CreateSatelliteClusterRemote").Resource("ibm_satellite_cluster", d.Id())
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster for remote location: %s", clusterId)
 	} else {
 		instance, response, err := satClient.CreateSatelliteCluster(createClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Satellite Cluster").Operation("CreateSatelliteCluster").Resource("ibm_satellite_cluster", d.Id())
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster : %s", clusterId)
@@ -420,7 +426,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 					response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+						return flex.NewAPIError(err, response).Summary("Error Adding Worker Pool Zone").Operation("CreateSatelliteWorkerPoolZone").Resource("ibm_satellite_cluster", d.Id())
 					}
 				}
 			}
@@ -448,12 +454,11 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating the labels").Operation("V2SetWorkerPoolLabels").Resource("ibm_satellite_cluster", d.Id())
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster: flex.PtrToString(clusterId),
 		}
@@ -550,6 +555,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("default_worker_pool_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	d.Set("host_labels", flex.FlattenWorkerPoolHostLabels(workerPool.HostLabels))
 	d.Set("operating_system", workerPool.OperatingSystem)
@@ -605,7 +611,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerOpts := satClient.NewGetWorkers1Options(clusterID)
 		workerFields, response, err := satClient.GetWorkers1(workerOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving workerFields").Operation("GetWorkers1").Resource("ibm_satellite_cluster", d.Id())
 		}
 
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
@@ -615,7 +621,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving cluster %s", clusterID).Operation("GetCluster").Resource("ibm_satellite_cluster", d.Id())
 		}
 		waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 		if workerFields != nil {
@@ -654,7 +660,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2ResizeWorkerPool(resizeOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating the worker pool size %d", workerCount).Operation("V2ResizeWorkerPool").Resource("ibm_satellite_cluster", d.Id())
 		}
 	}
 
@@ -674,12 +680,11 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating the labels").Operation("V2SetWorkerPoolLabels").Resource("ibm_satellite_cluster", d.Id())
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster:            &clusterID,
@@ -688,7 +693,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving cluster %s", clusterID).Operation("GetCluster").Resource("ibm_satellite_cluster", d.Id())
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
@@ -720,7 +725,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error Adding Worker Pool Zone").Operation("CreateSatelliteWorkerPoolZone").Resource("ibm_satellite_cluster", d.Id())
 				}
 			}
 			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
@@ -739,7 +744,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error deleting Worker Pool Zone").Operation("RemoveWorkerPoolZone").Resource("ibm_satellite_cluster", d.Id())
 				}
 			}
 		}
@@ -760,7 +765,7 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 
 	response, err := satClient.RemoveCluster(removeClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating Satellite Location").Operation("RemoveCluster").Resource("ibm_satellite_cluster", d.Id())
 	}
 
 	//Wait for cluster to get delete
@@ -838,7 +843,7 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 			}
 			instance, response, err := satClient.GetCluster(getClusterOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting cluster : %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting cluster").Operation("GetCluster").Resource("ibm_satellite_cluster", d.Id())
 			}
 			if instance != nil && *instance.State == isCluterDeployFailed {
 				return instance, isCluterDeployFailed, fmt.Errorf("[ERROR] The cluster failed to deploy : %s", cluster)
@@ -60,7 +60,7 @@ func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 				}
 				workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
 				if err != nil {
-					return nil, fmt.Errorf("Error reading satellite worker pool: %s\n%s", response, err)
+					return nil, flex.NewAPIError(err, response).Summary("Error reading satellite worker pool").Operation("GetWorkerPool").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 				}
 
 				var zones = make([]map[string]interface{}, 0)
@@ -263,7 +263,7 @@ func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta in
 
 	instance, response, err := satClient.CreateSatelliteWorkerPool(createWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating Satellite cluster worker pool").Operation("CreateSatelliteWorkerPool").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cluster, *instance.WorkerPoolID))
@@ -349,7 +349,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating the labels").Operation("V2SetWorkerPoolLabels").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 		}
 	}
 
@@ -399,7 +399,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error Adding Worker Pool Zone").Operation("CreateSatelliteWorkerPoolZone").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 				}
 			}
 			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
@@ -418,7 +418,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error deleting Worker Pool Zone").Operation("RemoveWorkerPoolZone").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 				}
 			}
 		}
@@ -454,7 +454,7 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Satellite Cluster WorkerPool").Operation("RemoveWorkerPool").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 	}
 
 	_, err = WaitForSatelliteWorkerDelete(clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
@@ -497,7 +497,7 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 
 			workers, response, err := satClient.GetWorkers1(getWorkersOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error retrieving workers for cluster").Operation("GetWorkers1").Resource("ibm_satellite_cluster_worker_pool", d.Id())
 			}
 
 			//Check active transactions
@@ -549,7 +549,7 @@ func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1
 
 		workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error retrieving workers for cluster").Operation("GetWorkers1").Resource("ibm_satellite_cluster_worker_pool", workerPoolNameOrID)
 		}
 		//Done worker has two fields desiredState and actualState , so check for those 2
 		for _, e := range workerFields {
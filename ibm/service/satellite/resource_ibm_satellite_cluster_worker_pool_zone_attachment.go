@@ -84,7 +84,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentCreate(context context.C
 	response, err := satClient.CreateSatelliteWorkerPoolZone(createSatelliteWorkerPoolZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSatelliteWorkerPoolZoneWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateSatelliteWorkerPoolZone").Resource("ibm_satellite_cluster_worker_pool_zone_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerpool, zone))
@@ -114,7 +114,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentRead(context context.Con
 			return nil
 		}
 		log.Printf("[DEBUG] GetWorkerPool1WithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkerPool1WithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetWorkerPoolWithContext").Resource("ibm_satellite_cluster_worker_pool_zone_attachment", d.Id()).Diagnostics()
 	}
 
 	if getWorkerPoolResponse != nil && getWorkerPoolResponse.Zones != nil {
@@ -153,7 +153,7 @@ func resourceIbmSatelliteClusterWorkerPoolZoneAttachmentDelete(context context.C
 	response, err := satClient.RemoveWorkerPoolZoneWithContext(context, removeWorkerPoolZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] RemoveWorkerPoolZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RemoveWorkerPoolZoneWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("RemoveWorkerPoolZoneWithContext").Resource("ibm_satellite_cluster_worker_pool_zone_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
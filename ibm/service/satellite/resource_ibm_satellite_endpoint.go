@@ -445,7 +445,7 @@ func resourceIbmSatelliteEndpointCreate(context context.Context, d *schema.Resou
 	endpoint, response, err := satelliteLinkClient.CreateEndpointsWithContext(context, createEndpointsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateEndpointsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateEndpointsWithContext").Resource("ibm_satellite_endpoint", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createEndpointsOptions.LocationID, *endpoint.EndpointID))
@@ -597,7 +597,7 @@ func resourceIbmSatelliteEndpointRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] ListEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListEndpointsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetEndpointsWithContext").Resource("ibm_satellite_endpoint", d.Id()).Diagnostics()
 	}
 
 	if endpoint.EndpointID != nil {
@@ -897,7 +897,7 @@ func resourceIbmSatelliteEndpointUpdate(context context.Context, d *schema.Resou
 		_, response, err := satelliteLinkClient.UpdateEndpointsWithContext(context, updateEndpointsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateEndpointsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateEndpointsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateEndpointsWithContext").Resource("ibm_satellite_endpoint", d.Id()).Diagnostics()
 		}
 	}
 
@@ -1026,7 +1026,7 @@ func resourceIbmSatelliteEndpointDelete(context context.Context, d *schema.Resou
 	_, response, err := satelliteLinkClient.DeleteEndpointsWithContext(context, deleteEndpointsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteEndpointsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteEndpointsWithContext").Resource("ibm_satellite_endpoint", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -166,7 +166,7 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	if hostStatus == rsHostReadyStatus {
 		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Assigning Satellite Host").Operation("CreateSatelliteAssignment").Resource("ibm_satellite_host", d.Id())
 		}
 	}
 
@@ -273,7 +273,7 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Satellite Host").Operation("UpdateSatelliteHost").Resource("ibm_satellite_host", d.Id())
 		}
 	}
 
@@ -302,7 +302,7 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Satellite Host").Operation("RemoveSatelliteHost").Resource("ibm_satellite_host", d.Id())
 	}
 
 	d.SetId("")
@@ -325,7 +325,7 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 			hostList, resp, err := satClient.GetSatelliteHosts(attachOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() != 404 {
-					return nil, "", fmt.Errorf("[ERROR] The satellite host (%s) failed to attached: %v\n%s", hostName, err, resp)
+					return nil, "", flex.NewAPIError(err, resp).Summary("The satellite host (%s) failed to attached", hostName).Operation("GetSatelliteHosts").Resource("ibm_satellite_host", d.Id())
 				}
 			}
 
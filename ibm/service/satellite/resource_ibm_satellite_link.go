@@ -158,7 +158,7 @@ func resourceIbmSatelliteLinkCreate(context context.Context, d *schema.ResourceD
 	location, response, err := satelliteLinkClient.CreateLinkWithContext(context, createLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateLinkWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateLinkWithContext").Resource("ibm_satellite_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*location.LocationID)
@@ -188,7 +188,7 @@ func resourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLinkWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLinkWithContext").Resource("ibm_satellite_link", d.Id()).Diagnostics()
 	}
 
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
@@ -201,7 +201,7 @@ func resourceIbmSatelliteLinkRead(context context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSatelliteLocation failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSatelliteLocation").Resource("ibm_satellite_link", d.Id()).Diagnostics()
 	}
 
 	d.Set("crn", *locInstance.Crn)
@@ -299,7 +299,7 @@ func resourceIbmSatelliteLinkUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := satelliteLinkClient.UpdateLinkWithContext(context, updateLinkOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateLinkWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateLinkWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateLinkWithContext").Resource("ibm_satellite_link", d.Id()).Diagnostics()
 		}
 	}
 
@@ -319,7 +319,7 @@ func resourceIbmSatelliteLinkDelete(context context.Context, d *schema.ResourceD
 	_, response, err := satelliteLinkClient.DeleteLinkWithContext(context, deleteLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteLinkWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteLinkWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteLinkWithContext").Resource("ibm_satellite_link", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
@@ -51,7 +50,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 				instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 				if err != nil || instance == nil {
-					return nil, fmt.Errorf("Error reading satellite location: %s\n%s", err, response)
+					return nil, flex.NewAPIError(err, response).Summary("Error reading satellite location").Operation("GetSatelliteLocation").Resource("ibm_satellite_location", d.Id())
 				}
 
 				d.Set("zones", flex.NewStringSet(schema.HashString, instance.WorkerZones))
@@ -61,7 +60,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ImmutableResourceCustomizeDiff([]string{satLocation, sateLocZone, "resource_group_id", "zones"}, diff)
@@ -173,6 +172,13 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags associated with resource instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			flex.ResourceGroupName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -277,14 +283,13 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating Satellite Location").Operation("CreateSatelliteLocation").Resource("ibm_satellite_location", d.Id())
 	}
 
 	d.SetId(*instance.ID)
 	log.Printf("[INFO] Created satellite location : %s", satLocation)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -345,6 +350,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 			"Error on get of ibm satellite location tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("crn", *instance.Crn)
 	d.Set(flex.ResourceGroupName, *instance.ResourceGroupName)
 	if instance.Hosts != nil {
@@ -367,8 +373,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 			Controller: &ID,
@@ -376,7 +381,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 
 		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving satellite location").Operation("GetSatelliteLocation").Resource("ibm_satellite_location", d.Id())
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -399,7 +404,7 @@ func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}
 
 	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Satellite Location").Operation("RemoveSatelliteLocation").Resource("ibm_satellite_location", d.Id())
 	}
 
 	//Wait for location to delete
@@ -425,7 +430,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 			getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationsOptions{}
 			locations, response, err := satClient.GetSatelliteLocations(getSatLocOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting locations list to delete : %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting locations list to delete").Operation("GetSatelliteLocations").Resource("ibm_satellite_location", d.Id())
 			}
 
 			isExist := false
@@ -440,7 +445,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 					return location, isLocationDeleteDone, nil
 				}
 			}
-			return nil, "", fmt.Errorf("[ERROR] Failed to delete location : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Failed to delete location").Operation("GetSatelliteLocations").Resource("ibm_satellite_location", d.Id())
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      60 * time.Second,
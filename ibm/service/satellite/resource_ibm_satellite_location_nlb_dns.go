@@ -67,7 +67,7 @@ func resourceIbmSatelliteLocationNlbDnsCreate(context context.Context, d *schema
 	mscRegisterResp, response, err := satClient.RegisterMultishiftClusterWithContext(context, registerMultishiftClusterOptions)
 	if err != nil {
 		log.Printf("[DEBUG] RegisterMultishiftClusterWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterMultishiftClusterWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("RegisterMultishiftClusterWithContext").Resource("ibm_satellite_location_nlb_dns", d.Id()).Diagnostics()
 	}
 	d.SetId(*mscRegisterResp.Controller)
 
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -85,7 +86,7 @@ func dataSourceIbmSccAccountLocationRead(context context.Context, d *schema.Reso
 	location, response, err := adminServiceApiClient.GetLocationWithContext(context, getLocationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLocationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLocationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLocationWithContext").Resource("data.ibm_scc_account_location", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*location.ID)
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -48,7 +49,7 @@ func dataSourceIbmSccAccountNotificationSettingsRead(context context.Context, d
 	accountSettings, response, err := adminServiceApiClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSettingsWithContext").Resource("data.ibm_scc_account_notification_settings", d.Id()).Diagnostics()
 	}
 	notificationsSettings := accountSettings.EventNotifications
 
@@ -204,7 +204,7 @@ func dataSourceIBMSccPostureCollectorRead(context context.Context, d *schema.Res
 	collector, response, err := postureManagementClient.GetCollectorWithContext(context, CollectorOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCollectorWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCollectorWithContext").Resource("data.ibm_scc_posture_collector", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*collector.ID)
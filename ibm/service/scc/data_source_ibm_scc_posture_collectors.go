@@ -285,7 +285,7 @@ func dataSourceIBMSccPostureListCollectorsRead(context context.Context, d *schem
 	collectorList, response, err := postureManagementClient.ListCollectorsWithContext(context, listCollectorsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListCollectorsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListCollectorsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListCollectorsWithContext").Resource("data.ibm_scc_posture_collectors", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMSccPostureListCollectorsID(d))
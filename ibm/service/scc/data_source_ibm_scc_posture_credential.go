@@ -223,7 +223,7 @@ func dataSourceIBMSccPostureCredentialRead(context context.Context, d *schema.Re
 	credential, response, err := postureManagementClient.GetCredentialWithContext(context, CredentialOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCredentialWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCredentialWithContext").Resource("data.ibm_scc_posture_credential", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*credential.ID)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -286,7 +287,7 @@ func dataSourceIBMSccPostureListCredentialsRead(context context.Context, d *sche
 	credentialList = result
 	if err != nil {
 		log.Printf("[DEBUG] ListCredentialsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListCredentialsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListCredentialsWithContext").Resource("data.ibm_scc_posture_credentials", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMSccPostureListCredentialsID(d))
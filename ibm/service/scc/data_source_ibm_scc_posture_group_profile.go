@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -176,7 +177,7 @@ func dataSourceIBMSccPostureGroupProfileDetailsRead(context context.Context, d *
 		controlList = result
 		if err != nil {
 			log.Printf("[DEBUG] GetProfileControlsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetProfileControlsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetProfileControlsWithContext").Resource("data.ibm_scc_posture_group_profile", d.Id()).Diagnostics()
 		}
 		offset = dataSourceControlListGetNext(result.Next)
 		finalList = append(finalList, result.Controls...)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -252,7 +253,7 @@ func dataSourceIBMSccPostureListLatestScansRead(context context.Context, d *sche
 		scanList = result
 		if err != nil {
 			log.Printf("[DEBUG] ListLatestScansWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListLatestScansWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListLatestScansWithContext").Resource("data.ibm_scc_posture_latest_scans", d.Id()).Diagnostics()
 		}
 		offset = dataSourceScanListGetNext(result.Next)
 		if suppliedFilter {
@@ -116,7 +116,7 @@ func dataSourceIBMSccPostureProfileDetailsRead(context context.Context, d *schem
 	profile, response, err := postureManagementClient.GetProfileWithContext(context, getProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetProfileWithContext").Resource("data.ibm_scc_posture_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*profile.ID)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -172,7 +173,7 @@ func dataSourceIBMSccPostureListProfilesRead(context context.Context, d *schema.
 		profileList = result
 		if err != nil {
 			log.Printf("[DEBUG] ListProfilesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListProfilesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListProfilesWithContext").Resource("data.ibm_scc_posture_profiles", d.Id()).Diagnostics()
 		}
 		offset = dataSourceProfileListGetNext(result.Next)
 		finalList = append(finalList, result.Profiles...)
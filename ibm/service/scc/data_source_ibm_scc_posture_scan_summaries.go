@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -320,7 +321,7 @@ func dataSourceIBMSccPostureScanSummariesRead(context context.Context, d *schema
 		summaryList = result
 		if err != nil {
 			log.Printf("[DEBUG] ScanSummariesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ScanSummariesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ScanSummariesWithContext").Resource("data.ibm_scc_posture_scan_summaries", d.Id()).Diagnostics()
 		}
 		offset = dataSourceSummaryListGetNext(result.Next)
 		finalList = append(finalList, result.Summaries...)
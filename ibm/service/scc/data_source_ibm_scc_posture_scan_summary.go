@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -211,7 +212,7 @@ func dataSourceIBMSccPostureScansSummaryRead(context context.Context, d *schema.
 	summary, response, err := postureManagementClient.ScansSummaryWithContext(context, scansSummaryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ScansSummaryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ScansSummaryWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ScansSummaryWithContext").Resource("data.ibm_scc_posture_scan_summary", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*summary.ID)
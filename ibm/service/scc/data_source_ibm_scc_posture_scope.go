@@ -817,7 +817,7 @@ func dataSourceIBMSccPostureScopeRead(context context.Context, d *schema.Resourc
 	scope, response, err := postureManagementClient.GetScopeDetailsWithContext(context, ScopeDetailsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetScopeDetailsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetScopeDetailsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetScopeDetailsWithContext").Resource("data.ibm_scc_posture_scope", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*scope.ID)
@@ -66,7 +66,7 @@ func dataSourceIBMSccPostureScopeCorrelationRead(context context.Context, d *sch
 	scopeTaskStatus, response, err := postureManagementClient.GetCorrelationIDWithContext(context, getCorrelationIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetCorrelationIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCorrelationIDWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCorrelationIDWithContext").Resource("data.ibm_scc_posture_scope_correlation", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMSccPostureScopeCorrelationID(d))
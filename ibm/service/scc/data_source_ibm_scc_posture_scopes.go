@@ -343,7 +343,7 @@ func dataSourceIBMSccPostureListScopesRead(context context.Context, d *schema.Re
 	finalList, response, err := postureManagementClient.ListScopesWithContext(context, listScopesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListScopesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListScopesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListScopesWithContext").Resource("data.ibm_scc_posture_scopes", d.Id()).Diagnostics()
 	}
 
 	scopeList := finalList
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -155,7 +156,7 @@ func resourceIbmSccAccountSettingsCreate(context context.Context, d *schema.Reso
 		_, response, err = adminServiceApiClient.PatchAccountSettingsWithContext(context, patchAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("PatchAccountSettingsWithContext").Resource("ibm_scc_account_settings", d.Id()).Diagnostics()
 		}
 	}
 	// Set the ID of the Terraform object
@@ -194,7 +195,7 @@ func resourceIbmSccAccountSettingsRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSettingsWithContext").Resource("ibm_scc_account_settings", d.Id()).Diagnostics()
 	}
 
 	if accountSettings.Location != nil {
@@ -285,7 +286,7 @@ func resourceIbmSccAccountSettingsUpdate(context context.Context, d *schema.Reso
 		_, response, err := adminServiceApiClient.PatchAccountSettingsWithContext(context, patchAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("PatchAccountSettingsWithContext").Resource("ibm_scc_account_settings", d.Id()).Diagnostics()
 		}
 	}
 
@@ -311,7 +312,7 @@ func resourceIbmSccAccountSettingsDelete(context context.Context, d *schema.Reso
 	_, response, err := adminServiceApiClient.GetSettingsWithContext(context, getSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PatchAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PatchAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSettingsWithContext").Resource("ibm_scc_account_settings", d.Id()).Diagnostics()
 	}
 	// Set the object to a empty string so Terraform deletes the object
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -137,7 +138,7 @@ func resourceIBMSccPostureCollectorsCreate(context context.Context, d *schema.Re
 	collector, response, err := postureManagementClient.CreateCollectorWithContext(context, createCollectorOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCollectorWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateCollectorWithContext").Resource("ibm_scc_posture_collector", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*collector.ID)
@@ -168,7 +169,7 @@ func resourceIBMSccPostureCollectorsRead(context context.Context, d *schema.Reso
 			return nil
 		}
 		log.Printf("[DEBUG] GetCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCollectorWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCollectorWithContext").Resource("ibm_scc_posture_collector", d.Id()).Diagnostics()
 	}
 	d.SetId(*(collector.ID))
 	return nil
@@ -197,7 +198,7 @@ func resourceIBMSccPostureCollectorsUpdate(context context.Context, d *schema.Re
 		_, response, err := postureManagementClient.UpdateCollectorWithContext(context, updateCollectorOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateCollectorWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateCollectorWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateCollectorWithContext").Resource("ibm_scc_posture_collector", d.Id()).Diagnostics()
 		}
 	}
 
@@ -223,7 +224,7 @@ func resourceIBMSccPostureCollectorsDelete(context context.Context, d *schema.Re
 	response, err := postureManagementClient.DeleteCollectorWithContext(context, deleteCollectorOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCollectorWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCollectorWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteCollectorWithContext").Resource("ibm_scc_posture_collector", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
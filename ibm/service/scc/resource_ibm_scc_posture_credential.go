@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -144,7 +145,7 @@ func resourceIBMSccPostureCredentialsCreate(context context.Context, d *schema.R
 	credential, response, err := postureManagementClient.CreateCredentialWithContext(context, createCredentialOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCredentialWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateCredentialWithContext").Resource("ibm_scc_posture_credential", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*credential.ID)
@@ -195,7 +196,7 @@ func resourceIBMSccPostureCredentialsRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCredentialWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetCredentialWithContext").Resource("ibm_scc_posture_credential", d.Id()).Diagnostics()
 	}
 	d.SetId(*(credential.ID))
 	return nil
@@ -246,7 +247,7 @@ func resourceIBMSccPostureCredentialsUpdate(context context.Context, d *schema.R
 	_, response, err := postureManagementClient.UpdateCredentialWithContext(context, updateCredentialOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateCredentialWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateCredentialWithContext").Resource("ibm_scc_posture_credential", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMSccPostureCredentialsRead(context, d, meta)
@@ -271,7 +272,7 @@ func resourceIBMSccPostureCredentialsDelete(context context.Context, d *schema.R
 	response, err := postureManagementClient.DeleteCredentialWithContext(context, deleteCredentialOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCredentialWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCredentialWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteCredentialWithContext").Resource("ibm_scc_posture_credential", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -116,7 +116,7 @@ func resourceIBMSccPostureProfileImport(context context.Context, d *schema.Resou
 	profile, response, err := postureManagementClient.ImportProfilesWithContext(context, importProfilesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ImportProfilesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ImportProfilesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ImportProfilesWithContext").Resource("ibm_scc_posture_import_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*profile.ProfileID)
@@ -145,7 +145,7 @@ func resourceIBMSccPostureProfileImportRead(context context.Context, d *schema.R
 	profile, response, err := postureManagementClient.GetProfileWithContext(context, getProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetProfileWithContext").Resource("ibm_scc_posture_import_profile", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*profile.ID)
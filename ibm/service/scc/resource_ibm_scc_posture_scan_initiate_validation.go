@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
 	"github.com/go-openapi/strfmt"
@@ -185,7 +186,7 @@ func resourceIBMSccPostureScanInitiateValidation(context context.Context, d *sch
 	result, response, err := postureManagementClient.CreateValidationWithContext(context, createValidationOptions)
 	if result == nil || err != nil {
 		log.Printf("[DEBUG] CreateValidationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateValidationWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateValidationWithContext").Resource("ibm_scc_posture_scan_initiate_validation", d.Id()).Diagnostics()
 	}
 
 	if *result.Result {
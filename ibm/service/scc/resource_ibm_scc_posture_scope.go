@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -130,7 +131,7 @@ func resourceIBMSccPostureScopesCreate(context context.Context, d *schema.Resour
 	scope, response, err := postureManagementClient.CreateScopeWithContext(context, createScopeOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateScopeWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateScopeWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateScopeWithContext").Resource("ibm_scc_posture_scope", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*scope.ID)
@@ -162,7 +163,7 @@ func resourceIBMSccPostureScopesRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetScopeDetailsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetScopeDetailsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetScopeDetailsWithContext").Resource("ibm_scc_posture_scope", d.Id()).Diagnostics()
 	}
 	d.SetId(*scope.ID)
 
@@ -200,7 +201,7 @@ func resourceIBMSccPostureScopesUpdate(context context.Context, d *schema.Resour
 		_, response, err := postureManagementClient.UpdateScopeDetailsWithContext(context, updateScopeDetailsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateScopeDetailsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateScopeDetailsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateScopeDetailsWithContext").Resource("ibm_scc_posture_scope", d.Id()).Diagnostics()
 		}
 	}
 
@@ -226,7 +227,7 @@ func resourceIBMSccPostureScopesDelete(context context.Context, d *schema.Resour
 	response, err := postureManagementClient.DeleteScopeWithContext(context, deleteScopeOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteScopeWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteScopeWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteScopeWithContext").Resource("ibm_scc_posture_scope", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -239,7 +239,7 @@ func resourceIBMSccRuleCreate(context context.Context, d *schema.ResourceData, m
 	createRulesResponse, response, err := configurationGovernanceClient.CreateRulesWithContext(context, createRulesOptions)
 	if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
 		log.Printf("[DEBUG] CreateRulesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRulesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateRulesWithContext").Resource("ibm_scc_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*createRulesResponse.Rules[0].Rule.RuleID)
@@ -266,7 +266,7 @@ func resourceIBMSccRuleRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetRuleWithContext").Resource("ibm_scc_rule", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("account_id", rule.AccountID); err != nil {
@@ -386,7 +386,7 @@ func resourceIBMSccRuleUpdate(context context.Context, d *schema.ResourceData, m
 		_, response, err := configurationGovernanceClient.UpdateRuleWithContext(context, updateRuleOptions)
 		if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
 			log.Printf("[DEBUG] UpdateRuleWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateRuleWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateRuleWithContext").Resource("ibm_scc_rule", d.Id()).Diagnostics()
 		}
 	}
 
@@ -406,7 +406,7 @@ func resourceIBMSccRuleDelete(context context.Context, d *schema.ResourceData, m
 	response, err := configurationGovernanceClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteRuleWithContext").Resource("ibm_scc_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
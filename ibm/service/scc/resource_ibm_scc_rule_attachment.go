@@ -129,7 +129,7 @@ func resourceIBMSccRuleAttachmentCreate(context context.Context, d *schema.Resou
 	createRuleAttachmentsResponse, response, err := configurationGovernanceClient.CreateRuleAttachmentsWithContext(context, createRuleAttachmentsOptions)
 	if err != nil || response.StatusCode > 300 {
 		log.Printf("[DEBUG] CreateRuleAttachmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleAttachmentsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateRuleAttachmentsWithContext").Resource("ibm_scc_rule_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createRuleAttachmentsOptions.RuleID, *createRuleAttachmentsResponse.Attachments[0].AttachmentID))
@@ -160,7 +160,7 @@ func resourceIBMSccRuleAttachmentRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetRuleAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetRuleAttachmentWithContext").Resource("ibm_scc_rule_attachment", d.Id()).Diagnostics()
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -248,7 +248,7 @@ func resourceIBMSccRuleAttachmentUpdate(context context.Context, d *schema.Resou
 		_, response, err := configurationGovernanceClient.UpdateRuleAttachmentWithContext(context, updateRuleAttachmentOptions)
 		if err != nil || response.StatusCode > 300 {
 			log.Printf("[DEBUG] UpdateRuleAttachmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateRuleAttachmentWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateRuleAttachmentWithContext").Resource("ibm_scc_rule_attachment", d.Id()).Diagnostics()
 		}
 	}
 
@@ -274,7 +274,7 @@ func resourceIBMSccRuleAttachmentDelete(context context.Context, d *schema.Resou
 	response, err := configurationGovernanceClient.DeleteRuleAttachmentWithContext(context, deleteRuleAttachmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRuleAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteRuleAttachmentWithContext").Resource("ibm_scc_rule_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
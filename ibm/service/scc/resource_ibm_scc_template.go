@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/scc-go-sdk/v3/configurationgovernancev1"
@@ -132,7 +133,7 @@ func resourceIBMSccTemplateCreate(context context.Context, d *schema.ResourceDat
 	createTemplatesResponse, response, err := configurationGovernanceClient.CreateTemplatesWithContext(context, createTemplatesOptions)
 	if err != nil || response.GetStatusCode() == 207 || response.StatusCode > 300 {
 		log.Printf("[DEBUG] CreateTemplatesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplatesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTemplatesWithContext").Resource("ibm_scc_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*createTemplatesResponse.Templates[0].Template.TemplateID)
@@ -157,7 +158,7 @@ func resourceIBMSccTemplateRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTemplateWithContext").Resource("ibm_scc_template", d.Id()).Diagnostics()
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -236,7 +237,7 @@ func resourceIBMSccTemplateUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := configurationGovernanceClient.UpdateTemplateWithContext(context, updateTemplateOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTemplateWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateTemplateWithContext").Resource("ibm_scc_template", d.Id()).Diagnostics()
 		}
 	}
 
@@ -256,7 +257,7 @@ func resourceIBMSccTemplateDelete(context context.Context, d *schema.ResourceDat
 	response, err := configurationGovernanceClient.DeleteTemplateWithContext(context, deleteTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTemplateWithContext").Resource("ibm_scc_template", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
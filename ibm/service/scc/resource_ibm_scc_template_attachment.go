@@ -119,7 +119,7 @@ func resourceIBMSccTemplateAttachmentCreate(context context.Context, d *schema.R
 	createTemplateAttachmentsResponse, response, err := configurationGovernanceClient.CreateTemplateAttachmentsWithContext(context, createTemplateAttachmentsOptions)
 	if err != nil || response.StatusCode > 300 {
 		log.Printf("[DEBUG] CreateTemplateAttachmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplateAttachmentsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateTemplateAttachmentsWithContext").Resource("ibm_scc_template_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTemplateAttachmentsOptions.TemplateID, *createTemplateAttachmentsResponse.Attachments[0].AttachmentID))
@@ -150,7 +150,7 @@ func resourceIBMSccTemplateAttachmentRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetTemplateAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetTemplateAttachmentWithContext").Resource("ibm_scc_template_attachment", d.Id()).Diagnostics()
 	}
 
 	// TODO: handle argument of type []interface{}
@@ -239,7 +239,7 @@ func resourceIBMSccTemplateAttachmentUpdate(context context.Context, d *schema.R
 		_, response, err := configurationGovernanceClient.UpdateTemplateAttachmentWithContext(context, updateTemplateAttachmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTemplateAttachmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTemplateAttachmentWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateTemplateAttachmentWithContext").Resource("ibm_scc_template_attachment", d.Id()).Diagnostics()
 		}
 	}
 
@@ -265,7 +265,7 @@ func resourceIBMSccTemplateAttachmentDelete(context context.Context, d *schema.R
 	response, err := configurationGovernanceClient.DeleteTemplateAttachmentWithContext(context, deleteTemplateAttachmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTemplateAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTemplateAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteTemplateAttachmentWithContext").Resource("ibm_scc_template_attachment", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -963,7 +963,7 @@ func dataSourceIBMSchematicsActionRead(context context.Context, d *schema.Resour
 	action, response, err := schematicsClient.GetActionWithContext(context, getActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetActionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetActionWithContext").Resource("data.ibm_schematics_action", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*getActionOptions.ActionID)
@@ -134,7 +134,7 @@ func dataSourceIBMSchematicsResourceQueryRead(context context.Context, d *schema
 	resourceQueryRecord, response, err := schematicsClient.GetResourcesQueryWithContext(context, getResourcesQueryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetResourcesQueryWithContext").Resource("data.ibm_schematics_resource_query", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*getResourcesQueryOptions.QueryID)
@@ -595,7 +595,7 @@ func dataSourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Res
 	workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetWorkspaceWithContext").Resource("data.ibm_schematics_workspace", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*getWorkspaceOptions.WID)
@@ -1087,7 +1087,7 @@ func resourceIBMSchematicsActionCreate(context context.Context, d *schema.Resour
 	action, response, err := schematicsClient.CreateActionWithContext(context, createActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateActionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateActionWithContext").Resource("ibm_schematics_action", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*action.ID)
@@ -1362,7 +1362,7 @@ func resourceIBMSchematicsActionRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetActionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetActionWithContext").Resource("ibm_schematics_action", d.Id()).Diagnostics()
 	}
 	if err = d.Set("name", action.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
@@ -1934,7 +1934,7 @@ func resourceIBMSchematicsActionUpdate(context context.Context, d *schema.Resour
 		_, response, err := schematicsClient.UpdateActionWithContext(context, updateActionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateActionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateActionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateActionWithContext").Resource("ibm_schematics_action", d.Id()).Diagnostics()
 		}
 	}
 
@@ -1959,7 +1959,7 @@ func resourceIBMSchematicsActionDelete(context context.Context, d *schema.Resour
 	response, err := schematicsClient.DeleteActionWithContext(context, deleteActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteActionWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteActionWithContext").Resource("ibm_schematics_action", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
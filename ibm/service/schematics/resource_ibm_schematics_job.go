@@ -2939,7 +2939,7 @@ func resourceIBMSchematicsJobCreate(context context.Context, d *schema.ResourceD
 	job, response, err := schematicsClient.CreateJobWithContext(context, createJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateJobWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateJobWithContext").Resource("ibm_schematics_job", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*job.ID)
@@ -3922,7 +3922,7 @@ func resourceIBMSchematicsJobRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetJobWithContext").Resource("ibm_schematics_job", d.Id()).Diagnostics()
 	}
 	if err = d.Set("command_object", job.CommandObject); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting command_object: %s", err))
@@ -5062,7 +5062,7 @@ func resourceIBMSchematicsJobUpdate(context context.Context, d *schema.ResourceD
 	_, response, err := schematicsClient.UpdateJobWithContext(context, updateJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateJobWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("UpdateJobWithContext").Resource("ibm_schematics_job", d.Id()).Diagnostics()
 	}
 
 	return resourceIBMSchematicsJobRead(context, d, meta)
@@ -5095,7 +5095,7 @@ func resourceIBMSchematicsJobDelete(context context.Context, d *schema.ResourceD
 	response, err := schematicsClient.DeleteJobWithContext(context, deleteJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteJobWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteJobWithContext").Resource("ibm_schematics_job", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
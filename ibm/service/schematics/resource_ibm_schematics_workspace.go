@@ -645,7 +645,7 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 	workspaceResponse, response, err := schematicsClient.CreateWorkspaceWithContext(context, createWorkspaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateWorkspaceWithContext").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*workspaceResponse.ID)
@@ -924,7 +924,7 @@ func resourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetWorkspaceWithContext").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 	}
 	if workspaceResponse.AppliedShareddataIds != nil {
 		if err = d.Set("applied_shareddata_ids", workspaceResponse.AppliedShareddataIds); err != nil {
@@ -1636,7 +1636,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 			_, response, err := schematicsClient.ReplaceWorkspaceWithContext(context, replaceWorkspaceOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ReplaceWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ReplaceWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ReplaceWorkspaceWithContext").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 			}
 		}
 
@@ -1644,7 +1644,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 			_, response, err := schematicsClient.UpdateWorkspaceWithContext(context, updateWorkspaceOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UpdateWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UpdateWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("UpdateWorkspaceWithContext").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 			}
 		}
 
@@ -1671,7 +1671,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 				_, response, err := schematicsClient.ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions)
 				if err != nil {
 					log.Printf("[DEBUG] ReplaceWorkspaceInputs failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ReplaceWorkspaceInputs failed %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Operation("ReplaceWorkspaceInputs").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 				}
 			}
 		}
@@ -1707,7 +1707,7 @@ func resourceIBMSchematicsWorkspaceDelete(context context.Context, d *schema.Res
 	_, response, err := schematicsClient.DeleteWorkspaceWithContext(context, deleteWorkspaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteWorkspaceWithContext").Resource("ibm_schematics_workspace", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
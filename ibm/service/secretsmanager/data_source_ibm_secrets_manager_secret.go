@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
@@ -266,7 +267,7 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 	}
 	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error:%s Response: %s", err, resp))
+		return flex.NewAPIError(err, resp).Summary("Error retrieving the Secrets Manager instance %s", instanceID).Operation("GetResourceInstance").Resource("data.ibm_secrets_manager_secret", d.Id()).Diagnostics()
 	}
 	instanceCRN := instanceData.CRN
 
@@ -4,10 +4,11 @@
 package transitgateway
 
 import (
-	"fmt"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -100,7 +101,7 @@ func dataSourceIBMTransitGatewayConnectionPrefixFiltersRead(d *schema.ResourceDa
 	listTransitGatewayConnectionPrefixFiltersOptionsModel.SetID(connectionId)
 	listPrefixFilters, response, err := client.ListTransitGatewayConnectionPrefixFilters(listTransitGatewayConnectionPrefixFiltersOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while listing transit gateway connection prefix filters %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while listing transit gateway connection prefix filters").Operation("ListTransitGatewayConnectionPrefixFilters").Resource("data.ibm_tg_connection_prefix_filters", d.Id())
 	}
 
 	prefixFiltersCollection := make([]map[string]interface{}, 0)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -149,7 +150,7 @@ func dataSourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) e
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
 	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing transit gateways %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while listing transit gateways").Operation("ListTransitGateways").Resource("data.ibm_tg_gateway", d.Id())
 	}
 
 	gwName := d.Get(tgName).(string)
@@ -197,7 +198,7 @@ func dataSourceIBMTransitGatewayConnectionsRead(d *schema.ResourceData, meta int
 	listTransitGatewayConnectionsOptions.SetTransitGatewayID(tgGatewayId)
 	listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing transit gateway connections %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while listing transit gateway connections").Operation("ListTransitGatewayConnections").Resource("data.ibm_tg_gateway", d.Id())
 	}
 	connections := make([]map[string]interface{}, 0)
 
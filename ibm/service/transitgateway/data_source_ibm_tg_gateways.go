@@ -4,11 +4,12 @@
 package transitgateway
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMTransitGateways() *schema.Resource {
@@ -75,7 +76,7 @@ func dataSourceIBMTransitGatewaysRead(d *schema.ResourceData, meta interface{})
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
 	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing transit gateways %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while listing transit gateways").Operation("ListTransitGateways").Resource("data.ibm_tg_gateways", d.Id())
 	}
 
 	tgws := make([]map[string]interface{}, 0)
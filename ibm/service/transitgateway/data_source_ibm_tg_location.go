@@ -4,11 +4,12 @@
 package transitgateway
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -76,7 +77,7 @@ func dataSourceIBMTransitGatewaysLocationRead(d *schema.ResourceData, meta inter
 	detailGatewayLocationOptionsModel.Name = &locName
 	detailTransitGatewayLocation, response, err := client.GetGatewayLocation(detailGatewayLocationOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while fetching transit gateway detailed location: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while fetching transit gateway detailed location").Operation("GetGatewayLocation").Resource("data.ibm_tg_location", d.Id())
 	}
 
 	if detailTransitGatewayLocation != nil {
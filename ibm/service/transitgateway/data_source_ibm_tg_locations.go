@@ -4,11 +4,12 @@
 package transitgateway
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -61,7 +62,7 @@ func dataSourceIBMTransitGatewaysLocationsRead(d *schema.ResourceData, meta inte
 	listTransitGatewayLocationsOptionsModel := &transitgatewayapisv1.ListGatewayLocationsOptions{}
 	listTransitGatewayLocations, response, err := client.ListGatewayLocations(listTransitGatewayLocationsOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while fetching transit gateways locations: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while fetching transit gateways locations").Operation("ListGatewayLocations").Resource("data.ibm_tg_locations", d.Id())
 	}
 
 	tgLocationsCol := make([]map[string]interface{}, 0)
@@ -5,9 +5,7 @@ package transitgateway
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -55,7 +53,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -91,6 +89,13 @@ func ResourceIBMTransitGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the transit gateway instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			tgResourceGroup: {
 				Type:     schema.TypeString,
@@ -220,8 +225,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(tgGatewayTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -254,7 +258,7 @@ func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApis
 		}
 		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway").Operation("GetTransitGateway").Resource("ibm_tg_gateway", id)
 		}
 
 		if *transitGateway.Status == "available" || *transitGateway.Status == "failed" {
@@ -303,6 +307,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			"Error on get of transit gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(tgGatewayTags, tags)
+	d.Set("tags_all", tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -353,7 +358,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 			updateTransitGatewayOptions.Global = &global
 		}
 	}
-	if d.HasChange(tgGatewayTags) {
+	if d.HasChange(tgGatewayTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -388,7 +393,7 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting Transit Gateway (%s)", ID).Operation("DeleteTransitGateway").Resource("ibm_tg_gateway", d.Id())
 	}
 	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -424,7 +429,7 @@ func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatew
 			if response != nil && response.StatusCode == 404 {
 				return transitGateway, isTransitGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway").Operation("GetTransitGateway").Resource("ibm_tg_gateway", id)
 		}
 		return transitGateway, isTransitGatewayDeleting, err
 	}
@@ -449,7 +454,7 @@ func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Transit Gateway: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway").Operation("GetTransitGateway").Resource("ibm_tg_gateway", d.Id())
 	}
 
 	return true, nil
@@ -257,7 +257,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create Transit Gateway connection err").Operation("CreateTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
@@ -304,7 +304,7 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		getTransitGatewayConnectionOptions.SetID(ID)
 		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Connection (%s)", ID).Operation("GetTransitGatewayConnection").Resource("ibm_tg_gateway_connection", id)
 		}
 		if *tgConnection.Status == "attached" || *tgConnection.Status == "failed" {
 			return tgConnection, isTransitGatewayConnectionAttached, nil
@@ -336,7 +336,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Connection (%s)", ID).Operation("GetTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 
 	if instance.Name != nil {
@@ -370,7 +370,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	}
 	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway").Operation("GetTransitGateway").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *tgw.Crn)
 
@@ -398,7 +398,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Connection").Operation("GetTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 
 	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
@@ -413,7 +413,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error in Update Transit Gateway Connection").Operation("UpdateTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
@@ -442,7 +442,7 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting Transit Gateway Connection(%s)", ID).Operation("DeleteTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
@@ -491,7 +491,7 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 				return tgConnection, isTransitGatewayConnectionDeleted, nil
 			}
 
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Connection (%s)", ID).Operation("GetTransitGatewayConnection").Resource("ibm_tg_gateway_connection", id)
 		}
 		return tgConnection, isTransitGatewayConnectionDeleting, err
 	}
@@ -521,7 +521,7 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Connection").Operation("GetTransitGatewayConnection").Resource("ibm_tg_gateway_connection", d.Id())
 	}
 
 	return true, nil
@@ -159,7 +159,7 @@ func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta int
 
 	tgRouteReport, response, err := client.CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions)
 	if err != nil {
-		return fmt.Errorf("Create Transit Gateway Route Report err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create Transit Gateway Route Report err").Operation("CreateTransitGatewayRouteReport").Resource("ibm_tg_gateway_route_report", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgRouteReport.ID))
@@ -204,7 +204,7 @@ func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.Transit
 
 		routeReport, response, getRouteErr := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptions)
 		if getRouteErr != nil {
-			return nil, "", fmt.Errorf("Error Getting Transit Gateway Route Report: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Route Report").Operation("GetTransitGatewayRouteReport").Resource("ibm_tg_gateway_route_report", id)
 		}
 
 		if *routeReport.Status == "complete" {
@@ -238,7 +238,7 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Transit Gateway Route Report (%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Transit Gateway Route Report (%s)", ID).Operation("GetTransitGatewayRouteReport").Resource("ibm_tg_gateway_route_report", d.Id())
 	}
 
 	d.Set(tgRouteReportId, *instance.ID)
@@ -358,7 +358,7 @@ func resourceIBMTransitGatewayRouteReportDelete(d *schema.ResourceData, meta int
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway Route Report(%s): %s\n%s", ID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting Transit Gateway Route Report(%s)", ID).Operation("DeleteTransitGatewayRouteReport").Resource("ibm_tg_gateway_route_report", d.Id())
 	}
 
 	d.SetId("")
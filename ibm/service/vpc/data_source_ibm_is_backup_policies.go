@@ -214,7 +214,7 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 		backupPolicyCollection, response, err := sess.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListBackupPoliciesWithContext").Resource("data.ibm_is_backup_policies", d.Id()).Diagnostics()
 		}
 		if backupPolicyCollection != nil && *backupPolicyCollection.TotalCount == int64(0) {
 			break
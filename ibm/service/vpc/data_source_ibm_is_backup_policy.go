@@ -168,7 +168,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 		backupPolicyInfo, response, err := sess.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetBackupPolicyWithContext").Resource("data.ibm_is_backup_policy", d.Id()).Diagnostics()
 		}
 		backupPolicy = backupPolicyInfo
 
@@ -185,7 +185,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 			backupPolicyCollection, response, err := sess.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListBackupPoliciesWithContext").Resource("data.ibm_is_backup_policy", d.Id()).Diagnostics()
 			}
 			if backupPolicyCollection != nil && *backupPolicyCollection.TotalCount == int64(0) {
 				break
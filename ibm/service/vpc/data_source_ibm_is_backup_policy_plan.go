@@ -120,7 +120,7 @@ func dataSourceIBMIsBackupPolicyPlanRead(context context.Context, d *schema.Reso
 		backupPolicyPlanInfo, response, err := sess.GetBackupPolicyPlanWithContext(context, getBackupPolicyPlanOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetBackupPolicyPlanWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyPlanWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetBackupPolicyPlanWithContext").Resource("data.ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 		}
 		backupPolicyPlan = backupPolicyPlanInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -133,7 +133,7 @@ func dataSourceIBMIsBackupPolicyPlanRead(context context.Context, d *schema.Reso
 		backupPolicyPlanCollection, response, err := sess.ListBackupPolicyPlansWithContext(context, listBackupPolicyPlansOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListBackupPolicyPlansWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPolicyPlansWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListBackupPolicyPlansWithContext").Resource("data.ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 		}
 		for _, backupPolicyPlanInfo := range backupPolicyPlanCollection.Plans {
 			if *backupPolicyPlanInfo.Name == name {
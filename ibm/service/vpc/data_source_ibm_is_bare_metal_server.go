@@ -412,7 +412,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		server, response, err := sess.GetBareMetalServerWithContext(context, options)
 		if err != nil {
 			log.Printf("[DEBUG] GetBareMetalServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response))
+			return flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("data.ibm_is_bare_metal_server", d.Id()).Diagnostics()
 		}
 		bms = server
 	} else if name != "" {
@@ -421,7 +421,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		bmservers, response, err := sess.ListBareMetalServersWithContext(context, options)
 		if err != nil {
 			log.Printf("[DEBUG] ListBareMetalServersWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Listing Bare Metal Server (%s): %s\n%s", name, err, response))
+			return flex.NewAPIError(err, response).Summary("Error Listing Bare Metal Server (%s)", name).Operation("ListBareMetalServersWithContext").Resource("data.ibm_is_bare_metal_server", d.Id()).Diagnostics()
 		}
 		if len(bmservers.BareMetalServers) == 0 {
 			return diag.FromErr(fmt.Errorf("[ERROR] No bare metal servers found with name %s", name))
@@ -443,7 +443,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 
 	initialization, response, err := sess.GetBareMetalServerInitialization(optionsInitialization)
 	if err != nil || initialization == nil {
-		return diag.FromErr(fmt.Errorf("[Error] Error getting Bare Metal Server (%s) initialization : %s\n%s", *bms.ID, err, response))
+		return flex.NewAPIError(err, response).Summary("[Error] Error getting Bare Metal Server (%s) initialization", *bms.ID).Operation("GetBareMetalServerInitialization").Resource("data.ibm_is_bare_metal_server", d.Id()).Diagnostics()
 	}
 
 	d.Set(isBareMetalServerImage, initialization.Image.ID)
@@ -535,7 +535,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		}
 		bmsnic, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the bare metal server").Operation("GetBareMetalServerNetworkInterface").Resource("data.ibm_is_bare_metal_server", d.Id()).Diagnostics()
 		}
 
 		switch reflect.TypeOf(bmsnic).String() {
@@ -608,7 +608,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 			}
 			bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the bare metal server").Operation("GetBareMetalServerNetworkInterface").Resource("data.ibm_is_bare_metal_server", d.Id()).Diagnostics()
 			}
 
 			switch reflect.TypeOf(bmsnicintf).String() {
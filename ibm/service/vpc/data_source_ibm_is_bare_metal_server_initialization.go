@@ -128,7 +128,7 @@ func dataSourceIBMISBareMetalServerInitializationRead(context context.Context, d
 
 	initialization, response, err := sess.GetBareMetalServerInitializationWithContext(context, options)
 	if err != nil || initialization == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) initialization : %s\n%s", bareMetalServerID, err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) initialization", bareMetalServerID).Operation("GetBareMetalServerInitializationWithContext").Resource("data.ibm_is_bare_metal_server_initialization", d.Id()).Diagnostics()
 	}
 	d.SetId(bareMetalServerID)
 	if initialization.Image != nil {
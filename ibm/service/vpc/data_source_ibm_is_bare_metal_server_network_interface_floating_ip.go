@@ -5,9 +5,9 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -92,7 +92,7 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPRead(context contex
 
 	ip, response, err := sess.GetBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching floating IP for bare metal server %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error fetching floating IP for bare metal server").Operation("GetBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("data.ibm_is_bare_metal_server_network_interface_floating_ip", d.Id()).Diagnostics()
 	}
 	d.Set(floatingIPName, *ip.Name)
 	d.Set(floatingIPAddress, *ip.Address)
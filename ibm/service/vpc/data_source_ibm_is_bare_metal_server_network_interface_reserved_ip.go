@@ -5,9 +5,9 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -117,7 +117,7 @@ func dataSourceIBMISBareMetalServerNICReservedIPRead(context context.Context, d
 	reserveIP, response, err := sess.GetBareMetalServerNetworkInterfaceIPWithContext(context, options)
 
 	if err != nil || response == nil || reserveIP == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching the reserved IP %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error fetching the reserved IP").Operation("GetBareMetalServerNetworkInterfaceIPWithContext").Resource("data.ibm_is_bare_metal_server_network_interface_reserved_ip", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*reserveIP.ID)
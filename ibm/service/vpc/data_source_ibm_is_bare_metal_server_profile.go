@@ -5,7 +5,6 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -305,7 +304,7 @@ func dataSourceIBMISBMSProfileRead(context context.Context, d *schema.ResourceDa
 	}
 	bmsProfile, response, err := sess.GetBareMetalServerProfileWithContext(context, options)
 	if err != nil || bmsProfile == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Bare Metal Server Profile (%s): %s\n%s", name, err, response))
+		return flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server Profile (%s)", name).Operation("GetBareMetalServerProfileWithContext").Resource("data.ibm_is_bare_metal_server_profile", d.Id()).Diagnostics()
 	}
 	d.SetId(*bmsProfile.Name)
 	d.Set(isBareMetalServerProfileName, *bmsProfile.Name)
@@ -5,7 +5,6 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -302,7 +301,7 @@ func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *sche
 		}
 		availableProfiles, response, err := sess.ListBareMetalServerProfilesWithContext(context, listBMSProfilesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Server Profiles %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error fetching Bare Metal Server Profiles").Operation("ListBareMetalServerProfilesWithContext").Resource("data.ibm_is_bare_metal_server_profiles", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(availableProfiles.Next)
 		allrecs = append(allrecs, availableProfiles.Profiles...)
@@ -5,7 +5,6 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -393,7 +392,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		}
 		availableServers, response, err := sess.ListBareMetalServersWithContext(context, listBareMetalServersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error fetching Bare Metal Servers").Operation("ListBareMetalServersWithContext").Resource("data.ibm_is_bare_metal_servers", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(availableServers.Next)
 		allrecs = append(allrecs, availableServers.BareMetalServers...)
@@ -482,7 +481,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 			}
 			bmsnic, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the bare metal server").Operation("GetBareMetalServerNetworkInterface").Resource("data.ibm_is_bare_metal_servers", d.Id()).Diagnostics()
 			}
 
 			switch reflect.TypeOf(bmsnic).String() {
@@ -553,7 +552,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 				}
 				bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+					return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the bare metal server").Operation("GetBareMetalServerNetworkInterface").Resource("data.ibm_is_bare_metal_servers", d.Id()).Diagnostics()
 				}
 
 				switch reflect.TypeOf(bmsnicintf).String() {
@@ -196,7 +196,7 @@ func floatingIPGet(d *schema.ResourceData, meta interface{}, name string) error
 		}
 		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching floating IPs %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching floating IPs").Operation("ListFloatingIps").Resource("data.ibm_is_floating_ip", d.Id())
 		}
 		start = flex.GetNext(floatingIPs.Next)
 		allFloatingIPs = append(allFloatingIPs, floatingIPs.FloatingIps...)
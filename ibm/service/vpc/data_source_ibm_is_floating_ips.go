@@ -220,7 +220,7 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Error Fetching floating IPs  %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching floating IPs %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error Fetching floating IPs").Operation("ListFloatingIps").Resource("data.ibm_is_floating_ips", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(floatingIPs.Next)
 		allFloatingIPs = append(allFloatingIPs, floatingIPs.FloatingIps...)
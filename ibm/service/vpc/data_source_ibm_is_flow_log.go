@@ -214,7 +214,7 @@ func dataSourceIBMIsFlowLogRead(context context.Context, d *schema.ResourceData,
 
 		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("Error Fetching Flow Logs for VPC %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error Fetching Flow Logs for VPC").Operation("ListFlowLogCollectors").Resource("data.ibm_is_flow_log", d.Id()).Diagnostics()
 		}
 
 		allrecs := flowlogCollectors.FlowLogCollectors
@@ -233,7 +233,7 @@ func dataSourceIBMIsFlowLogRead(context context.Context, d *schema.ResourceData,
 		flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(context, getFlowLogCollectorOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetFlowLogCollectorWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetFlowLogCollectorWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetFlowLogCollectorWithContext").Resource("data.ibm_is_flow_log", d.Id()).Diagnostics()
 		}
 		flowLogCollector = flowlogCollector
 	}
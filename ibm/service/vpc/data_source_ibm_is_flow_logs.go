@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -105,7 +104,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		}
 		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Flow Logs for VPC %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Flow Logs for VPC").Operation("ListFlowLogCollectors").Resource("data.ibm_is_flow_logs", d.Id())
 		}
 		start = flex.GetNext(flowlogCollectors.Next)
 		allrecs = append(allrecs, flowlogCollectors.FlowLogCollectors...)
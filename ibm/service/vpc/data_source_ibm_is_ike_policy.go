@@ -168,7 +168,7 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 			}
 			ikePolicies, response, err := vpcClient.ListIkePolicies(listIkePoliciesyOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("Error Fetching IKE Policies %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error Fetching IKE Policies").Operation("ListIkePolicies").Resource("data.ibm_is_ike_policy", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(ikePolicies.Next)
 			allrecs = append(allrecs, ikePolicies.IkePolicies...)
@@ -197,7 +197,7 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 		ikePolicy1, response, err := vpcClient.GetIkePolicyWithContext(context, getIkePolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetIkePolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetIkePolicyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetIkePolicyWithContext").Resource("data.ibm_is_ike_policy", d.Id()).Diagnostics()
 		}
 		ikePolicy = ikePolicy1
 	}
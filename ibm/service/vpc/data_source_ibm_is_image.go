@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -170,7 +171,7 @@ func imageGetByName(d *schema.ResourceData, meta interface{}, name, visibility s
 	}
 	availableImages, response, err := sess.ListImages(listImagesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching Images").Operation("ListImages").Resource("data.ibm_is_image", d.Id())
 	}
 	allrecs := availableImages.Images
 
@@ -224,7 +225,7 @@ func imageGetById(d *schema.ResourceData, meta interface{}, identifier string) e
 		if response.StatusCode == 404 {
 			return fmt.Errorf("[ERROR] No image found with id  %s", identifier)
 		}
-		return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching Images").Operation("GetImage").Resource("data.ibm_is_image", d.Id())
 	}
 
 	d.SetId(*image.ID)
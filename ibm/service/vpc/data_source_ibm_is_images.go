@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -234,7 +233,7 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		}
 		availableImages, response, err := sess.ListImages(listImagesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Images").Operation("ListImages").Resource("data.ibm_is_images", d.Id())
 		}
 		start = flex.GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
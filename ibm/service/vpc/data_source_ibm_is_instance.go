@@ -679,7 +679,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 
 	instances, response, err := sess.ListInstances(listInstancesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching Instances").Operation("ListInstances").Resource("data.ibm_is_instance", d.Id())
 	}
 	allrecs := instances.Instances
 
@@ -787,7 +787,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("data.ibm_is_instance", d.Id())
 		}
 		if insnic.PortSpeed != nil {
 			currentPrimNic[isInstanceNicPortSpeed] = *insnic.PortSpeed
@@ -841,7 +841,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 				}
 				insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("data.ibm_is_instance", d.Id())
 				}
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -922,7 +922,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 	}
 	initParms, response, err := sess.GetInstanceInitialization(getInstanceInitializationOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting instance Initialization: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting instance Initialization").Operation("GetInstanceInitialization").Resource("data.ibm_is_instance", d.Id())
 	}
 	if initParms.Keys != nil {
 		initKeyList := make([]map[string]interface{}, 0)
@@ -106,7 +106,7 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 		}
 		instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching InstanceGroups").Operation("ListInstanceGroups").Resource("data.ibm_is_instance_group", d.Id())
 		}
 		start = flex.GetNext(instanceGroupsCollection.Next)
 		allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
@@ -117,7 +117,7 @@ func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Managers").Operation("ListInstanceGroupManagers").Resource("data.ibm_is_instance_group_manager", d.Id())
 		}
 		start = flex.GetNext(instanceGroupManagerCollections.Next)
 		allrecs = append(allrecs, instanceGroupManagerCollections.Managers...)
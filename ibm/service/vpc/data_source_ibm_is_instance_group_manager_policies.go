@@ -101,7 +101,7 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 		}
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Policies").Operation("ListInstanceGroupManagerPolicies").Resource("data.ibm_is_instance_group_manager_policies", d.Id())
 		}
 		start = flex.GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
@@ -85,7 +85,7 @@ func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta
 		}
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Policies").Operation("ListInstanceGroupManagerPolicies").Resource("data.ibm_is_instance_group_manager_policy", d.Id())
 		}
 		start = flex.GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
@@ -134,7 +134,7 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Managers").Operation("ListInstanceGroupManagers").Resource("data.ibm_is_instance_group_managers", d.Id())
 		}
 
 		start = flex.GetNext(instanceGroupManagerCollections.Next)
@@ -336,7 +336,7 @@ func DataSourceIBMIsInstanceGroupsRead(context context.Context, d *schema.Resour
 		instanceGroupCollection, response, err := vpcClient.ListInstanceGroupsWithContext(context, listInstanceGroupsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListInstanceGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListInstanceGroupsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListInstanceGroupsWithContext").Resource("data.ibm_is_instance_groups", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(instanceGroupCollection.Next)
 		allrecs = append(allrecs, instanceGroupCollection.InstanceGroups...)
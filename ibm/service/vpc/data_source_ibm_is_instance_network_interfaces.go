@@ -282,7 +282,7 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 
 		instances, response, err := vpcClient.ListInstances(listInstancesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error Fetching Instances").Operation("ListInstances").Resource("data.ibm_is_instance_network_interfaces", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -302,7 +302,7 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 
 			if err != nil {
 				log.Printf("[DEBUG] ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListInstanceNetworkInterfacesWithContext").Resource("data.ibm_is_instance_network_interfaces", d.Id()).Diagnostics()
 			}
 
 			d.SetId(ins_id)
@@ -9,6 +9,8 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -574,7 +576,7 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	listInstanceProfilesOptions := &vpcv1.ListInstanceProfilesOptions{}
 	availableProfiles, response, err := sess.ListInstanceProfiles(listInstanceProfilesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching Instance Profiles").Operation("ListInstanceProfiles").Resource("data.ibm_is_instance_profiles", d.Id())
 	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
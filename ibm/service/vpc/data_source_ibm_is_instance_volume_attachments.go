@@ -4,11 +4,12 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISInstanceVolumeAttachments() *schema.Resource {
@@ -130,7 +131,7 @@ func instanceGetVolumeAttachments(d *schema.ResourceData, meta interface{}, inst
 	}
 	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching Instance volume attachments").Operation("ListInstanceVolumeAttachments").Resource("data.ibm_is_instance_volume_attachments", d.Id())
 	}
 	allrecs = append(allrecs, volumeAtts.VolumeAttachments...)
 	volAttList := make([]map[string]interface{}, 0)
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -675,7 +674,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Fetching InstanceGroups").Operation("ListInstanceGroups").Resource("data.ibm_is_instances", d.Id())
 			}
 			start = flex.GetNext(instanceGroupsCollection.Next)
 			allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
@@ -735,7 +734,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Instances").Operation("ListInstances").Resource("data.ibm_is_instances", d.Id())
 		}
 		start = flex.GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -756,7 +755,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Membership Collection").Operation("ListInstanceGroupMemberships").Resource("data.ibm_is_instances", d.Id())
 			}
 
 			start = flex.GetNext(instanceGroupMembershipCollection.Next)
@@ -909,7 +908,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("data.ibm_is_instances", d.Id())
 			}
 			currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 			if len(insnic.SecurityGroups) != 0 {
@@ -960,7 +959,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 					}
 					insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+						return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("data.ibm_is_instances", d.Id())
 					}
 					currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 					if len(insnic.SecurityGroups) != 0 {
@@ -173,7 +173,7 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 		iPsecPolicyCollection, response, err := vpcClient.ListIpsecPoliciesWithContext(context, listIpsecPoliciesOptions)
 		if err != nil || iPsecPolicyCollection == nil {
 			log.Printf("[DEBUG] ListIpsecPoliciesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListIpsecPoliciesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListIpsecPoliciesWithContext").Resource("data.ibm_is_ipsec_policies", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(iPsecPolicyCollection.Next)
 		allrecs = append(allrecs, iPsecPolicyCollection.IpsecPolicies...)
@@ -169,7 +169,7 @@ func dataSourceIBMIsIpsecPolicyRead(context context.Context, d *schema.ResourceD
 			}
 			ipSecPolicy, response, err := vpcClient.ListIpsecPolicies(listIPSecPoliciesyOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("Error Fetching IPSec Policies %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error Fetching IPSec Policies").Operation("ListIpsecPolicies").Resource("data.ibm_is_ipsec_policy", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(ipSecPolicy.Next)
 			allrecs = append(allrecs, ipSecPolicy.IpsecPolicies...)
@@ -199,7 +199,7 @@ func dataSourceIBMIsIpsecPolicyRead(context context.Context, d *schema.ResourceD
 		ipsecPolicy1, response, err := vpcClient.GetIpsecPolicyWithContext(context, getIPSecPolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetIpsecPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetIpsecPolicyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetIpsecPolicyWithContext").Resource("data.ibm_is_ipsec_policy", d.Id()).Diagnostics()
 		}
 		IPSecPolicy = ipsecPolicy1
 	}
@@ -316,7 +316,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Load Balancers").Operation("ListLoadBalancers").Resource("data.ibm_is_lb", d.Id())
 		}
 		start = flex.GetNext(lbs.Next)
 		allrecs = append(allrecs, lbs.LoadBalancers...)
@@ -229,7 +229,7 @@ func dataSourceIBMIsLbListenerRead(context context.Context, d *schema.ResourceDa
 	loadBalancerListener, response, err := vpcClient.GetLoadBalancerListenerWithContext(context, getLoadBalancerListenerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerListenerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerListenerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLoadBalancerListenerWithContext").Resource("data.ibm_is_lb_listener", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*loadBalancerListener.ID)
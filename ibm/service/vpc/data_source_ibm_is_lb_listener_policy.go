@@ -202,7 +202,7 @@ func dataSourceIBMIsLbListenerPolicyRead(context context.Context, d *schema.Reso
 	loadBalancerListenerPolicy, response, err := vpcClient.GetLoadBalancerListenerPolicyWithContext(context, getLoadBalancerListenerPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerListenerPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerListenerPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLoadBalancerListenerPolicyWithContext").Resource("data.ibm_is_lb_listener_policy", d.Id()).Diagnostics()
 	}
 	d.SetId(*loadBalancerListenerPolicy.ID)
 	if err = d.Set("action", loadBalancerListenerPolicy.Action); err != nil {
@@ -95,7 +95,7 @@ func dataSourceIBMIsLbListenerPolicyRuleRead(context context.Context, d *schema.
 	loadBalancerListenerPolicyRule, response, err := vpcClient.GetLoadBalancerListenerPolicyRuleWithContext(context, getLoadBalancerListenerPolicyRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerListenerPolicyRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerListenerPolicyRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLoadBalancerListenerPolicyRuleWithContext").Resource("data.ibm_is_lb_listener_policy_rule", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*loadBalancerListenerPolicyRule.ID)
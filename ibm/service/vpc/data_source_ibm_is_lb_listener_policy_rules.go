@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBListenerPolicyRules() *schema.Resource {
@@ -102,7 +104,7 @@ func dataSourceIBMIsLbListenerPolicyRulesRead(context context.Context, d *schema
 	loadBalancerListenerPolicyRuleCollection, response, err := vpcClient.ListLoadBalancerListenerPolicyRulesWithContext(context, listLoadBalancerListenerPolicyRulesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerListenerPolicyRulesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenerPolicyRulesWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListLoadBalancerListenerPolicyRulesWithContext").Resource("data.ibm_is_lb_listener_policy_rules", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIsLbListenerPolicyRulesID(d))
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBListeners() *schema.Resource {
@@ -238,7 +240,7 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 	loadBalancerListenerCollection, response, err := vpcClient.ListLoadBalancerListenersWithContext(context, listLoadBalancerListenersOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerListenersWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenersWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListLoadBalancerListenersWithContext").Resource("data.ibm_is_lb_listeners", d.Id()).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIsLbListenersID(d))
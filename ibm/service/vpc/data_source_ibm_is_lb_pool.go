@@ -223,7 +223,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 		loadBalancerPoolInfo, response, err := sess.GetLoadBalancerPoolWithContext(context, getLoadBalancerPoolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetLoadBalancerPoolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetLoadBalancerPoolWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetLoadBalancerPoolWithContext").Resource("data.ibm_is_lb_pool", d.Id()).Diagnostics()
 		}
 		loadBalancerPool = loadBalancerPoolInfo
 
@@ -235,7 +235,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 		loadBalancerPoolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListLoadBalancerPoolsWithContext").Resource("data.ibm_is_lb_pool", d.Id()).Diagnostics()
 		}
 
 		name := v.(string)
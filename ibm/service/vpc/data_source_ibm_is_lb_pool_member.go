@@ -132,7 +132,7 @@ func dataSourceIBMIsLbPoolMemberRead(context context.Context, d *schema.Resource
 	loadBalancerPoolMember, response, err := sess.GetLoadBalancerPoolMemberWithContext(context, getLoadBalancerPoolMemberOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerPoolMemberWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerPoolMemberWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetLoadBalancerPoolMemberWithContext").Resource("data.ibm_is_lb_pool_member", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*loadBalancerPoolMember.ID)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBPools() *schema.Resource {
@@ -225,7 +227,7 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 	loadBalancerPoolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("ListLoadBalancerPoolsWithContext").Resource("data.ibm_is_lb_pools", d.Id()).Diagnostics()
 	}
 	if err = d.Set("lb", d.Get("lb").(string)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting lb: %s", err))
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 	"time"
 
@@ -86,7 +85,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		}
 		profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Load Balancer Profiles for VPC").Operation("ListLoadBalancerProfiles").Resource("data.ibm_is_lb_profiles", d.Id())
 		}
 		start = flex.GetNext(profileCollectors.Next)
 		allrecs = append(allrecs, profileCollectors.Profiles...)
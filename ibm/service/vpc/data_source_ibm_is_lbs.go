@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -279,7 +278,7 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		}
 		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching Load Balancers").Operation("ListLoadBalancers").Resource("data.ibm_is_lbs", d.Id())
 		}
 		start = flex.GetNext(lbs.Next)
 		allrecs = append(allrecs, lbs.LoadBalancers...)
@@ -371,7 +371,7 @@ func dataSourceIBMIsNetworkACLRead(context context.Context, d *schema.ResourceDa
 			networkACLCollection, response, err := vpcClient.ListNetworkAclsWithContext(context, listNetworkAclsOptions)
 			if err != nil || networkACLCollection == nil {
 				log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ListNetworkAclsWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListNetworkAclsWithContext").Resource("data.ibm_is_network_acl", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(networkACLCollection.Next)
 			allrecs = append(allrecs, networkACLCollection.NetworkAcls...)
@@ -401,7 +401,7 @@ func dataSourceIBMIsNetworkACLRead(context context.Context, d *schema.ResourceDa
 		networkACLInst, response, err := vpcClient.GetNetworkACLWithContext(context, getNetworkACLOptions)
 		if err != nil || networkACLInst == nil {
 			log.Printf("[DEBUG] GetNetworkACLWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetNetworkACLWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetNetworkACLWithContext").Resource("data.ibm_is_network_acl", d.Id()).Diagnostics()
 		}
 		networkACL = networkACLInst
 	}
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 	"time"
 
@@ -195,7 +194,7 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 
 		ruleList, response, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching network acl ruless %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching network acl ruless").Operation("ListNetworkACLRules").Resource("data.ibm_is_network_acl_rules", d.Id())
 		}
 		start = flex.GetNext(ruleList.Next)
 
@@ -370,7 +370,7 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		networkACLCollection, response, err := vpcClient.ListNetworkAclsWithContext(context, listNetworkAclsOptions)
 		if err != nil || networkACLCollection == nil {
 			log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListNetworkAclsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListNetworkAclsWithContext").Resource("data.ibm_is_network_acls", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(networkACLCollection.Next)
 		allrecs = append(allrecs, networkACLCollection.NetworkAcls...)
@@ -123,7 +123,7 @@ func dataSourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{})
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching public gateways").Operation("ListPublicGateways").Resource("data.ibm_is_public_gateway", d.Id())
 		}
 		start = flex.GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -149,7 +148,7 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching public gateways").Operation("ListPublicGateways").Resource("data.ibm_is_public_gateways", d.Id())
 		}
 		start = flex.GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
@@ -177,7 +177,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 		}
 		sgs, response, err := sess.ListSecurityGroups(listSgOptions)
 		if err != nil || sgs == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Groups %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Security Groups").Operation("ListSecurityGroups").Resource("data.ibm_is_security_group", d.Id())
 		}
 		if *sgs.TotalCount == int64(0) {
 			break
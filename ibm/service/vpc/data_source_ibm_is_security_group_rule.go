@@ -142,7 +142,7 @@ func dataSourceIBMIsSecurityGroupRuleRead(context context.Context, d *schema.Res
 	securityGroupRuleIntf, response, err := vpcClient.GetSecurityGroupRuleWithContext(context, getSecurityGroupRuleOptions)
 	if err != nil || securityGroupRuleIntf == nil {
 		log.Printf("[DEBUG] GetSecurityGroupRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecurityGroupRuleWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetSecurityGroupRuleWithContext").Resource("data.ibm_is_security_group_rule", d.Id()).Diagnostics()
 	}
 
 	switch reflect.TypeOf(securityGroupRuleIntf).String() {
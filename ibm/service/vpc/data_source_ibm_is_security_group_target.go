@@ -78,7 +78,7 @@ func dataSourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interfa
 		}
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Managers").Operation("ListSecurityGroupTargets").Resource("data.ibm_is_security_group_target", d.Id())
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -336,7 +336,7 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		securityGroupCollection, response, err := vpcClient.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListSecurityGroupsWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListSecurityGroupsWithContext").Resource("data.ibm_is_security_groups", d.Id()).Diagnostics()
 		}
 
 		start = flex.GetNext(securityGroupCollection.Next)
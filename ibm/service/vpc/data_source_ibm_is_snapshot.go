@@ -215,7 +215,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 			}
 			snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching snapshots %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Fetching snapshots").Operation("ListSnapshots").Resource("data.ibm_is_snapshot", d.Id())
 			}
 			start = flex.GetNext(snapshots.Next)
 			allrecs = append(allrecs, snapshots.Snapshots...)
@@ -284,7 +284,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshot %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error fetching snapshot").Operation("GetSnapshot").Resource("data.ibm_is_snapshot", d.Id())
 		}
 		if (response != nil && response.StatusCode == 404) || snapshot == nil {
 			return fmt.Errorf("[ERROR] No snapshot found with id %s", id)
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -263,7 +262,7 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 
 		snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error fetching snapshots").Operation("ListSnapshots").Resource("data.ibm_is_snapshots", d.Id())
 		}
 		start = flex.GetNext(snapshots.Next)
 		allrecs = append(allrecs, snapshots.Snapshots...)
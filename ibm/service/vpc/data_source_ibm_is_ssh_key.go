@@ -111,7 +111,7 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 
 		keys, response, err := sess.ListKeys(listKeysOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching Keys %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error fetching Keys").Operation("ListKeys").Resource("data.ibm_is_ssh_key", d.Id())
 		}
 		start = flex.GetNext(keys.Next)
 		allrecs = append(allrecs, keys.Keys...)
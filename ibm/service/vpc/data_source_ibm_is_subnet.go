@@ -221,7 +221,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnetinfo, response, err := sess.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Subnet (%s)", id).Operation("GetSubnet").Resource("data.ibm_is_subnet", d.Id())
 		}
 		subnet = subnetinfo
 	} else if v, ok := d.GetOk(isSubnetName); ok {
@@ -236,7 +236,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 			}
 			subnetsCollection, response, err := sess.ListSubnets(getSubnetsListOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching subnets List %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Fetching subnets List").Operation("ListSubnets").Resource("data.ibm_is_subnet", d.Id())
 			}
 			start = flex.GetNext(subnetsCollection.Next)
 			allrecs = append(allrecs, subnetsCollection.Subnets...)
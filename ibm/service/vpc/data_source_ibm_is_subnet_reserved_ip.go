@@ -4,11 +4,12 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 // Define all the constants that matches with the given terrafrom attribute
@@ -118,7 +119,7 @@ func dataSdataSourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}
 	reserveIP, response, err := sess.GetSubnetReservedIP(options)
 
 	if err != nil || response == nil || reserveIP == nil {
-		return fmt.Errorf("[ERROR] Error fetching the reserved IP %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error fetching the reserved IP").Operation("GetSubnetReservedIP").Resource("data.ibm_is_subnet_reserved_ip", d.Id())
 	}
 
 	d.SetId(*reserveIP.ID)
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 	"time"
 
@@ -131,7 +130,7 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 
 		result, response, err := sess.ListSubnetReservedIps(options)
 		if err != nil || response == nil || result == nil {
-			return fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error fetching reserved ips").Operation("ListSubnetReservedIps").Resource("data.ibm_is_subnet_reserved_ips", d.Id())
 		}
 		start = flex.GetNext(result.Next)
 		allrecs = append(allrecs, result.ReservedIps...)
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"strconv"
 	"time"
 
@@ -194,7 +193,7 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnets, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching subnets").Operation("ListSubnets").Resource("data.ibm_is_subnets", d.Id())
 		}
 		start = flex.GetNext(subnets.Next)
 		allrecs = append(allrecs, subnets.Subnets...)
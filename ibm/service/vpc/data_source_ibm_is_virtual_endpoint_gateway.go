@@ -8,6 +8,8 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISEndpointGateway() *schema.Resource {
@@ -129,7 +131,7 @@ func dataSourceIBMISEndpointGatewayRead(
 
 	results, response, err := sess.ListEndpointGateways(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error fetching endpoint gateways").Operation("ListEndpointGateways").Resource("data.ibm_is_virtual_endpoint_gateway", d.Id())
 	}
 	allrecs := results.EndpointGateways
 
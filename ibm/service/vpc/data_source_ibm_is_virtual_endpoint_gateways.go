@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -149,7 +148,7 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		}
 		result, response, err := sess.ListEndpointGateways(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error fetching endpoint gateways").Operation("ListEndpointGateways").Resource("data.ibm_is_virtual_endpoint_gateways", d.Id())
 		}
 		start = flex.GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
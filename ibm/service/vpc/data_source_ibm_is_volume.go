@@ -210,7 +210,7 @@ func volumeGet(d *schema.ResourceData, meta interface{}, name string) error {
 	listVolumesOptions.Name = &name
 	vols, response, err := sess.ListVolumes(listVolumesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching volumes %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Fetching volumes").Operation("ListVolumes").Resource("data.ibm_is_volume", d.Id())
 	}
 	allrecs := vols.Volumes
 
@@ -529,7 +529,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListVolumesWithContext").Resource("data.ibm_is_volumes", d.Id()).Diagnostics()
 		}
 
 		start = flex.GetNext(volumeCollection.Next)
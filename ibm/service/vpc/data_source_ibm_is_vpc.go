@@ -322,7 +322,7 @@ func vpcGetByNameOrId(d *schema.ResourceData, meta interface{}, name, id string)
 		}
 		vpcGet, response, err := sess.GetVPC(getVpcsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching vpc %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching vpc").Operation("GetVPC").Resource("data.ibm_is_vpc", d.Id())
 		}
 		flag = true
 		setVpcDetails(d, vpcGet, meta, sess)
@@ -336,7 +336,7 @@ func vpcGetByNameOrId(d *schema.ResourceData, meta interface{}, name, id string)
 			}
 			vpcs, response, err := sess.ListVpcs(listVpcsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching vpcs %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Fetching vpcs").Operation("ListVpcs").Resource("data.ibm_is_vpc", d.Id())
 			}
 			start = flex.GetNext(vpcs.Next)
 			allrecs = append(allrecs, vpcs.Vpcs...)
@@ -430,7 +430,7 @@ func setVpcDetails(d *schema.ResourceData, vpc *vpcv1.VPC, meta interface{}, ses
 			}
 			s, response, err := sess.ListSubnets(options)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error fetching subnets").Operation("ListSubnets").Resource("data.ibm_is_vpc", d.Id())
 			}
 			startSub = flex.GetNext(s.Next)
 			allrecsSub = append(allrecsSub, s.Subnets...)
@@ -471,7 +471,7 @@ func setVpcDetails(d *schema.ResourceData, vpc *vpcv1.VPC, meta interface{}, ses
 			}
 			sgs, response, err := sess.ListSecurityGroups(listSgOptions)
 			if err != nil || sgs == nil {
-				return fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error fetching Security Groups").Operation("ListSecurityGroups").Resource("data.ibm_is_vpc", d.Id())
 			}
 			if *sgs.TotalCount == int64(0) {
 				break
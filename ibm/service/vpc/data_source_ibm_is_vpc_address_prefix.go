@@ -119,7 +119,7 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 			}
 			vpcs, response, err := vpcClient.ListVpcs(listVpcsOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("Error Fetching vpcs %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error Fetching vpcs").Operation("ListVpcs").Resource("data.ibm_is_vpc_address_prefix", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(vpcs.Next)
 			allrecs = append(allrecs, vpcs.Vpcs...)
@@ -149,7 +149,7 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 		addressPrefix1, response, err := vpcClient.GetVPCAddressPrefixWithContext(context, getVPCAddressPrefixOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPCAddressPrefixWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPCAddressPrefixWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPCAddressPrefixWithContext").Resource("data.ibm_is_vpc_address_prefix", d.Id()).Diagnostics()
 		}
 		addressPrefix = addressPrefix1
 
@@ -166,7 +166,7 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 			addressPrefixCollection, response, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ListVpcAddressPrefixesWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListVPCAddressPrefixesWithContext").Resource("data.ibm_is_vpc_address_prefix", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(addressPrefixCollection.Next)
 			allrecs = append(allrecs, addressPrefixCollection.AddressPrefixes...)
@@ -208,7 +208,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRead(context context.Context, d *schema.Re
 		rt, response, err := vpcClient.GetVPCRoutingTableWithContext(context, getVPCRoutingTableOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPCRoutingTableWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPCRoutingTableWithContext").Resource("data.ibm_is_vpc_routing_table", d.Id()).Diagnostics()
 		}
 		routingTable = rt
 	} else {
@@ -224,7 +224,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRead(context context.Context, d *schema.Re
 			result, detail, err := vpcClient.ListVPCRoutingTables(listOptions)
 			if err != nil {
 				log.Printf("[ERROR] Error reading list of VPC Routing Tables:%s\n%s", err, detail)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPCRoutingTables failed %s\n%s", err, detail))
+				return flex.NewAPIError(err, detail).Operation("ListVPCRoutingTables").Resource("data.ibm_is_vpc_routing_table", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(result.Next)
 			allrecs = append(allrecs, result.RoutingTables...)
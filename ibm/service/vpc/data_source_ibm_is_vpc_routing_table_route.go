@@ -222,7 +222,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRouteRead(context context.Context, d *sche
 		r, response, err := vpcClient.GetVPCRoutingTableRouteWithContext(context, getVPCRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPCRoutingTableRouteWithContext").Resource("data.ibm_is_vpc_routing_table_route", d.Id()).Diagnostics()
 		}
 		route = r
 	} else {
@@ -240,7 +240,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRouteRead(context context.Context, d *sche
 			result, detail, err := vpcClient.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
 			if err != nil {
 				log.Printf("Error reading list of VPC Routing Table Routes:%s\n%s", err, detail)
-				return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, detail))
+				return flex.NewAPIError(err, detail).Operation("ListVPCRoutingTableRoutes").Resource("data.ibm_is_vpc_routing_table_route", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(result.Next)
 			allrecs = append(allrecs, result.Routes...)
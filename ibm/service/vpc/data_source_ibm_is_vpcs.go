@@ -7,7 +7,6 @@ import (
 	//"encoding/json"
 
 	"context"
-	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -394,7 +393,7 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 			}
 			s, response, err := sess.ListSubnetsWithContext(context, options)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error fetching subnets").Operation("ListSubnetsWithContext").Resource("data.ibm_is_vpcs", d.Id()).Diagnostics()
 			}
 			startSub = flex.GetNext(s.Next)
 			allrecsSub = append(allrecsSub, s.Subnets...)
@@ -435,7 +434,7 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 			}
 			sgs, response, err := sess.ListSecurityGroupsWithContext(context, listSgOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error fetching Security Groups").Operation("ListSecurityGroupsWithContext").Resource("data.ibm_is_vpcs", d.Id()).Diagnostics()
 			}
 			if *sgs.TotalCount == int64(0) {
 				break
@@ -270,7 +270,7 @@ func dataSourceIBMIsVPNGatewayRead(context context.Context, d *schema.ResourceDa
 		vpnGatewayIntf, response, err := vpcClient.GetVPNGatewayWithContext(context, getVPNGatewayOptions)
 		if err != nil || vpnGatewayIntf.(*vpcv1.VPNGateway) == nil {
 			log.Printf("[DEBUG] GetVPNGatewayWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPNGatewayWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPNGatewayWithContext").Resource("data.ibm_is_vpn_gateway", d.Id()).Diagnostics()
 		}
 		vpnGateway = vpnGatewayIntf.(*vpcv1.VPNGateway)
 	} else {
@@ -284,7 +284,7 @@ func dataSourceIBMIsVPNGatewayRead(context context.Context, d *schema.ResourceDa
 			}
 			availableVPNGateways, detail, err := vpcClient.ListVPNGatewaysWithContext(context, listvpnGWOptions)
 			if err != nil || availableVPNGateways == nil {
-				return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail))
+				return flex.NewAPIError(err, detail).Summary("Error reading list of VPN Gateways").Operation("ListVPNGatewaysWithContext").Resource("data.ibm_is_vpn_gateway", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(availableVPNGateways.Next)
 			allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -272,7 +272,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 			}
 			availableVPNGateways, detail, err := vpcClient.ListVPNGatewaysWithContext(context, listvpnGWOptions)
 			if err != nil || availableVPNGateways == nil {
-				return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail))
+				return flex.NewAPIError(err, detail).Summary("Error reading list of VPN Gateways").Operation("ListVPNGatewaysWithContext").Resource("data.ibm_is_vpn_gateway_connection", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(availableVPNGateways.Next)
 			allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -300,7 +300,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 
 		availableVPNGatewayConnections, detail, err := vpcClient.ListVPNGatewayConnections(listvpnGWConnectionOptions)
 		if err != nil || availableVPNGatewayConnections == nil {
-			return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateway Connections:%s\n%s", err, detail))
+			return flex.NewAPIError(err, detail).Summary("Error reading list of VPN Gateway Connections").Operation("ListVPNGatewayConnections").Resource("data.ibm_is_vpn_gateway_connection", d.Id()).Diagnostics()
 		}
 
 		vpn_gateway_conn_found := false
@@ -324,7 +324,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 		vpnGatewayConnectionIntf, response, err := vpcClient.GetVPNGatewayConnectionWithContext(context, getVPNGatewayConnectionOptions)
 		if err != nil || vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection) == nil {
 			log.Printf("[DEBUG] GetVPNGatewayConnectionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPNGatewayConnectionWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPNGatewayConnectionWithContext").Resource("data.ibm_is_vpn_gateway_connection", d.Id()).Diagnostics()
 		}
 		vpnGatewayConnection = vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	}
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -171,7 +170,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		availableVPNGateways, detail, err := sess.ListVPNGateways(listvpnGWOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error reading list of VPN Gateways:%s\n%s", err, detail)
+			return flex.NewAPIError(err, detail).Summary("Error reading list of VPN Gateways").Operation("ListVPNGateways").Resource("data.ibm_is_vpn_gateways", d.Id())
 		}
 		start = flex.GetNext(availableVPNGateways.Next)
 		allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
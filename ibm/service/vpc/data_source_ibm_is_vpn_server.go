@@ -383,7 +383,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 		vpnServerInfo, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPNServerWithContext").Resource("data.ibm_is_vpn_server", d.Id()).Diagnostics()
 		}
 		vpnServer = vpnServerInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -400,7 +400,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 			vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListVPNServersWithContext").Resource("data.ibm_is_vpn_server", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(vpnServerCollection.Next)
 			allrecs = append(allrecs, vpnServerCollection.VPNServers...)
@@ -116,7 +116,7 @@ func dataSourceIBMIsVPNServerClientRead(context context.Context, d *schema.Resou
 	vpnServerClient, response, err := sess.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVPNServerClientWithContext").Resource("data.ibm_is_vpn_server_client", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*vpnServerClient.ID)
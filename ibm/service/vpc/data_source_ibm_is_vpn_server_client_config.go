@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMIsVPNServerClientConfiguration() *schema.Resource {
@@ -54,7 +56,7 @@ func dataSourceIBMIsVPNServerClientConfigurationRead(context context.Context, d
 	result, response, err := sess.GetVPNServerClientConfigurationWithContext(context, getVPNServerClientConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVPNServerClientConfigurationWithContext").Resource("data.ibm_is_vpn_server_client_config", d.Id()).Diagnostics()
 	}
 
 	d.SetId(d.Get("vpn_server").(string))
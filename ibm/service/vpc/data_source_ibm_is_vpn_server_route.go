@@ -93,7 +93,7 @@ func dataSourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resour
 		vpnServerRouteInfo, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("GetVPNServerRouteWithContext").Resource("data.ibm_is_vpn_server_route", d.Id()).Diagnostics()
 		}
 		vpnServerRoute = vpnServerRouteInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -112,7 +112,7 @@ func dataSourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resour
 			vpnServerRouteCollection, response, err := sess.ListVPNServerRoutesWithContext(context, listVPNServerRoutesOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListVPNServerRoutesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerRoutesWithContext failed %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Operation("ListVPNServerRoutesWithContext").Resource("data.ibm_is_vpn_server_route", d.Id()).Diagnostics()
 			}
 			start = flex.GetNext(vpnServerRouteCollection.Next)
 			allrecs = append(allrecs, vpnServerRouteCollection.Routes...)
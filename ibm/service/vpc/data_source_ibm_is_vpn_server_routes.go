@@ -98,7 +98,7 @@ func dataSourceIBMIsVPNServerRoutesRead(context context.Context, d *schema.Resou
 		vpnServerRouteCollection, response, err := sess.ListVPNServerRoutesWithContext(context, listVPNServerRoutesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVPNServerRoutesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerRoutesWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("ListVPNServerRoutesWithContext").Resource("data.ibm_is_vpn_server_routes", d.Id()).Diagnostics()
 		}
 		start = flex.GetNext(vpnServerRouteCollection.Next)
 		allrecs = append(allrecs, vpnServerRouteCollection.Routes...)
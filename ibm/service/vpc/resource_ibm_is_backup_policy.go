@@ -159,7 +159,7 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 	backupPolicy, response, err := vpcClient.CreateBackupPolicyWithContext(context, createBackupPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateBackupPolicyWithContext").Resource("ibm_is_backup_policy", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*backupPolicy.ID)
@@ -184,7 +184,7 @@ func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceDa
 			return nil
 		}
 		log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetBackupPolicyWithContext").Resource("ibm_is_backup_policy", d.Id()).Diagnostics()
 	}
 
 	if backupPolicy.MatchResourceTypes != nil {
@@ -274,7 +274,7 @@ func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.Resource
 		_, response, err := vpcClient.UpdateBackupPolicyWithContext(context, updateBackupPolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateBackupPolicyWithContext").Resource("ibm_is_backup_policy", d.Id()).Diagnostics()
 		}
 	}
 
@@ -292,7 +292,7 @@ func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.Resource
 	_, response, err := vpcClient.DeleteBackupPolicyWithContext(context, deleteBackupPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteBackupPolicyWithContext").Resource("ibm_is_backup_policy", d.Id()).Diagnostics()
 	}
 	d.SetId("")
 	return nil
@@ -197,7 +197,7 @@ func resourceIBMIsBackupPolicyPlanCreate(context context.Context, d *schema.Reso
 	backupPolicyPlan, response, err := vpcClient.CreateBackupPolicyPlanWithContext(context, createBackupPolicyPlanOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateBackupPolicyPlanWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateBackupPolicyPlanWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateBackupPolicyPlanWithContext").Resource("ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createBackupPolicyPlanOptions.BackupPolicyID, *backupPolicyPlan.ID))
@@ -228,7 +228,7 @@ func resourceIBMIsBackupPolicyPlanRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetBackupPolicyPlanWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyPlanWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetBackupPolicyPlanWithContext").Resource("ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 	}
 
 	if getBackupPolicyPlanOptions.BackupPolicyID != nil {
@@ -402,7 +402,7 @@ func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.Reso
 		_, response, err := vpcClient.UpdateBackupPolicyPlanWithContext(context, updateBackupPolicyPlanOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateBackupPolicyPlanWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateBackupPolicyPlanWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateBackupPolicyPlanWithContext").Resource("ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 		}
 	}
 
@@ -430,7 +430,7 @@ func resourceIBMIsBackupPolicyPlanDelete(context context.Context, d *schema.Reso
 	_, response, err := vpcClient.DeleteBackupPolicyPlanWithContext(context, deleteBackupPolicyPlanOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteBackupPolicyPlanWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteBackupPolicyPlanWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteBackupPolicyPlanWithContext").Resource("ibm_is_backup_policy_plan", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
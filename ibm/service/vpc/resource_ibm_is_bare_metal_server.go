@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -100,7 +99,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 		),
@@ -560,6 +559,13 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the Bare metal server",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 		},
 	}
 }
@@ -1025,7 +1031,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 
 	bms, response, err := sess.CreateBareMetalServerWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server err %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Create bare metal server err").Operation("CreateBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id()).Diagnostics()
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
@@ -1033,8 +1039,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *bms.CRN)
 		if err != nil {
@@ -1070,7 +1075,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	d.SetId(*bms.ID)
 	d.Set(isBareMetalServerBandwidth, bms.Bandwidth)
@@ -1118,7 +1123,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) initialization: %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) initialization", id).Operation("GetBareMetalServerInitializationWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	if bmsinitialization != nil && bmsinitialization.Image.ID != nil {
 		d.Set(isBareMetalServerImage, *bmsinitialization.Image.ID)
@@ -1149,7 +1154,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		bmsnic, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, getnicoptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting primary network interface attached to the bare metal server %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting primary network interface attached to the bare metal server").Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 
 		if bms.PrimaryNetworkInterface.PrimaryIP != nil {
@@ -1180,7 +1185,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server primary network interface(%s): %s\n%s", *bms.PrimaryNetworkInterface.PrimaryIP.ID, *bms.PrimaryNetworkInterface.ID, err, response)
+				return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the bare metal server primary network interface(%s)", *bms.PrimaryNetworkInterface.PrimaryIP.ID, *bms.PrimaryNetworkInterface.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_bare_metal_server", d.Id())
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 		}
@@ -1248,7 +1253,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 				}
 				bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface(%s) attached to the bare metal server(%s) %s\n%s", nicId, id, err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting network interface(%s) attached to the bare metal server(%s)", nicId, id).Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 				}
 				if intfc.PrimaryIP != nil {
 					primaryIpList := make([]map[string]interface{}, 0)
@@ -1271,7 +1276,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 						}
 						bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", ripId, nicId, err, response)
+							return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", ripId, nicId).Operation("GetSubnetReservedIP").Resource("ibm_is_bare_metal_server", d.Id())
 						}
 						if bmsRip.AutoDelete != nil {
 							currentIP[isBareMetalServerNicIpAutoDelete] = *bmsRip.AutoDelete
@@ -1366,6 +1371,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			"[ERROR] Error on get of resource bare metal server (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isBareMetalServerTags, tags)
+	d.Set("tags_all", tags)
 
 	return nil
 }
@@ -1388,7 +1394,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		return err
 	}
 
-	if d.HasChange(isBareMetalServerTags) {
+	if d.HasChange(isBareMetalServerTags) || d.HasChange("tags_all") {
 		bmscrn := d.Get(isBareMetalServerCRN).(string)
 		if bmscrn == "" {
 			options := &vpcv1.GetBareMetalServerOptions{
@@ -1400,7 +1406,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+				return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 			}
 			bmscrn = *bms.CRN
 		}
@@ -1472,7 +1478,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						updatepnicfoptions.BareMetalServerNetworkInterfacePatch = networkInterfacePatch
 						_, response, err := sess.UpdateBareMetalServerNetworkInterface(updatepnicfoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while updating network interface(%s) of bar emetal server(%s) \n%s: %q", networkId, d.Id(), err, response)
+							return flex.NewAPIError(err, response).Summary("Error while updating network interface(%s) of bar emetal server(%s)", networkId, d.Id()).Operation("UpdateBareMetalServerNetworkInterface").Resource("ibm_is_bare_metal_server", d.Id())
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
@@ -1924,7 +1930,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 			_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating bare metal server primary network interface reserved ip(%s): %s\n%s", ripId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error updating bare metal server primary network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_bare_metal_server", d.Id())
 			}
 		}
 		bmsNicUpdateOptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
@@ -1993,7 +1999,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		options.BareMetalServerPatch = bmsPatch
 		_, response, err := sess.UpdateBareMetalServerWithContext(context, options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating Bare Metal Server").Operation("UpdateBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 	}
 
@@ -2041,7 +2047,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	if *bms.Status == "running" {
 
@@ -2052,7 +2058,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 
 		response, err := sess.StopBareMetalServerWithContext(context, options)
 		if err != nil && response != nil && response.StatusCode != 204 {
-			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error stopping Bare Metal Server (%s)", id).Operation("StopBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
 
@@ -2062,7 +2068,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	}
 	response, err = sess.DeleteBareMetalServerWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Bare Metal Server").Operation("DeleteBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -2097,7 +2103,7 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 			if response != nil && response.StatusCode == 404 {
 				return bms, isBareMetalServerActionDeleted, nil
 			}
-			return bms, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+			return bms, "", flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server", id)
 		}
 		if *bms.Status == isBareMetalServerStatusFailed {
 			return bms, *bms.Status, fmt.Errorf("[ERROR] The Bare Metal Server (%s) failed to delete: %v", *bms.ID, err)
@@ -2127,7 +2133,7 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 		d.Set(isBareMetalServerStatus, *bms.Status)
 
@@ -2185,7 +2191,7 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 			}
 			bms, response, err := bmsC.GetBareMetalServer(getbmsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 			}
 			select {
 			case data := <-communicator:
@@ -2222,7 +2228,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 			}
 			response, err := bmsC.StopBareMetalServer(createbmssactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying Bare Metal Server action stop: %s\n%s", err, response)
+				communicator <- flex.NewAPIError(err, response).Summary("Error retrying Bare Metal Server action stop").Operation("StopBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 				return
 			}
 		case <-communicator:
@@ -2243,7 +2249,7 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
+		return nil, flex.NewAPIError(err, response).Summary("Error creating Bare Metal Server action start").Operation("StartBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
@@ -2262,7 +2268,7 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
+		return nil, flex.NewAPIError(err, response).Summary("Error creating Bare Metal Server Action stop").Operation("StopBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
@@ -2279,7 +2285,7 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
+		return nil, flex.NewAPIError(err, response).Summary("Error creating Bare Metal Server action restart").Operation("RestartBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
@@ -2307,7 +2313,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	if *bms.Status == "running" {
 
@@ -2318,7 +2324,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 
 		response, err := sess.StopBareMetalServerWithContext(context, options)
 		if err != nil && response != nil && response.StatusCode != 204 {
-			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error stopping Bare Metal Server (%s)", id).Operation("StopBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
 
@@ -2335,7 +2341,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server", d.Id())
 	}
 	if *bms.Status == "stopped" {
 
@@ -2347,7 +2353,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error creating Bare Metal Server action start").Operation("StartBareMetalServer").Resource("ibm_is_bare_metal_server", d.Id())
 		}
 		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
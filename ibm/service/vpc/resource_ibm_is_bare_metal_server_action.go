@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -208,7 +209,7 @@ func bareMetalServerActionGet(context context.Context, sess *vpcv1.VpcV1, id str
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s)", id).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_action", d.Id())
 	}
 	d.SetId(*bms.ID)
 	d.Set(isBareMetalServerStatus, *bms.Status)
@@ -324,7 +325,7 @@ func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server_action", d.Id())
 		}
 		d.Set(isBareMetalServerStatus, *bms.Status)
 
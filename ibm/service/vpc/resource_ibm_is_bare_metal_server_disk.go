@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -100,7 +101,7 @@ func resourceIBMISBareMetalServerDiskCreate(context context.Context, d *schema.R
 	options.BareMetalServerDiskPatch = diskPatch
 	disk, response, err := sess.UpdateBareMetalServerDiskWithContext(context, options)
 	if err != nil || disk == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response))
+		return flex.NewAPIError(err, response).Summary("Error updating bare metal server (%s)  disk (%s) err", bareMetalServerId, diskId).Operation("UpdateBareMetalServerDiskWithContext").Resource("ibm_is_bare_metal_server_disk", d.Id()).Diagnostics()
 	}
 	d.SetId(*disk.ID)
 	err = bareMetalServerDiskGet(context, d, sess, bareMetalServerId, diskId)
@@ -122,7 +123,7 @@ func bareMetalServerDiskGet(context context.Context, d *schema.ResourceData, ses
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error fetching bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error fetching bare metal server (%s)  disk (%s) err", bareMetalServerId, diskId).Operation("GetBareMetalServerDiskWithContext").Resource("ibm_is_bare_metal_server_disk", d.Id())
 	}
 
 	d.Set(isBareMetalServerID, bareMetalServerId)
@@ -183,7 +184,7 @@ func resourceIBMISBareMetalServerDiskUpdate(context context.Context, d *schema.R
 		options.BareMetalServerDiskPatch = diskPatch
 		disk, response, err := sess.UpdateBareMetalServerDiskWithContext(context, options)
 		if err != nil || disk == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating bare metal server (%s)  disk (%s) err", bareMetalServerId, diskId).Operation("UpdateBareMetalServerDiskWithContext").Resource("ibm_is_bare_metal_server_disk", d.Id()).Diagnostics()
 		}
 		err = bareMetalServerDiskGet(context, d, sess, bareMetalServerId, diskId)
 		if err != nil {
@@ -279,7 +279,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 
 		bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error fetching bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 		}
 		// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 		if *bms.Status == "failed" {
@@ -296,7 +296,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 			}
 			res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 			if err != nil || res.StatusCode != 204 {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+				return flex.NewAPIError(err, response).Summary("Error stopping bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 			}
 			_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -387,7 +387,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		options.BareMetalServerNetworkInterfacePrototype = nicOptions
 		nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil || nic == nil {
-			return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewAPIError(err, response).Summary("[DEBUG] Create bare metal server (%s) network interface err", bareMetalServerId).Operation("CreateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 		}
 		switch reflect.TypeOf(nic).String() {
 		case "*vpcv1.BareMetalServerNetworkInterfaceByPci":
@@ -426,7 +426,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		}
 		res, err := sess.StartBareMetalServerWithContext(context, createstartaction)
 		if err != nil || res.StatusCode != 204 {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error starting bare metal server (%s) err", bareMetalServerId).Operation("CreateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 		}
 		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
@@ -532,7 +532,7 @@ func createVlanTypeNetworkInterface(context context.Context, d *schema.ResourceD
 	options.BareMetalServerNetworkInterfacePrototype = nicOptions
 	nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nic == nil {
-		return fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response)
+		return flex.NewAPIError(err, response).Summary("[DEBUG] Create bare metal server (%s) network interface err", bareMetalServerId).Operation("CreateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 	}
 
 	switch reflect.TypeOf(nic).String() {
@@ -584,7 +584,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceRead(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicID).Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 	}
 	err = bareMetalServerNICGet(d, meta, sess, nicIntf, bareMetalServerId)
 	if err != nil {
@@ -647,7 +647,7 @@ func bareMetalServerNICGet(d *schema.ResourceData, meta interface{}, sess *vpcv1
 				}
 				bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 				}
 				currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 				primaryIpList = append(primaryIpList, currentIP)
@@ -724,7 +724,7 @@ func bareMetalServerNICGet(d *schema.ResourceData, meta interface{}, sess *vpcv1
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+				return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 
@@ -790,7 +790,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 		}
 	}
 
@@ -842,7 +842,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 
 		nicIntf, response, err := sess.UpdateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating Bare Metal Server").Operation("UpdateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id()).Diagnostics()
 		}
 		return diag.FromErr(bareMetalServerNICGet(d, meta, sess, nicIntf, bareMetalServerId))
 	}
@@ -879,7 +879,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface(%s)", bareMetalServerId, nicId).Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 	}
 	nicType := ""
 	switch reflect.TypeOf(nicIntf).String() {
@@ -896,7 +896,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 
 			bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error fetching bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 			}
 			// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 			if *bms.Status == "failed" {
@@ -913,7 +913,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 				}
 				res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 				if err != nil || res.StatusCode != 204 {
-					return fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+					return flex.NewAPIError(err, response).Summary("Error stopping bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 				}
 				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 				if err != nil || res.StatusCode != 204 {
@@ -935,7 +935,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 	}
 	response, err = sess.DeleteBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicId).Operation("DeleteBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -949,7 +949,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		}
 		res, err := sess.StartBareMetalServerWithContext(context, createstartaction)
 		if err != nil || res.StatusCode != 204 {
-			return fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error starting bare metal server (%s) err", bareMetalServerId).Operation("DeleteBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 		}
 		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
@@ -987,7 +987,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			}
 			bms, response, err := bmsC.GetBareMetalServer(getBmsOptions)
 			if err != nil {
-				return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) : %s\n%s", bareMetalServerId, err, response)
+				return bmsNic, isBareMetalServerNetworkInterfaceFailed, flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server(%s)", bareMetalServerId).Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server_network_interface", "")
 			}
 			if *bms.Status == "stopped" {
 				return bmsNic, isBareMetalServerNetworkInterfaceVlanPending, fmt.Errorf("[ERROR] Error deleting Bare Metal Server(%s) Network Interface (%s), server in stopped state ", bareMetalServerId, nicId)
@@ -997,7 +997,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			if response != nil && response.StatusCode == 404 {
 				return nicIntf, isBareMetalServerNetworkInterfaceDeleted, nil
 			}
-			return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) Network Interface (%s): %s\n%s", bareMetalServerId, nicId, err, response)
+			return bmsNic, isBareMetalServerNetworkInterfaceFailed, flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server(%s) Network Interface (%s)", bareMetalServerId, nicId).Operation("GetBareMetalServerNetworkInterface").Resource("ibm_is_bare_metal_server_network_interface", "")
 		}
 		return bmsNic, isBareMetalServerNetworkInterfaceDeleting, err
 	}
@@ -1024,7 +1024,7 @@ func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetal
 		}
 		bmsNic, response, err := client.GetBareMetalServerNetworkInterface(getBmsNicOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) Network Interface (%s)", bareMetalServerId, nicId).Operation("GetBareMetalServerNetworkInterface").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 		}
 		status := ""
 		pcipending := false
@@ -1039,7 +1039,7 @@ func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetal
 				}
 				bms, response, err := client.GetBareMetalServer(getBmsOptions)
 				if err != nil {
-					return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s)  : %s\n%s", bareMetalServerId, err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s)", bareMetalServerId).Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 				}
 				if *bms.Status == "stopped" {
 					pcipending = true
@@ -1104,7 +1104,7 @@ func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "failed", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 		}
 
 		if *bms.Status == "running" || *bms.Status == "failed" {
@@ -1134,7 +1134,7 @@ func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "failed", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server").Operation("GetBareMetalServer").Resource("ibm_is_bare_metal_server_network_interface", d.Id())
 		}
 		if *bms.Status == "stopped" || *bms.Status == "failed" {
 			// let know the isRestartStartAction() to stop
@@ -307,7 +307,7 @@ func createVlanTypeNetworkInterfaceAllowFloat(context context.Context, d *schema
 	options.BareMetalServerNetworkInterfacePrototype = nicOptions
 	nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nic == nil {
-		return fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response)
+		return flex.NewAPIError(err, response).Summary("[DEBUG] Create bare metal server (%s) network interface err", bareMetalServerId).Operation("CreateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 	}
 	d.Set(isFloatedBareMetalServerID, bareMetalServerId)
 	switch reflect.TypeOf(nic).String() {
@@ -370,7 +370,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatRead(context context.
 		// if response returns an error
 		if err != nil || nicIntf == nil {
 			if response != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+				return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicID).Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id()).Diagnostics()
 			} else {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s", bareMetalServerId, nicID, err))
 			}
@@ -394,7 +394,7 @@ func findNicsWithoutBMS(context context.Context, d *schema.ResourceData, sess *v
 		}
 		availableServers, response, err := sess.ListBareMetalServersWithContext(context, listBareMetalServersOptions)
 		if err != nil {
-			return nil, nil, fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s\n%s", err, response)
+			return nil, nil, flex.NewAPIError(err, response).Summary("Error fetching Bare Metal Servers").Operation("ListBareMetalServersWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 		}
 		start = flex.GetNext(availableServers.Next)
 		allrecs = append(allrecs, availableServers.BareMetalServers...)
@@ -475,7 +475,7 @@ func bareMetalServerNICAllowFloatGet(d *schema.ResourceData, meta interface{}, s
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+				return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 
@@ -597,7 +597,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id()).Diagnostics()
 		}
 	}
 
@@ -641,7 +641,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 
 		nicIntf, response, err := sess.UpdateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating Bare Metal Server").Operation("UpdateBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id()).Diagnostics()
 		}
 		return diag.FromErr(bareMetalServerNICAllowFloatGet(d, meta, sess, nicIntf, bareMetalServerId))
 	}
@@ -678,7 +678,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface(%s)", bareMetalServerId, nicId).Operation("GetBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 	}
 	nicType := ""
 	switch reflect.TypeOf(nicIntf).String() {
@@ -695,7 +695,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 
 			bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error fetching bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 			}
 			// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 			if *bms.Status == "failed" {
@@ -712,7 +712,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 				}
 				res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 				if err != nil || res.StatusCode != 204 {
-					return fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+					return flex.NewAPIError(err, response).Summary("Error stopping bare metal server (%s) err", bareMetalServerId).Operation("GetBareMetalServerWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 				}
 				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil || res.StatusCode != 204 {
@@ -734,7 +734,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 	}
 	response, err = sess.DeleteBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicId).Operation("DeleteBareMetalServerNetworkInterfaceWithContext").Resource("ibm_is_bare_metal_server_network_interface_allow_float", d.Id())
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -132,7 +134,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpCreate(context contex
 
 	fip, response, err := sess.AddBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil || fip == nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface (%s) floating ip (%s) err %s\n%s", bareMetalServerId, bareMetalServerNicId, bareMetalServerNicFipId, err, response))
+		return flex.NewAPIError(err, response).Summary("[DEBUG] Create bare metal server (%s) network interface (%s) floating ip (%s) err", bareMetalServerId, bareMetalServerNicId, bareMetalServerNicFipId).Operation("AddBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id()).Diagnostics()
 	}
 	d.SetId(MakeTerraformNICFipID(bareMetalServerId, bareMetalServerNicId, *fip.ID))
 	err = bareMetalServerNICFipGet(d, fip, bareMetalServerId, bareMetalServerNicId)
@@ -165,7 +167,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpRead(context context.
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicID).Operation("GetBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id()).Diagnostics()
 	}
 	err = bareMetalServerNICFipGet(d, fip, bareMetalServerId, nicID)
 	if err != nil {
@@ -216,7 +218,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpUpdate(context contex
 
 		fip, response, err := sess.AddBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating Bare Metal Server").Operation("AddBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id()).Diagnostics()
 		}
 		d.SetId(MakeTerraformNICFipID(bareMetalServerId, nicId, *fip.ID))
 		return diag.FromErr(bareMetalServerNICFipGet(d, fip, bareMetalServerId, nicId))
@@ -254,7 +256,7 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) Floating Ip(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) network interface(%s) Floating Ip(%s)", bareMetalServerId, nicId, fipId).Operation("GetBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id())
 	}
 
 	options := &vpcv1.RemoveBareMetalServerNetworkInterfaceFloatingIPOptions{
@@ -264,7 +266,7 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 	}
 	response, err = sess.RemoveBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) Floating Ip(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Bare Metal Server (%s) network interface (%s) Floating Ip(%s)", bareMetalServerId, nicId, fipId).Operation("RemoveBareMetalServerNetworkInterfaceFloatingIPWithContext").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id())
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(sess, bareMetalServerId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -302,7 +304,7 @@ func isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC *vpcv1.Vp
 			if response != nil && response.StatusCode == 404 {
 				return fip, isBareMetalServerNetworkInterfaceFloatingIpDeleted, nil
 			}
-			return fip, isBareMetalServerNetworkInterfaceFloatingIpFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+			return fip, isBareMetalServerNetworkInterfaceFloatingIpFailed, flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server(%s) Network Interface (%s) FloatingIp(%s)", bareMetalServerId, nicId, fipId).Operation("GetBareMetalServerNetworkInterfaceFloatingIP").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", "")
 		}
 		return fip, isBareMetalServerNetworkInterfaceFloatingIpDeleting, err
 	}
@@ -331,7 +333,7 @@ func isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1,
 		}
 		fip, response, err := client.GetBareMetalServerNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Bare Metal Server (%s) Network Interface (%s) FloatingIp(%s)", bareMetalServerId, nicId, fipId).Operation("GetBareMetalServerNetworkInterfaceFloatingIP").Resource("ibm_is_bare_metal_server_network_interface_floating_ip", d.Id())
 		}
 		status := ""
 
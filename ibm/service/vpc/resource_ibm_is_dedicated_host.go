@@ -682,7 +682,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error getting dedicated Host: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error getting dedicated Host").Operation("GetDedicatedHost").Resource("ibm_is_dedicated_host", d.Id())
 			}
 			if *dedicatedhost.State == isDedicatedHostFailed {
 				return dedicatedhost, *dedicatedhost.State, fmt.Errorf("[ERROR] The  Dedicated host %s failed to delete: %v", d.Id(), err)
@@ -719,7 +719,7 @@ func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Res
 		}
 		dhost, response, err := instanceC.GetDedicatedHost(getinsOptions)
 		if dhost == nil || err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting dedicated host : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting dedicated host").Operation("GetDedicatedHost").Resource("ibm_is_dedicated_host", d.Id())
 		}
 		d.Set("state", *dhost.State)
 		d.Set("lifecycle_state", *dhost.LifecycleState)
@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -135,7 +136,7 @@ func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta
 
 			_, response, err := sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating dedicated host disk: %s %s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error updating dedicated host disk").Operation("UpdateDedicatedHostDisk").Resource("ibm_is_dedicated_host_disk_management", d.Id())
 			}
 
 		}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -53,7 +52,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -218,6 +217,13 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -341,7 +347,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Floating IP err").Operation("CreateFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -349,8 +355,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if _, ok := d.GetOk(isFloatingIPTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
@@ -385,7 +390,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Floating IP (%s)", id).Operation("GetFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -407,6 +412,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFloatingIPTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -437,13 +443,13 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange(isFloatingIPTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Floating IP").Operation("GetFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
@@ -483,7 +489,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating vpc Floating IP").Operation("UpdateFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 		}
 	}
 	return nil
@@ -512,7 +518,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Floating IP (%s)", id).Operation("GetFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -520,7 +526,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Floating IP").Operation("DeleteFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 	}
 	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -549,7 +555,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting floating IP: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting floating IP").Operation("GetFloatingIP").Resource("ibm_is_floating_ip", d.Id())
 	}
 	return true, nil
 }
@@ -580,7 +586,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("[ERROR] Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", flex.NewAPIError(err, response).Summary("Error Getting Floating IP").Operation("GetFloatingIP").Resource("ibm_is_floating_ip", id)
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
@@ -608,7 +614,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Floating IP for the instance").Operation("GetFloatingIP").Resource("ibm_is_floating_ip", id)
 		}
 
 		if *instance.Status == "available" {
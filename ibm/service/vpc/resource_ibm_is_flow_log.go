@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -51,7 +50,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -137,6 +136,13 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -231,14 +237,13 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create Flow Log Collector err").Operation("CreateFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 	}
 	d.SetId(*flowlogCollector.ID)
 
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if _, ok := d.GetOk(isFlowLogTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -264,7 +269,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Flow Log Collector").Operation("GetFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 	}
 
 	if flowlogCollector.Name != nil {
@@ -312,6 +317,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFlowLogTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -344,10 +350,10 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Flow Log Collector").Operation("GetFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 	}
 
-	if d.HasChange(isFlowLogTags) {
+	if d.HasChange(isFlowLogTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -373,7 +379,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
 		_, response, err = sess.UpdateFlowLogCollector(updoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating flow log collector").Operation("UpdateFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 		}
 	}
 
@@ -393,7 +399,7 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting flow log collector").Operation("DeleteFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 	}
 
 	d.SetId("")
@@ -413,7 +419,7 @@ func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool,
 	}
 	_, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil && response.StatusCode != 404 {
-		return false, fmt.Errorf("[ERROR] Error Getting Flow Log Collector : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Flow Log Collector").Operation("GetFlowLogCollector").Resource("ibm_is_flow_log", d.Id())
 	}
 	if response.StatusCode == 404 {
 		d.SetId("")
@@ -239,7 +239,7 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	}
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("ike policy err").Operation("CreateIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
@@ -266,7 +266,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting IKE Policy(%s)", id).Operation("GetIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -346,7 +346,7 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error on update of IKE Policy(%s)", id).Operation("UpdateIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 		}
 	}
 	return nil
@@ -372,7 +372,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting IKE Policy(%s)", id).Operation("GetIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 	}
 
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
@@ -380,7 +380,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting IKE Policy(%s)", id).Operation("DeleteIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -405,7 +405,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting IKE Policy(%s)", id).Operation("GetIkePolicy").Resource("ibm_is_ike_policy", d.Id())
 	}
 
 	return true, nil
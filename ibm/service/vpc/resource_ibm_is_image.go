@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -59,7 +58,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -102,6 +101,13 @@ func ResourceIBMISImage() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isImageOperatingSystem: {
 				Type:         schema.TypeString,
@@ -286,7 +292,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Image creation err").Operation("CreateImage").Resource("ibm_is_image", d.Id())
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -294,8 +300,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -322,7 +327,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	vol, response, err := sess.GetVolume(options)
 	if err != nil || vol == nil {
-		return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volume, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving Volume (%s) details", volume).Operation("GetVolume").Resource("ibm_is_image", d.Id())
 	}
 	if vol.VolumeAttachments == nil {
 		return fmt.Errorf("[ERROR] Error creating Image because the specified source_volume %s is not attached to a virtual server instance ", volume)
@@ -334,7 +339,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	instance, response, err := sess.GetInstance(getinsOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the source_volume (%s) is attached : %s\n%s", insId, volume, err, response)
+		return flex.NewAPIError(err, response).Summary("Error retrieving Instance (%s) to which the source_volume (%s) is attached", insId, volume).Operation("GetInstance").Resource("ibm_is_image", d.Id())
 	}
 	if instance != nil && *instance.Status == "running" {
 		actiontype := "stop"
@@ -344,7 +349,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		}
 		_, response, err = sess.CreateInstanceAction(createinsactoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error stopping Instance (%s) to which the source_volume (%s) is attached  : %s\n%s", insId, volume, err, response)
+			return flex.NewAPIError(err, response).Summary("Error stopping Instance (%s) to which the source_volume (%s) is attached", insId, volume).Operation("CreateInstanceAction").Resource("ibm_is_image", d.Id())
 		}
 		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
@@ -375,7 +380,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	image, response, err := sess.CreateImage(imagOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Image creation err").Operation("CreateImage").Resource("ibm_is_image", d.Id())
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -383,8 +388,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -416,7 +420,7 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Image").Operation("GetImage").Resource("ibm_is_image", id)
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -450,13 +454,13 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange(isImageTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Image IP: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Image IP").Operation("GetImage").Resource("ibm_is_image", d.Id())
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
@@ -479,7 +483,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options.ImagePatch = imagePatch
 		_, response, err := sess.UpdateImage(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on update of resource vpc Image: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error on update of resource vpc Image").Operation("UpdateImage").Resource("ibm_is_image", d.Id())
 		}
 	}
 	return nil
@@ -509,7 +513,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Image (%s)", id).Operation("GetImage").Resource("ibm_is_image", d.Id())
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	if image.MinimumProvisionedSize != nil {
@@ -549,6 +553,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isImageTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -587,7 +592,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Image (%s)", id).Operation("GetImage").Resource("ibm_is_image", d.Id())
 	}
 
 	options := &vpcv1.DeleteImageOptions{
@@ -595,7 +600,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Image : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Image").Operation("DeleteImage").Resource("ibm_is_image", d.Id())
 	}
 	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -631,7 +636,7 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return image, "", flex.NewAPIError(err, response).Summary("Error Getting Image").Operation("GetImage").Resource("ibm_is_image", id)
 		}
 		return image, isImageDeleting, err
 	}
@@ -655,7 +660,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Image: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Image").Operation("GetImage").Resource("ibm_is_image", d.Id())
 	}
 	return true, nil
 }
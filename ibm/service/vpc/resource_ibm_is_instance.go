@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -142,7 +141,7 @@ func ResourceIBMISInstance() *schema.Resource {
 						d.SetId("")
 						return nil, nil
 					}
-					return nil, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+					return nil, flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 				}
 				var volumes []string
 				volumes = make([]string, 0)
@@ -166,12 +165,11 @@ func ResourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.InstanceProfileValidate(diff)
-				}),
+				validate.InvokeRules("ibm_is_instance"),
+				validate.InvokeCloudData("ibm_is_instance")),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 
@@ -212,19 +210,21 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:   "Id of the instance template",
 			},
 			isInstanceZone: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Computed:    true,
-				Optional:    true,
-				Description: "Zone name",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceZone),
+				Description:  "Zone name",
 			},
 
 			isInstanceProfile: {
-				Type:        schema.TypeString,
-				ForceNew:    false,
-				Computed:    true,
-				Optional:    true,
-				Description: "Profile info",
+				Type:         schema.TypeString,
+				ForceNew:     false,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceProfile),
+				Description:  "Profile info",
 			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
@@ -299,6 +299,13 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isEnableCleanDelete: {
 				Type:             schema.TypeBool,
@@ -950,6 +957,20 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceZone,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			CloudDataType:              validate.CloudDataZone})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceProfile,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			CloudDataType:              validate.CloudDataInstanceProfile})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isInstanceTotalVolumeBandwidth,
@@ -991,7 +1012,16 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              host_failure})
 
-	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
+	// an instance changed from or to a profile with instance storage, such as bx2d-2x8, is replaced
+	rules := []validate.ValidateRule{
+		{
+			Type:       validate.ForceNewWhenChanged,
+			Identifier: isInstanceProfile,
+			Regexp:     "d",
+		},
+	}
+
+	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema, Rules: rules}
 	return &ibmISInstanceValidator
 }
 
@@ -1341,8 +1371,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1701,8 +1730,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2070,8 +2098,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2431,8 +2458,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2510,7 +2536,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
@@ -2573,7 +2599,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				communicator <- flex.NewAPIError(err, response).Summary("Error retrying instance action start").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
@@ -2585,7 +2611,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				communicator <- flex.NewAPIError(err, response).Summary("Error retrying instance action start").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 				return
 			}
 		case <-communicator:
@@ -2624,11 +2650,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 	}
 	instanceInitialization, response, err := instanceC.GetInstanceInitialization(getinsIniOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Instance initialization details").Operation("GetInstanceInitialization").Resource("ibm_is_instance", d.Id())
 	}
 	if instanceInitialization.DefaultTrustedProfile != nil && instanceInitialization.DefaultTrustedProfile.AutoLink != nil {
 		d.Set(isInstanceDefaultTrustedProfileAutoLink, *instanceInitialization.DefaultTrustedProfile.AutoLink)
@@ -2718,7 +2744,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *instance.PrimaryNetworkInterface.PrimaryIP.ID, *instance.PrimaryNetworkInterface.ID, err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *instance.PrimaryNetworkInterface.PrimaryIP.ID, *instance.PrimaryNetworkInterface.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_instance", d.Id())
 		}
 		currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -2731,7 +2757,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("ibm_is_instance", d.Id())
 		}
 		currentPrimNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 		if insnic.PortSpeed != nil {
@@ -2785,7 +2811,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *intfc.PrimaryIP.ID, *intfc.ID, err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *intfc.PrimaryIP.ID, *intfc.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_instance", d.Id())
 				}
 				currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -2798,7 +2824,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance").Operation("GetInstanceNetworkInterface").Resource("ibm_is_instance", d.Id())
 				}
 				currentNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -2904,6 +2930,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource Instance (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isInstanceTags, tags)
+	d.Set("tags_all", tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -2975,7 +3002,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res))
+			return flex.NewAPIError(err, res).Summary("Error encountered while expanding boot volume of instance").Operation("UpdateVolume").Resource("ibm_is_instance", d.Id())
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
@@ -3009,14 +3036,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.GetVolume(optionsget)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+					return flex.NewAPIError(err, response).Summary("Error getting Boot Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_instance", d.Id())
 				}
 				eTag := response.Headers.Get("ETag")
 				updateVolumeOptions.IfMatch = &eTag
 				updateVolumeOptions.VolumePatch = volumePatch
 				vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 				if vol == nil || err != nil {
-					return (fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res))
+					return flex.NewAPIError(err, res).Summary("Error encountered while applying tags for boot volume of instance").Operation("UpdateVolume").Resource("ibm_is_instance", d.Id())
 				}
 				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
@@ -3044,7 +3071,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -3091,7 +3118,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -3108,7 +3135,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			instance, response, err := instanceC.GetInstance(getinsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+				return flex.NewAPIError(err, response).Summary("Error Getting Instance (%s)", id).Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 			}
 			if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 				d.Set(isInstanceAction, nil)
@@ -3127,7 +3154,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 			}
 			if actiontype == "stop" {
 				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
@@ -3220,7 +3247,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return flex.NewAPIError(err, response).Summary("Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()).Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_instance", d.Id())
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -3238,7 +3265,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return flex.NewAPIError(err, response).Summary("Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()).Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_instance", d.Id())
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -3271,7 +3298,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating instance network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_instance", d.Id())
 		}
 	}
 
@@ -3296,7 +3323,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return flex.NewAPIError(err, response).Summary("Error while updating name %s for primary network interface of instance %s", newName, d.Id()).Operation("UpdateInstanceNetworkInterface").Resource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -3337,7 +3364,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 				_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+					return flex.NewAPIError(err, response).Summary("Error updating instance network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_instance", d.Id())
 				}
 			}
 
@@ -3357,7 +3384,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return flex.NewAPIError(err, response).Summary("Error while creating security group %q for network interface of instance %s", add[i], d.Id()).Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_instance", d.Id())
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
@@ -3376,7 +3403,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return flex.NewAPIError(err, response).Summary("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()).Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_instance", d.Id())
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
@@ -3409,7 +3436,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return flex.NewAPIError(err, response).Summary("Error while updating name %s for network interface of instance %s", newName, d.Id()).Operation("UpdateInstanceNetworkInterface").Resource("ibm_is_instance", d.Id())
 				}
 				if err != nil {
 					return err
@@ -3516,7 +3543,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Instance (%s)", id).Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 		}
 
 		if instance != nil && *instance.Status == "running" {
@@ -3530,7 +3557,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 			}
 			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
@@ -3557,7 +3584,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error in UpdateInstancePatch").Operation("UpdateInstance").Resource("ibm_is_instance", d.Id())
 		}
 
 		actiontype := "start"
@@ -3570,7 +3597,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -3584,9 +3611,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 	}
-	if d.HasChange(isInstanceTags) {
+	if d.HasChange(isInstanceTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -3623,7 +3650,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Instance (%s)", id).Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 	}
 
 	bootvolid := ""
@@ -3639,7 +3666,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
@@ -3650,7 +3677,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing volume attachments to the instance: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Listing volume attachments to the instance").Operation("ListInstanceVolumeAttachments").Resource("ibm_is_instance", d.Id())
 		}
 		for _, vol := range vols.VolumeAttachments {
 			if *vol.Type == "data" {
@@ -3719,7 +3746,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 	}
 	return true, nil
 }
@@ -3747,7 +3774,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to delete: %v", d.Id(), err)
@@ -3773,7 +3800,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 			}
 			select {
 			case data := <-communicator:
@@ -3812,7 +3839,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Instance").Operation("GetInstance").Resource("ibm_is_instance", d.Id())
 			}
 			select {
 			case data := <-communicator:
@@ -3855,7 +3882,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
+				communicator <- flex.NewAPIError(err, response).Summary("Error retrying instance action stop").Operation("CreateInstanceAction").Resource("ibm_is_instance", d.Id())
 				return
 			}
 		case <-communicator:
@@ -3890,7 +3917,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 		}
 		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Attaching volume").Operation("GetInstanceVolumeAttachment").Resource("ibm_is_instance", id)
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -3916,7 +3943,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Detaching: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Detaching").Operation("GetInstanceVolumeAttachment").Resource("ibm_is_instance", d.Id())
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("[ERROR] The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -126,7 +127,7 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 	}
 	instance, response, err := sess.GetInstance(getinsOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", instanceId, err, response))
+		return flex.NewAPIError(err, response).Summary("Error Getting Instance (%s)", instanceId).Operation("GetInstance").Resource("ibm_is_instance_action", d.Id()).Diagnostics()
 	}
 	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 		d.Set(isInstanceAction, nil)
@@ -148,7 +149,7 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance_action", d.Id()).Diagnostics()
 	}
 	if actiontype == "stop" {
 		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
@@ -182,7 +183,7 @@ func resourceIBMISInstanceActionRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting instance (%s): %s\n%s", id, err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting instance (%s)", id).Operation("GetInstance").Resource("ibm_is_instance_action", d.Id()).Diagnostics()
 	}
 
 	d.Set(isInstanceStatus, *instance.Status)
@@ -218,7 +219,7 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 	}
 	instance, response, err := sess.GetInstance(getinsOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response))
+		return flex.NewAPIError(err, response).Summary("Error Getting Instance (%s)", id).Operation("GetInstance").Resource("ibm_is_instance_action", d.Id()).Diagnostics()
 	}
 	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 		d.Set(isInstanceAction, nil)
@@ -236,7 +237,7 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error Creating Instance Action").Operation("CreateInstanceAction").Resource("ibm_is_instance_action", d.Id()).Diagnostics()
 	}
 	if actiontype == "stop" {
 		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
@@ -272,7 +273,7 @@ func resourceIBMISInstanceActionExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting instance : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting instance").Operation("GetInstance").Resource("ibm_is_instance_action", d.Id())
 	}
 	return true, err
 }
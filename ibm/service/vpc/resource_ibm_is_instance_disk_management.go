@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -99,7 +100,7 @@ func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta inte
 
 		_, response, err := sess.UpdateInstanceDisk(updateInstanceDiskOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling UpdateInstanceDisk: %s %s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error calling UpdateInstanceDisk").Operation("UpdateInstanceDisk").Resource("ibm_is_instance_disk_management", d.Id())
 		}
 
 	}
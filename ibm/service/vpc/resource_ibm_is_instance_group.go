@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -39,7 +38,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -146,6 +145,13 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for instance group",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 		},
 	}
 }
@@ -245,7 +251,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		return fmt.Errorf("[ERROR] Error Creating InstanceGroup: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating InstanceGroup").Operation("CreateInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 	}
 	d.SetId(*instanceGroup.ID)
 
@@ -254,8 +260,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		return healthError
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
@@ -278,12 +283,12 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
-			return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting instance group").Operation("GetInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 		}
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
@@ -345,7 +350,7 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating InstanceGroup").Operation("UpdateInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 		}
 
 		// wait for instance group health update with update timeout configured.
@@ -371,7 +376,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup").Operation("GetInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 	}
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
@@ -406,6 +411,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	return nil
 }
 
@@ -415,7 +421,7 @@ func getLBStatus(sess *vpcv1.VpcV1, lbId string) (string, error) {
 	}
 	lb, response, err := sess.GetLoadBalancer(getlboptions)
 	if err != nil || lb == nil {
-		return "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_instance_group", "")
 	}
 	return *lb.ProvisioningStatus, nil
 }
@@ -454,7 +460,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error updating instanceGroup's instance count to 0").Operation("UpdateInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 	}
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
@@ -495,7 +501,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup: %s\n%s", Err, response)
+		return flex.NewAPIError(Err, response).Summary("Error Deleting the InstanceGroup").Operation("DeleteInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 	}
 
 	_, deleteError := waitForInstanceGroupDelete(d, meta)
@@ -517,7 +523,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup").Operation("GetInstanceGroup").Resource("ibm_is_instance_group", d.Id())
 	}
 	return true, nil
 }
@@ -536,7 +542,7 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		Refresh: func() (interface{}, string, error) {
 			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
-				return nil, SCALING, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+				return nil, SCALING, flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup").Operation("GetInstanceGroup").Resource("ibm_is_instance_group", instanceGroupID)
 			}
 			log.Println("Status : ", *instanceGroup.Status)
 
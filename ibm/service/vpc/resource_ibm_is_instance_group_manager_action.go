@@ -275,7 +275,7 @@ func resourceIBMISInstanceGroupManagerActionCreate(d *schema.ResourceData, meta
 
 	instanceGroupManagerActionIntf, response, err := sess.CreateInstanceGroupManagerAction(&instanceGroupManagerActionOptions)
 	if err != nil || instanceGroupManagerActionIntf == nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceGroup manager Action: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error creating InstanceGroup manager Action").Operation("CreateInstanceGroupManagerAction").Resource("ibm_is_instance_group_manager_action", d.Id())
 	}
 	instanceGroupManagerAction := instanceGroupManagerActionIntf.(*vpcv1.InstanceGroupManagerAction)
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceGroupID, instancegroupmanagerscheduledID, *instanceGroupManagerAction.ID))
@@ -367,7 +367,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 		}
 		_, response, err := sess.UpdateInstanceGroupManagerAction(updateInstanceGroupManagerActionOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating InstanceGroup manager action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating InstanceGroup manager action").Operation("UpdateInstanceGroupManagerAction").Resource("ibm_is_instance_group_manager_action", d.Id())
 		}
 	}
 	return resourceIBMISInstanceGroupManagerRead(d, meta)
@@ -399,7 +399,7 @@ func resourceIBMISInstanceGroupManagerActionRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Action: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Action").Operation("GetInstanceGroupManagerAction").Resource("ibm_is_instance_group_manager_action", d.Id())
 	}
 	instanceGroupManagerAction := instanceGroupManagerActionIntf.(*vpcv1.InstanceGroupManagerAction)
 	if err = d.Set("auto_delete", *instanceGroupManagerAction.AutoDelete); err != nil {
@@ -502,7 +502,7 @@ func resourceIBMISInstanceGroupManagerActionDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager Action: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting the InstanceGroup Manager Action").Operation("DeleteInstanceGroupManagerAction").Resource("ibm_is_instance_group_manager_action", d.Id())
 	}
 	return nil
 }
@@ -533,7 +533,7 @@ func resourceIBMISInstanceGroupManagerActionExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Action: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Action").Operation("GetInstanceGroupManagerAction").Resource("ibm_is_instance_group_manager_action", d.Id())
 	}
 
 	return true, nil
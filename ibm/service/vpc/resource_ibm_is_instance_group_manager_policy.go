@@ -133,8 +133,10 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isInsGrpKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isInsGrpKey)
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
@@ -143,7 +145,7 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 
 	data, response, err := sess.CreateInstanceGroupManagerPolicy(&createInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
-		return fmt.Errorf("[ERROR] Error Creating InstanceGroup Manager Policy: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating InstanceGroup Manager Policy").Operation("CreateInstanceGroupManagerPolicy").Resource("ibm_is_instance_group_manager_policy", d.Id())
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 
@@ -194,8 +196,10 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		updateInstanceGroupManagerPolicyOptions.InstanceGroupManagerID = &instanceGroupManagerID
 
 		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-		conns.IbmMutexKV.Lock(isInsGrpKey)
-		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isInsGrpKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isInsGrpKey)
 
 		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
@@ -204,7 +208,7 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 
 		_, response, err := sess.UpdateInstanceGroupManagerPolicy(&updateInstanceGroupManagerPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating InstanceGroup Manager Policy").Operation("UpdateInstanceGroupManagerPolicy").Resource("ibm_is_instance_group_manager_policy", d.Id())
 		}
 	}
 	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
@@ -235,7 +239,7 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Policy").Operation("GetInstanceGroupManagerPolicy").Resource("ibm_is_instance_group_manager_policy", d.Id())
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 	d.Set("name", *instanceGroupManagerPolicy.Name)
@@ -269,8 +273,10 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isInsGrpKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isInsGrpKey)
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
@@ -283,7 +289,7 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Manager Policy: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting the InstanceGroup Manager Policy").Operation("DeleteInstanceGroupManagerPolicy").Resource("ibm_is_instance_group_manager_policy", d.Id())
 	}
 	return nil
 }
@@ -317,7 +323,7 @@ func resourceIBMISInstanceGroupManagerPolicyExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Manager Policy").Operation("GetInstanceGroupManagerPolicy").Resource("ibm_is_instance_group_manager_policy", d.Id())
 	}
 	return true, nil
 }
@@ -178,7 +178,7 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 
 	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Membership").Operation("GetInstanceGroupMembership").Resource("ibm_is_instance_group_membership", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, instanceGroupMembershipID))
 
@@ -206,7 +206,7 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 			updateInstanceGroupMembershipOptions.InstanceGroupMembershipPatch = instanceGroupMembershipPatch
 			_, response, err := sess.UpdateInstanceGroupMembership(&updateInstanceGroupMembershipOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating InstanceGroup Membership: %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error updating InstanceGroup Membership").Operation("UpdateInstanceGroupMembership").Resource("ibm_is_instance_group_membership", d.Id())
 			}
 		}
 	}
@@ -236,7 +236,7 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting InstanceGroup Membership").Operation("GetInstanceGroupMembership").Resource("ibm_is_instance_group_membership", d.Id())
 	}
 	d.Set(isInstanceGroupMemershipDeleteInstanceOnMembershipDelete, *instanceGroupMembership.DeleteInstanceOnMembershipDelete)
 	d.Set(isInstanceGroupMembership, *instanceGroupMembership.ID)
@@ -293,7 +293,7 @@ func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Membership: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting the InstanceGroup Membership").Operation("DeleteInstanceGroupMembership").Resource("ibm_is_instance_group_membership", d.Id())
 	}
 	return nil
 }
@@ -338,13 +338,15 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := conns.Locks.LockContext(context, isNICKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(isNICKey)
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateInstanceNetworkInterfaceWithContext").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
@@ -368,7 +370,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 		if err != nil {
 			d.Set(isInstanceNicFloatingIP, "")
-			return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error adding Floating IP to network interface").Operation("AddInstanceNetworkInterfaceFloatingIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 		}
 		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -407,7 +409,7 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetInstanceNetworkInterfaceWithContext").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 	}
 	d.SetId(fmt.Sprintf("%s/%s", parts[0], *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
@@ -450,7 +452,7 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 		}
 		insRip, response, err := vpcClient.GetSubnetReservedIP(getripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *networkInterface.PrimaryIP.ID, *networkInterface.ID, err, response))
+			return flex.NewAPIError(err, response).Summary("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *networkInterface.PrimaryIP.ID, *networkInterface.ID).Operation("GetSubnetReservedIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 		}
 		currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -574,7 +576,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := vpcClient.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewAPIError(err, response).Summary("Error updating instance network interface reserved ip(%s)", ripId).Operation("UpdateSubnetReservedIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 		}
 	}
 
@@ -593,7 +595,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				}
 				_, response, err := vpcClient.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
+					return flex.NewAPIError(err, response).Summary("Error while creating security group %q for network interface of instance %s", add[i], d.Id()).Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 				}
 				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -610,7 +612,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				}
 				response, err := vpcClient.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
+					return flex.NewAPIError(err, response).Summary("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()).Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 				}
 				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -622,13 +624,15 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		if err := conns.Locks.LockContext(context, isNICKey); err != nil {
+			return diag.FromErr(err)
+		}
+		defer conns.Locks.Unlock(isNICKey)
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateInstanceNetworkInterfaceWithContext").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 		}
 	}
 
@@ -643,7 +647,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if response.StatusCode == 404 {
 					log.Println("[DEBUG] The specified floating IP address is not associated with the network interface with the specified identifier. ", err.Error())
 				} else {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error de-associating the floating ip %s in network interface %s of instance %s, %s\n%s", floating_ip_id_old.(string), network_interface_id, instance_id, err, response))
+					return flex.NewAPIError(err, response).Summary("Error de-associating the floating ip %s in network interface %s of instance %s", floating_ip_id_old.(string), network_interface_id, instance_id).Operation("RemoveInstanceNetworkInterfaceFloatingIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 				}
 			}
 		} else {
@@ -657,7 +661,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					d.SetId("")
 					return nil
 				}
-				return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", floating_ip_id, err, response))
+				return flex.NewAPIError(err, response).Summary("Error Getting Floating IP (%s)", floating_ip_id).Operation("GetFloatingIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 
 			}
 
@@ -679,7 +683,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 			if err != nil {
 				d.Set(isInstanceNicFloatingIP, "")
-				return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
+				return flex.NewAPIError(err, response).Summary("Error adding Floating IP to network interface").Operation("AddInstanceNetworkInterfaceFloatingIP").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 			}
 		}
 
@@ -711,8 +715,10 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := conns.Locks.LockContext(context, isNICKey); err != nil {
+		return diag.FromErr(err)
+	}
+	defer conns.Locks.Unlock(isNICKey)
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
@@ -720,7 +726,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	response, err := vpcClient.DeleteInstanceNetworkInterfaceWithContext(context, deleteInstanceNetworkInterfaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteInstanceNetworkInterfaceWithContext").Resource("ibm_is_instance_network_interface", d.Id()).Diagnostics()
 	}
 
 	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
@@ -767,7 +773,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 
 		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Operation("GetInstanceNetworkInterface").Resource("ibm_is_instance_network_interface", d.Id())
 		}
 		d.Set("status", *networkInterface.Status)
 
@@ -810,7 +816,7 @@ func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *s
 			if response != nil && response.StatusCode == 404 {
 				return networkInterface, isNetworkInterfaceDeleted, nil
 			}
-			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Operation("GetInstanceNetworkInterface").Resource("ibm_is_instance_network_interface", d.Id())
 		}
 		d.Set("status", *networkInterface.Status)
 
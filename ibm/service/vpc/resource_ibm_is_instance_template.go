@@ -74,7 +74,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 
@@ -1016,7 +1016,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error creating InstanceTemplate").Operation("CreateInstanceTemplate").Resource("ibm_is_instance_template", d.Id())
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.SetId(*instance.ID)
@@ -1033,7 +1033,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	}
 	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Instance template").Operation("GetInstanceTemplate").Resource("ibm_is_instance_template", d.Id())
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.Set(isInstanceTemplateName, *instance.Name)
@@ -1348,7 +1348,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceTemplate: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting InstanceTemplate").Operation("GetInstanceTemplate").Resource("ibm_is_instance_template", d.Id())
 	}
 	return true, nil
 }
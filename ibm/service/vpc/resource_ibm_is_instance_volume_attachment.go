@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -52,12 +51,13 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
+				validate.InvokeRules("ibm_is_instance_volume_attachment"),
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeValidate(diff)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 		Schema: map[string]*schema.Schema{
@@ -266,7 +266,8 @@ func ResourceIBMISInstanceVolumeAttachmentValidator() *validate.ResourceValidato
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISInstanceVolumeAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_volume_attachment", Schema: validateSchema}
+	ibmISInstanceVolumeAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_volume_attachment", Schema: validateSchema,
+		Rules: volumeProfileRules(isInstanceVolProfile, isInstanceVolCapacity, isInstanceVolIops)}
 	return &ibmISInstanceVolumeAttachmentValidator
 }
 
@@ -302,12 +303,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 					userTagStr := userTag.(string)
 					userTagsArray[i] = userTagStr
 				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
+				userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 				volProtoVol.UserTags = userTagsArray
 			}
 		}
@@ -379,8 +375,10 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isInstanceKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isInstanceKey)
 
 	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachment(instanceVolAttproto)
 	if err != nil {
@@ -433,7 +431,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance volume attachment : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Instance volume attachment").Operation("GetInstanceVolumeAttachment").Resource("ibm_is_instance_volume_attachment", d.Id())
 	}
 	d.Set(isInstanceId, instanceId)
 
@@ -537,7 +535,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		voloptions.VolumePatch = volumePatch
 		_, response, err := instanceC.UpdateVolume(voloptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume name : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating volume name").Operation("UpdateVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 	}
 
@@ -555,7 +553,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", insId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Instance (%s)", insId).Operation("GetInstance").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 
 		if instance != nil && *instance.Status != "running" {
@@ -566,7 +564,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", insId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error starting Instance (%s)", insId).Operation("CreateInstanceAction").Resource("ibm_is_instance_volume_attachment", d.Id())
 			}
 			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -599,12 +597,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 						userTagStr := userTag.(string)
 						userTagsArray[i] = userTagStr
 					}
-					schematicTags := os.Getenv("IC_ENV_TAGS")
-					var envTags []string
-					if schematicTags != "" {
-						envTags = strings.Split(schematicTags, ",")
-						userTagsArray = append(userTagsArray, envTags...)
-					}
+					userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 					volumeProfilePatchModel.UserTags = userTagsArray
 				}
 			}
@@ -620,14 +613,14 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, response, err = instanceC.GetVolume(optionsget)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Boot Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		eTag := response.Headers.Get("ETag")
 		updateVolumeProfileOptions.IfMatch = &eTag
 		updateVolumeProfileOptions.VolumePatch = volumeProfilePatch
 		_, response, err = instanceC.UpdateVolume(updateVolumeProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume profile/iops/userTags: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating volume profile/iops/userTags").Operation("UpdateVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 	}
@@ -641,7 +634,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		vol, response, err := instanceC.GetVolume(getvolumeoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].Name == "" {
@@ -653,7 +646,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", instanceId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Instance (%s)", instanceId).Operation("GetInstance").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -663,10 +656,10 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error starting Instance (%s)", instanceId).Operation("CreateInstanceAction").Resource("ibm_is_instance_volume_attachment", d.Id())
 			}
 			_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
-			return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error starting Instance (%s)", instanceId).Operation("GetInstance").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		capacity := int64(d.Get(isVolumeCapacity).(int))
 		updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
@@ -681,7 +674,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateVolumeOptions.VolumePatch = volumeCapacityPatch
 		_, response, err = instanceC.UpdateVolume(updateVolumeOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume capacity: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating volume capacity").Operation("UpdateVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -712,8 +705,10 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isInstanceKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isInstanceKey)
 
 	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
 	if err != nil {
@@ -730,7 +725,7 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		}
 		response, err := instanceC.DeleteVolume(deleteVolumeOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while deleting volume : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error while deleting volume").Operation("DeleteVolume").Resource("ibm_is_instance_volume_attachment", d.Id())
 		}
 		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
@@ -787,7 +782,7 @@ func instanceVolAttExists(d *schema.ResourceData, meta interface{}, instanceId,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Instance volume attachment: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Instance volume attachment").Operation("GetInstanceVolumeAttachment").Resource("ibm_is_instance_volume_attachment", d.Id())
 	}
 	return true, nil
 }
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -41,9 +40,7 @@ func ResourceIBMISIPSecPolicy() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceIPSecPolicyValidate(diff)
-				}),
+				validate.InvokeRules("ibm_is_ipsec_policy")),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -187,7 +184,18 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              pfs})
 
-	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema}
+	validateRules := []validate.ValidateRule{
+		{
+			Type:          validate.AllowedValuesWhen,
+			Identifier:    isIpSecAuthenticationAlg,
+			AllowedValues: "disabled",
+			When: []validate.RuleCondition{
+				{Identifier: isIpSecEncryptionAlg, AllowedValues: "aes128gcm16, aes192gcm16, aes256gcm16"},
+			},
+		},
+	}
+
+	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema, Rules: validateRules}
 	return &ibmISIPSECResourceValidator
 }
 
@@ -234,7 +242,7 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("ipSec policy err").Operation("CreateIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
@@ -261,7 +269,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting IPSEC Policy(%s)", id).Operation("GetIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 	}
 	d.Set(isIpSecName, *ipSec.Name)
 	d.Set(isIpSecAuthenticationAlg, *ipSec.AuthenticationAlgorithm)
@@ -342,7 +350,7 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error on update of IPSEC Policy(%s)", id).Operation("UpdateIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 		}
 	}
 	return nil
@@ -368,14 +376,14 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting IPSEC Policy(%s)", id).Operation("GetIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 	}
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting IPSEC Policy(%s)", id).Operation("DeleteIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -400,7 +408,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting IPSEC Policy(%s)", id).Operation("GetIpsecPolicy").Resource("ibm_is_ipsec_policy", d.Id())
 	}
 	return true, nil
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -62,13 +61,11 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceRouteModeValidate(diff)
-				}),
+				validate.InvokeRules("ibm_is_lb")),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -195,6 +192,13 @@ func ResourceIBMISLB() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_lb", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isLBResourceGroup: {
 				Type:     schema.TypeString,
@@ -295,7 +299,24 @@ func ResourceIBMISLBValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISLBResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb", Schema: validateSchema}
+	validateRules := []validate.ValidateRule{
+		{
+			Type:          validate.AllowedValuesWhen,
+			Identifier:    isLBType,
+			AllowedValues: "private",
+			When:          []validate.RuleCondition{{Identifier: isLBRouteMode, AllowedValues: "true"}},
+			Message:       "'type' must be 'private', at present public load balancers are not supported with route mode enabled.",
+		},
+		{
+			Type:          validate.AllowedValuesWhen,
+			Identifier:    isLBProfile,
+			AllowedValues: "network-fixed",
+			When:          []validate.RuleCondition{{Identifier: isLBRouteMode, AllowedValues: "true"}},
+			Message:       "'profile' must be 'network-fixed', route mode is supported by private network load balancer.",
+		},
+	}
+
+	ibmISLBResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb", Schema: validateSchema, Rules: validateRules}
 	return &ibmISLBResourceValidator
 }
 
@@ -400,7 +421,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Load Balancer err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Load Balancer err").Operation("CreateLoadBalancer").Resource("ibm_is_lb", d.Id())
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
@@ -408,8 +429,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
+	if _, ok := d.GetOk(isLBTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
@@ -445,7 +465,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb", d.Id())
 	}
 	d.Set(isLBName, *lb.Name)
 	if *lb.IsPublic {
@@ -545,6 +565,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isLBTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -600,13 +621,13 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isLBTags) {
+	if d.HasChange(isLBTags) || d.HasChange("tags_all") {
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb", d.Id())
 		}
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
@@ -641,7 +662,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating subents in vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating subents in vpc Load Balancer").Operation("UpdateLoadBalancer").Resource("ibm_is_lb", d.Id())
 		}
 		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -664,7 +685,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating vpc Load Balancer").Operation("UpdateLoadBalancer").Resource("ibm_is_lb", d.Id())
 		}
 	}
 	if hasChangedLog {
@@ -688,7 +709,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating vpc Load Balancer").Operation("UpdateLoadBalancer").Resource("ibm_is_lb", d.Id())
 		}
 	}
 
@@ -701,7 +722,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error while creating Security Group Target Binding").Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_lb", d.Id())
 				}
 				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -721,12 +742,12 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("[ERROR] Error Getting Security Group Target for this load balancer (%s): %s\n%s", securityGroupID, err, response)
+					return flex.NewAPIError(err, response).Summary("Error Getting Security Group Target for this load balancer (%s)", securityGroupID).Operation("GetSecurityGroupTarget").Resource("ibm_is_lb", d.Id())
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error Deleting Security Group Target for this load balancer").Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_lb", d.Id())
 				}
 				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -765,7 +786,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting vpc load balancer(%s)", id).Operation("GetLoadBalancer").Resource("ibm_is_lb", d.Id())
 	}
 
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
@@ -773,7 +794,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting vpc load balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting vpc load balancer").Operation("DeleteLoadBalancer").Resource("ibm_is_lb", d.Id())
 	}
 	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -809,7 +830,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("[ERROR] The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", flex.NewAPIError(err, response).Summary("The vpc load balancer %s failed to delete", id).Operation("GetLoadBalancer").Resource("ibm_is_lb", id)
 		}
 		return lb, isLBDeleting, nil
 	}
@@ -836,7 +857,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting vpc load balancer: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting vpc load balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb", d.Id())
 	}
 	return true, nil
 }
@@ -864,7 +885,7 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb", lbId)
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -240,8 +240,10 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
 	if err != nil {
@@ -268,7 +270,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	lb, response, err := sess.GetLoadBalancer(getlboptions)
 
 	if err != nil || lb == nil {
-		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_listener", d.Id())
 	}
 	if lb != nil && *lb.RouteMode && lb.Profile != nil && *lb.Profile.Name == "network-fixed" {
 		if portMin > 0 && portMax > 0 && portMin != 1 && portMax != 65535 {
@@ -342,7 +344,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Load Balanacer Listener err").Operation("CreateLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
 	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
@@ -382,7 +384,7 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Listener").Operation("GetLoadBalancerListener").Resource("ibm_is_lb_listener", lbListenerID)
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -426,7 +428,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Listener").Operation("GetLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 	}
 	d.Set(isLBListenerLBID, lbID)
 	if lbListener.Port != nil {
@@ -463,7 +465,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_listener", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 	return nil
@@ -595,8 +597,10 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isLBKey)
 
 		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -605,7 +609,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Load Balancer Listener").Operation("UpdateLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 		}
 
 		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
@@ -634,8 +638,10 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbListenerDelete(d, meta, lbID, lbListenerID)
 	if err != nil {
@@ -661,7 +667,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting vpc load balancer listener(%s)", lbListenerID).Operation("GetLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -673,7 +679,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Load Balancer Pool").Operation("DeleteLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 	}
 	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -714,7 +720,7 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("The vpc load balancer listener %s failed to delete", lbListenerID).Operation("GetLoadBalancerListener").Resource("ibm_is_lb_listener", lbListenerID)
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
@@ -752,7 +758,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Load balancer Listener").Operation("GetLoadBalancerListener").Resource("ibm_is_lb_listener", d.Id())
 	}
 	return true, nil
 }
@@ -472,8 +472,10 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -634,7 +636,7 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer policy: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Load balancer policy").Operation("GetLoadBalancerListenerPolicy").Resource("ibm_is_lb_listener_policy", d.Id())
 	}
 	return true, nil
 }
@@ -775,8 +777,10 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isLBKey)
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -785,7 +789,7 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating in policy").Operation("UpdateLoadBalancerListenerPolicy").Resource("ibm_is_lb_listener_policy", d.Id())
 		}
 
 		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -809,8 +813,10 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
 	if err != nil {
@@ -858,7 +864,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 
 	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in lbListenerPolicyDelete: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error in lbListenerPolicyDelete").Operation("DeleteLoadBalancerListenerPolicy").Resource("ibm_is_lb_listener_policy", d.Id())
 	}
 	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1015,7 +1021,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_listener_policy", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 
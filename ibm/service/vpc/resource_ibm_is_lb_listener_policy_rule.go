@@ -262,8 +262,10 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -429,7 +431,7 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting policy: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting policy").Operation("GetLoadBalancerListenerPolicyRule").Resource("ibm_is_lb_listener_policy_rule", d.Id())
 	}
 	return true, nil
 }
@@ -499,8 +501,10 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isLBKey)
 
 		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -510,7 +514,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 
 		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating in policy : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating in policy").Operation("UpdateLoadBalancerListenerPolicyRule").Resource("ibm_is_lb_listener_policy_rule", d.Id())
 		}
 
 		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -535,8 +539,10 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	ruleID := parts[3]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
 	if err != nil {
@@ -570,7 +576,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error in LbListenerPolicyGet : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error in LbListenerPolicyGet").Operation("GetLoadBalancerListenerPolicyRule").Resource("ibm_is_lb_listener_policy_rule", d.Id())
 	}
 
 	deleteLbListenerPolicyRuleOptions := &vpcv1.DeleteLoadBalancerListenerPolicyRuleOptions{
@@ -581,7 +587,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	}
 	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error in lbListenerPolicyRuleDelete").Operation("DeleteLoadBalancerListenerPolicyRule").Resource("ibm_is_lb_listener_policy_rule", d.Id())
 	}
 	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -677,7 +683,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_listener_policy_rule", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 
@@ -60,6 +60,7 @@ func ResourceIBMISLBPool() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			validate.InvokeRules("ibm_is_lb_pool"),
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceIBMISLBPoolCookieValidate(diff)
 			},
@@ -243,7 +244,20 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              persistanceType})
 
-	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema}
+	validateRules := []validate.ValidateRule{
+		{
+			Type:       validate.RequiredWhen,
+			Identifier: isLBPoolSessPersistenceAppCookieName,
+			When:       []validate.RuleCondition{{Identifier: isLBPoolSessPersistenceType, AllowedValues: "app_cookie"}},
+		},
+		{
+			Type:       validate.ForbiddenWhen,
+			Identifier: isLBPoolSessPersistenceAppCookieName,
+			When:       []validate.RuleCondition{{Identifier: isLBPoolSessPersistenceType, AllowedValues: "app_cookie", Not: true}},
+		},
+	}
+
+	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema, Rules: validateRules}
 	return &ibmISLBPoolResourceValidator
 }
 
@@ -280,8 +294,10 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		healthMonitorPort = int64(hmp.(int))
 	}
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err := lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 	if err != nil {
@@ -333,7 +349,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("lbpool create err").Operation("CreateLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
@@ -386,7 +402,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool").Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 	}
 	d.Set(isLBPoolName, *lbPool.Name)
 	d.Set(isLBPool, lbPoolID)
@@ -429,7 +445,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_pool", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 	return nil
@@ -529,8 +545,10 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isLBKey)
 		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
@@ -558,7 +576,7 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Load Balancer Pool").Operation("UpdateLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 		}
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
@@ -587,8 +605,10 @@ func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	lbPoolID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbPoolDelete(d, meta, lbID, lbPoolID)
 	if err != nil {
@@ -613,7 +633,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting vpc load balancer pool(%s)", lbPoolID).Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -631,7 +651,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Load Balancer Pool").Operation("DeleteLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 	}
 	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -679,7 +699,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer pool: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Load balancer pool").Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool", d.Id())
 	}
 	return true, nil
 }
@@ -708,7 +728,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool").Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool", lbPoolId)
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -746,7 +766,7 @@ func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("The vpc load balancer pool %s failed to delete", lbPoolId).Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool", lbPoolId)
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
@@ -166,8 +166,10 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 	var weight int64
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
 	if err != nil {
@@ -218,7 +220,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 
 	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("lbpool member create err").Operation("CreateLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
@@ -267,7 +269,7 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool Member").Operation("GetLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", lbPoolMemID)
 		}
 
 		if *lbPoolMem.ProvisioningStatus == isLBPoolMemberActive {
@@ -318,7 +320,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool Member").Operation("GetLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 	d.Set(isLBPoolID, lbPoolID)
 	d.Set(isLBID, lbID)
@@ -340,7 +342,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 	return nil
@@ -377,8 +379,10 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey); err != nil {
+			return err
+		}
+		defer conns.Locks.Unlock(isLBKey)
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -430,7 +434,7 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 
 		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool Member: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Load Balancer Pool Member").Operation("UpdateLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 		}
 		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -464,8 +468,10 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	lbPoolMemID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
 
 	err = lbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
 	if err != nil {
@@ -492,7 +498,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool Member").Operation("GetLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -516,7 +522,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	}
 	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Load Balancer Pool Member").Operation("DeleteLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 
 	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
@@ -566,7 +572,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] Error Deleting Load balancer pool member: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Deleting Load balancer pool member").Operation("GetLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", lbPoolMemID)
 		}
 		return lbPoolMem, isLBPoolMemberDeletePending, nil
 	}
@@ -607,7 +613,7 @@ func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer pool member: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Load balancer pool member").Operation("GetLoadBalancerPoolMember").Resource("ibm_is_lb_pool_member", d.Id())
 	}
 	return true, nil
 }
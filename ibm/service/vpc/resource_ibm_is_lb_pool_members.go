@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBPoolMembers             = "members"
+	isLBPoolMemberID            = "id"
+	isLBPoolMemberDefaultWeight = 50
+)
+
+func ResourceIBMISLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISLBPoolMembersCreate,
+		Read:     resourceIBMISLBPoolMembersRead,
+		Update:   resourceIBMISLBPoolMembersUpdate,
+		Delete:   resourceIBMISLBPoolMembersDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer ID",
+			},
+
+			isLBPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					poolID, err := getPoolId(n)
+					return o != "" && err == nil && poolID == o
+				},
+				Description: "Load balancer pool ID",
+			},
+
+			isLBPoolMembers: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMISLBPoolMembersHash,
+				Description: "The members of the load balancer pool, replacing all the members of the pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberPort: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Load Balancer Pool port",
+						},
+
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Load balancer pool member target address",
+						},
+
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Load balancer pool member target id",
+						},
+
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isLBPoolMemberDefaultWeight,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_members", isLBPoolMemberWeight),
+							Description:  "Load balancer pool member weight",
+						},
+
+						isLBPoolMemberID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Load balancer pool member ID",
+						},
+
+						isLBPoolMemberProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Load balancer Pool member provisioning status",
+						},
+
+						isLBPoolMemberHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LB Pool member health",
+						},
+
+						isLBPoolMemberHref: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LB pool member Href value",
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the LB resource",
+			},
+		},
+	}
+}
+
+func ResourceIBMISLBPoolMembersValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMemberWeight,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "100"})
+
+	ibmISLBPoolMembersResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool_members", Schema: validateSchema}
+	return &ibmISLBPoolMembersResourceValidator
+}
+
+// resourceIBMISLBPoolMembersHash hashes the configurable attributes of a member only, so that the
+// members read from the pool match the members of the configuration.
+func resourceIBMISLBPoolMembersHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberPort].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetAddress]))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetID]))
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberWeight].(int)))
+	return conns.String(buf.String())
+}
+
+func resourceIBMISLBPoolMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	lbID := d.Get(isLBID).(string)
+	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
+	if err != nil {
+		return err
+	}
+
+	err = lbpMembersReplace(d, meta, lbID, lbPoolID, d.Get(isLBPoolMembers).(*schema.Set).List(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbPoolID))
+
+	return resourceIBMISLBPoolMembersRead(d, meta)
+}
+
+// lbpMembersReplace replaces all the members of the pool with members, waiting once for the pool
+// and the load balancer to be active before and after the replacement.
+func lbpMembersReplace(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, members []interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	prototypes := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, len(members))
+	for _, m := range members {
+		prototype, err := expandLBPoolMemberPrototype(m.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		prototypes = append(prototypes, prototype)
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	if err := conns.Locks.LockTimeout(timeout, isLBKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isLBKey)
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	options := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+		Members:        prototypes,
+	}
+	_, response, err := sess.ReplaceLoadBalancerPoolMembers(options)
+	if err != nil {
+		return flex.NewAPIError(err, response).Summary("Error Replacing Load Balancer Pool Members").Operation("ReplaceLoadBalancerPoolMembers").Resource("ibm_is_lb_pool_members", d.Id())
+	}
+	log.Printf("[INFO] Replaced the members of the load balancer pool %s with %d members", lbPoolID, len(prototypes))
+
+	_, err = isWaitForLBPoolMembersAvailable(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	return nil
+}
+
+func expandLBPoolMemberPrototype(m map[string]interface{}) (vpcv1.LoadBalancerPoolMemberPrototype, error) {
+	port := int64(m[isLBPoolMemberPort].(int))
+	weight := int64(m[isLBPoolMemberWeight].(int))
+	prototype := vpcv1.LoadBalancerPoolMemberPrototype{
+		Port:   &port,
+		Weight: &weight,
+	}
+
+	targetAddress := m[isLBPoolMemberTargetAddress].(string)
+	targetID := m[isLBPoolMemberTargetID].(string)
+	if (targetAddress == "") == (targetID == "") {
+		return prototype, fmt.Errorf("[ERROR] Exactly one of %s and %s must be set for the member on port %d", isLBPoolMemberTargetAddress, isLBPoolMemberTargetID, port)
+	}
+	if targetAddress != "" {
+		prototype.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{
+			Address: &targetAddress,
+		}
+	} else {
+		prototype.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{
+			ID: &targetID,
+		}
+	}
+	return prototype, nil
+}
+
+func isWaitForLBPoolMembersAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the members of the load balancer pool (%s) to be available.", lbPoolID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"create_pending", "update_pending", "maintenance_pending", isLBPoolMemberDeletePending},
+		Target:     []string{isLBPoolMemberActive},
+		Refresh:    isLBPoolMembersRefreshFunc(lbc, lbID, lbPoolID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// isLBPoolMembersRefreshFunc returns the pending provisioning status of a member of the pool, if
+// any, and active otherwise.
+func isLBPoolMembersRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+
+		listlbpmoptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         &lbPoolID,
+		}
+		lbPoolMembers, response, err := lbc.ListLoadBalancerPoolMembers(listlbpmoptions)
+		if err != nil {
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Listing Load Balancer Pool Members").Operation("ListLoadBalancerPoolMembers").Resource("ibm_is_lb_pool_members", lbPoolID)
+		}
+
+		for _, lbPoolMem := range lbPoolMembers.Members {
+			if *lbPoolMem.ProvisioningStatus != isLBPoolMemberActive && *lbPoolMem.ProvisioningStatus != "failed" {
+				return lbPoolMembers, *lbPoolMem.ProvisioningStatus, nil
+			}
+		}
+		return lbPoolMembers, isLBPoolMemberActive, nil
+	}
+}
+
+func resourceIBMISLBPoolMembersRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] The id should contain loadbalancer Id and loadbalancer pool Id")
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	listlbpmoptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+	}
+	lbPoolMembers, response, err := sess.ListLoadBalancerPoolMembers(listlbpmoptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).Summary("Error Listing Load Balancer Pool Members").Operation("ListLoadBalancerPoolMembers").Resource("ibm_is_lb_pool_members", d.Id())
+	}
+
+	members := make([]interface{}, 0, len(lbPoolMembers.Members))
+	for _, lbPoolMem := range lbPoolMembers.Members {
+		member := map[string]interface{}{
+			isLBPoolMemberID:                 *lbPoolMem.ID,
+			isLBPoolMemberPort:               int(*lbPoolMem.Port),
+			isLBPoolMemberTargetAddress:      "",
+			isLBPoolMemberTargetID:           "",
+			isLBPoolMemberWeight:             isLBPoolMemberDefaultWeight,
+			isLBPoolMemberProvisioningStatus: *lbPoolMem.ProvisioningStatus,
+			isLBPoolMemberHealth:             *lbPoolMem.Health,
+			isLBPoolMemberHref:               *lbPoolMem.Href,
+		}
+		if lbPoolMem.Weight != nil {
+			member[isLBPoolMemberWeight] = int(*lbPoolMem.Weight)
+		}
+		if target, ok := lbPoolMem.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+			if target.Address != nil {
+				member[isLBPoolMemberTargetAddress] = *target.Address
+			}
+			if target.ID != nil {
+				member[isLBPoolMemberTargetID] = *target.ID
+			}
+		}
+		members = append(members, member)
+	}
+
+	d.Set(isLBID, lbID)
+	d.Set(isLBPoolID, lbPoolID)
+	if err := d.Set(isLBPoolMembers, schema.NewSet(resourceIBMISLBPoolMembersHash, members)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting members: %s", err)
+	}
+
+	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	}
+	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_lb_pool_members", d.Id())
+	}
+	d.Set(flex.RelatedCRN, *lb.CRN)
+	return nil
+}
+
+func resourceIBMISLBPoolMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	if d.HasChange(isLBPoolMembers) {
+		err = lbpMembersReplace(d, meta, lbID, lbPoolID, d.Get(isLBPoolMembers).(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMISLBPoolMembersRead(d, meta)
+}
+
+func resourceIBMISLBPoolMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbPoolID,
+	}
+	_, response, err := sess.GetLoadBalancerPool(getlbpOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).Summary("Error Getting Load Balancer Pool").Operation("GetLoadBalancerPool").Resource("ibm_is_lb_pool_members", d.Id())
+	}
+
+	err = lbpMembersReplace(d, meta, lbID, lbPoolID, []interface{}{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
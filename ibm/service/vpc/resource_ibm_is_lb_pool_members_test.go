@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISLBPoolMembers_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpms-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpmsc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, `"127.0.0.1", "127.0.0.2", "127.0.0.3"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount("ibm_is_lb_pool_members.testacc_lb_mems", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.*", map[string]string{
+							"port":           "8080",
+							"target_address": "127.0.0.2",
+							"weight":         "50",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, `"127.0.0.1", "127.0.0.4"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount("ibm_is_lb_pool_members.testacc_lb_mems", 2),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.*", map[string]string{
+							"target_address": "127.0.0.4",
+						}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_lb_pool_members.testacc_lb_mems",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBPoolMembersDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_lb_pool_members" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		lbID := parts[0]
+		lbPoolID := parts[1]
+		listlbpmoptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         &lbPoolID,
+		}
+		lbPoolMembers, _, err := sess.ListLoadBalancerPoolMembers(listlbpmoptions)
+		if err == nil && len(lbPoolMembers.Members) > 0 {
+			return fmt.Errorf("LB Pool members still exist: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISLBPoolMembersCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		lbID := parts[0]
+		lbPoolID := parts[1]
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listlbpmoptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         &lbPoolID,
+		}
+		lbPoolMembers, _, err := sess.ListLoadBalancerPoolMembers(listlbpmoptions)
+		if err != nil {
+			return err
+		}
+		if len(lbPoolMembers.Members) != count {
+			return fmt.Errorf("Expected %d members in the pool, got %d", count, len(lbPoolMembers.Members))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, zone, cidr, name, poolName, addresses string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name = "%s"
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		algorithm = "round_robin"
+		protocol = "http"
+		health_delay= 45
+		health_retries = 5
+		health_timeout = 30
+		health_type = "tcp"
+	}
+	resource "ibm_is_lb_pool_members" "testacc_lb_mems" {
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		pool = "${element(split("/",ibm_is_lb_pool.testacc_lb_pool.id),1)}"
+		dynamic "members" {
+			for_each = [%s]
+			content {
+				port = 8080
+				target_address = members.value
+			}
+		}
+}`, vpcname, subnetname, zone, cidr, name, poolName, addresses)
+}
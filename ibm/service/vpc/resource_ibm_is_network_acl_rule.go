@@ -40,7 +40,7 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -443,7 +443,7 @@ func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) e
 	}
 	nwaclRule, response, err := sess.CreateNetworkACLRule(createNetworkAclRuleOptions)
 	if err != nil || nwaclRule == nil {
-		return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Creating network ACL rule").Operation("CreateNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 	}
 	err = nwaclRuleGet(d, meta, nwACLID, nwaclRule)
 	if err != nil {
@@ -471,7 +471,7 @@ func resourceIBMISNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Network ACL Rule (%s) : %s\n%s", ruleId, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Network ACL Rule (%s)", ruleId).Operation("GetNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 	}
 	err = nwaclRuleGet(d, meta, nwACLID, nwaclRule)
 	if err != nil {
@@ -752,7 +752,7 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		updateNetworkACLRuleOptions.NetworkACLRulePatch = updateNetworkACLOptionsPatch
 		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Network ACL Rule : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Network ACL Rule").Operation("UpdateNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 		}
 	}
 	return nil
@@ -789,7 +789,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Network ACL Rule  (%s)", id).Operation("GetNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 	}
 
 	deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
@@ -798,7 +798,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	}
 	response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Network ACL Rule").Operation("DeleteNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -818,7 +818,7 @@ func nwaclRuleExists(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Network ACL Rule: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Network ACL Rule").Operation("GetNetworkACLRule").Resource("ibm_is_network_acl_rule", d.Id())
 	}
 	return true, nil
 }
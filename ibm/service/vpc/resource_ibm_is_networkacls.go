@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -63,7 +62,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -96,6 +95,13 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isNetworkACLCRN: {
 				Type:        schema.TypeString,
@@ -428,24 +434,18 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Network ACL err").Operation("CreateNetworkACL").Resource("ibm_is_network_acl", d.Id())
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
 	nwaclid := *nwacl.ID
 
-	//Remove default rules
-	err = clearRules(sess, nwaclid)
-	if err != nil {
-		return err
-	}
-
-	err = createInlineRules(sess, nwaclid, rules)
+	// Replace the default rules
+	err = replaceNetworkACLRules(sess, nwaclid, rules)
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if _, ok := d.GetOk(isNetworkACLTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *nwacl.CRN)
 		if err != nil {
@@ -479,7 +479,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Network ACL(%s)", id).Operation("GetNetworkACL").Resource("ibm_is_network_acl", d.Id())
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -493,6 +493,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource network acl (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isNetworkACLTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
 	if len(nwacl.Rules) > 0 {
@@ -623,10 +624,10 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Network ACL(%s)", id).Operation("UpdateNetworkACL").Resource("ibm_is_network_acl", d.Id())
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
+	if d.HasChange(isNetworkACLTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string))
 		if err != nil {
@@ -639,13 +640,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			return err
 		}
-		//Delete all existing rules
-		err = clearRules(sess, id)
-		if err != nil {
-			return err
-		}
-		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		err = replaceNetworkACLRules(sess, id, rules)
 		if err != nil {
 			return err
 		}
@@ -680,7 +675,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Network ACL (%s)", id).Operation("GetNetworkACL").Resource("ibm_is_network_acl", d.Id())
 	}
 
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
@@ -688,7 +683,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Network ACL : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Network ACL").Operation("DeleteNetworkACL").Resource("ibm_is_network_acl", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -713,7 +708,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Network ACL: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Network ACL").Operation("GetNetworkACL").Resource("ibm_is_network_acl", d.Id())
 	}
 	return true, nil
 }
@@ -725,54 +720,16 @@ func checkNetworkACLNil(ptr *int64) int {
 	return int(*ptr)
 }
 
-func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
-	start := ""
-	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
-	for {
-		listNetworkAclRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
-			NetworkACLID: &nwaclid,
-		}
-		if start != "" {
-			listNetworkAclRulesOptions.Start = &start
-		}
-		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
-		}
-		start = flex.GetNext(rawrules.Next)
-		allrecs = append(allrecs, rawrules.Rules...)
-		if start == "" {
-			break
-		}
-	}
-
-	for _, rule := range allrecs {
-		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
-			NetworkACLID: &nwaclid,
-		}
-		switch reflect.TypeOf(rule).String() {
-		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
-			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
-			deleteNetworkAclRuleOptions.ID = rule.ID
-		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
-			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
-			deleteNetworkAclRuleOptions.ID = rule.ID
-		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
-			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-			deleteNetworkAclRuleOptions.ID = rule.ID
-		}
-
-		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
-		}
-	}
-	return nil
-}
-
 func validateInlineRules(rules []interface{}) error {
+	names := make(map[string]bool, len(rules))
 	for _, rule := range rules {
 		rulex := rule.(map[string]interface{})
+		name := rulex[isNetworkACLRuleName].(string)
+		if names[name] {
+			return fmt.Errorf("[ERROR] Duplicate rule name %s, the names of the rules must be unique", name)
+		}
+		names[name] = true
+
 		action := rulex[isNetworkACLRuleAction].(string)
 		if (action != "allow") && (action != "deny") {
 			return fmt.Errorf("[ERROR] Invalid action. valid values are allow|deny")
@@ -793,111 +750,337 @@ func validateInlineRules(rules []interface{}) error {
 	return nil
 }
 
-func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
-	before := ""
+// networkACLRule is an existing rule of a network ACL, with the prototype creating it.
+type networkACLRule struct {
+	id        string
+	prototype *vpcv1.NetworkACLRulePrototype
+}
 
-	for i := 0; i <= len(rules)-1; i++ {
-		rulex := rules[i].(map[string]interface{})
+// networkACLRuleStep is a step of the replacement of the rules of a network ACL. The step applies
+// to the rule at index in the configuration, updating or moving the existing rule id, or creating
+// the rule after deleting the existing rule id, if any. The steps with no index delete the rule id.
+type networkACLRuleStep struct {
+	index  int
+	id     string
+	create bool
+	move   bool
+	update bool
+}
 
-		name := rulex[isNetworkACLRuleName].(string)
-		source := rulex[isNetworkACLRuleSource].(string)
-		destination := rulex[isNetworkACLRuleDestination].(string)
-		action := rulex[isNetworkACLRuleAction].(string)
-		direction := rulex[isNetworkACLRuleDirection].(string)
-		icmp := rulex[isNetworkACLRuleICMP].([]interface{})
-		tcp := rulex[isNetworkACLRuleTCP].([]interface{})
-		udp := rulex[isNetworkACLRuleUDP].([]interface{})
-		icmptype := int64(-1)
-		icmpcode := int64(-1)
-		minport := int64(-1)
-		maxport := int64(-1)
-		sourceminport := int64(-1)
-		sourcemaxport := int64(-1)
-		protocol := "all"
-
-		ruleTemplate := &vpcv1.NetworkACLRulePrototype{
-			Action:      &action,
-			Destination: &destination,
-			Direction:   &direction,
-			Source:      &source,
-			Name:        &name,
-		}
-
-		if before != "" {
-			ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
-				ID: &before,
+// replaceNetworkACLRules replaces the rules of the network ACL nwaclid with the inline rules, in
+// the order of the rules. The rules are matched by name with the existing rules, which are
+// updated and moved in place rather than deleted and created again, and the existing rules that
+// are not in rules are deleted last, so that the ACL is never left without its rules.
+func replaceNetworkACLRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+	items, err := listNetworkACLRules(nwaclC, nwaclid)
+	if err != nil {
+		return err
+	}
+	existing := make([]networkACLRule, 0, len(items))
+	for _, item := range items {
+		existing = append(existing, networkACLRuleItemRule(item))
+	}
+	desired := make([]*vpcv1.NetworkACLRulePrototype, len(rules))
+	for i, rule := range rules {
+		desired[i] = expandNetworkACLRulePrototype(rule.(map[string]interface{}))
+	}
+
+	ids := make([]string, len(desired))
+	for _, step := range planNetworkACLRules(existing, desired) {
+		if step.id != "" && (step.index < 0 || step.create) {
+			deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+				NetworkACLID: &nwaclid,
+				ID:           &step.id,
 			}
+			response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+			if err != nil {
+				return flex.NewAPIError(err, response).Summary("Error Deleting network ACL rule").Operation("DeleteNetworkACLRule").Resource("ibm_is_network_acl", nwaclid)
+			}
+		}
+		if step.index < 0 {
+			continue
 		}
 
-		if len(icmp) > 0 {
-			protocol = "icmp"
-			ruleTemplate.Protocol = &protocol
-			if !isNil(icmp[0]) {
-				icmpval := icmp[0].(map[string]interface{})
-				if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
-					icmptype = int64(val.(int))
-					ruleTemplate.Type = &icmptype
-				}
-				if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
-					icmpcode = int64(val.(int))
-					ruleTemplate.Code = &icmpcode
+		before := ""
+		if step.index+1 < len(desired) {
+			before = ids[step.index+1]
+		}
+		rule := desired[step.index]
+		switch {
+		case step.create:
+			if before != "" {
+				rule.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+					ID: &before,
 				}
 			}
-		} else if len(tcp) > 0 {
-			protocol = "tcp"
-			ruleTemplate.Protocol = &protocol
-			tcpval := tcp[0].(map[string]interface{})
-			if val, ok := tcpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
+			createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+				NetworkACLID:            &nwaclid,
+				NetworkACLRulePrototype: rule,
 			}
-			if val, ok := tcpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
+			created, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+			if err != nil {
+				return flex.NewAPIError(err, response).Summary("Error Creating network ACL rule").Operation("CreateNetworkACLRule").Resource("ibm_is_network_acl", nwaclid)
 			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+			ids[step.index] = networkACLRuleItemRule(created).id
+			continue
+		case step.move || step.update:
+			networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{}
+			if step.update {
+				networkACLRulePatchModel.Action = rule.Action
+				networkACLRulePatchModel.Source = rule.Source
+				networkACLRulePatchModel.Destination = rule.Destination
+				networkACLRulePatchModel.Direction = rule.Direction
+				networkACLRulePatchModel.DestinationPortMin = rule.DestinationPortMin
+				networkACLRulePatchModel.DestinationPortMax = rule.DestinationPortMax
+				networkACLRulePatchModel.SourcePortMin = rule.SourcePortMin
+				networkACLRulePatchModel.SourcePortMax = rule.SourcePortMax
+				networkACLRulePatchModel.Code = rule.Code
+				networkACLRulePatchModel.Type = rule.Type
 			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+			if step.move && before != "" {
+				networkACLRulePatchModel.Before = &vpcv1.NetworkACLRuleBeforePatch{
+					ID: &before,
+				}
 			}
-		} else if len(udp) > 0 {
-			protocol = "udp"
-			ruleTemplate.Protocol = &protocol
-			udpval := udp[0].(map[string]interface{})
-			if val, ok := udpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
+			networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err)
 			}
-			if val, ok := udpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
+			updateNetworkAclRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+				NetworkACLID:        &nwaclid,
+				ID:                  &step.id,
+				NetworkACLRulePatch: networkACLRulePatch,
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+			_, response, err := nwaclC.UpdateNetworkACLRule(updateNetworkAclRuleOptions)
+			if err != nil {
+				return flex.NewAPIError(err, response).Summary("Error Updating network ACL rule").Operation("UpdateNetworkACLRule").Resource("ibm_is_network_acl", nwaclid)
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+		}
+		ids[step.index] = step.id
+	}
+	return nil
+}
+
+// planNetworkACLRules returns the steps replacing the existing rules, in priority order, with the
+// desired rules. The existing rules with the name and the protocol of a desired rule are kept,
+// and the longest sequence of them already in the desired order is not moved. The steps place
+// the desired rules from the last one to the first one, each before the rule following it, then
+// delete the existing rules that are not desired.
+func planNetworkACLRules(existing []networkACLRule, desired []*vpcv1.NetworkACLRulePrototype) []networkACLRuleStep {
+	byName := make(map[string]int, len(existing))
+	for j, rule := range existing {
+		byName[*rule.prototype.Name] = j
+	}
+	matched := make([]int, len(desired))
+	replaced := make([]string, len(desired))
+	used := make([]bool, len(existing))
+	for i, rule := range desired {
+		matched[i] = -1
+		j, ok := byName[*rule.Name]
+		if !ok {
+			continue
+		}
+		used[j] = true
+		if *existing[j].prototype.Protocol == *rule.Protocol {
+			matched[i] = j
+		} else {
+			// The protocol of a rule cannot be updated
+			replaced[i] = existing[j].id
+		}
+	}
+
+	inOrder := inOrderNetworkACLRules(matched)
+	steps := make([]networkACLRuleStep, 0, len(desired)+len(existing))
+	for i := len(desired) - 1; i >= 0; i-- {
+		if matched[i] < 0 {
+			steps = append(steps, networkACLRuleStep{index: i, id: replaced[i], create: true})
+			continue
+		}
+		existingRule := existing[matched[i]]
+		steps = append(steps, networkACLRuleStep{
+			index:  i,
+			id:     existingRule.id,
+			move:   !inOrder[i],
+			update: !networkACLRulePrototypesEqual(existingRule.prototype, desired[i]),
+		})
+	}
+	for j, rule := range existing {
+		if !used[j] {
+			steps = append(steps, networkACLRuleStep{index: -1, id: rule.id})
+		}
+	}
+	return steps
+}
+
+// inOrderNetworkACLRules returns the desired rules that do not move, the longest sequence of the
+// matched existing rules in increasing order. A rule can only be moved before another rule, so
+// the sequence ends with the last desired rule when it exists.
+func inOrderNetworkACLRules(matched []int) []bool {
+	length := make([]int, len(matched))
+	previous := make([]int, len(matched))
+	last := -1
+	for i := range matched {
+		previous[i] = -1
+		if matched[i] < 0 {
+			continue
+		}
+		length[i] = 1
+		for k := 0; k < i; k++ {
+			if matched[k] >= 0 && matched[k] < matched[i] && length[k]+1 > length[i] {
+				length[i] = length[k] + 1
+				previous[i] = k
 			}
 		}
-		if protocol == "all" {
-			ruleTemplate.Protocol = &protocol
+		if last < 0 || length[i] > length[last] {
+			last = i
 		}
+	}
+	if n := len(matched); n > 0 && matched[n-1] >= 0 {
+		last = n - 1
+	}
+
+	inOrder := make([]bool, len(matched))
+	for i := last; i >= 0; i = previous[i] {
+		inOrder[i] = true
+	}
+	return inOrder
+}
+
+func networkACLRulePrototypesEqual(a, b *vpcv1.NetworkACLRulePrototype) bool {
+	return reflect.DeepEqual(a.Action, b.Action) &&
+		reflect.DeepEqual(a.Source, b.Source) &&
+		reflect.DeepEqual(a.Destination, b.Destination) &&
+		reflect.DeepEqual(a.Direction, b.Direction) &&
+		reflect.DeepEqual(a.DestinationPortMin, b.DestinationPortMin) &&
+		reflect.DeepEqual(a.DestinationPortMax, b.DestinationPortMax) &&
+		reflect.DeepEqual(a.SourcePortMin, b.SourcePortMin) &&
+		reflect.DeepEqual(a.SourcePortMax, b.SourcePortMax) &&
+		reflect.DeepEqual(a.Code, b.Code) &&
+		reflect.DeepEqual(a.Type, b.Type)
+}
 
-		createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
-			NetworkACLID:            &nwaclid,
-			NetworkACLRulePrototype: ruleTemplate,
+func listNetworkACLRules(nwaclC *vpcv1.VpcV1, nwaclid string) ([]vpcv1.NetworkACLRuleItemIntf, error) {
+	start := ""
+	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
+	for {
+		listNetworkAclRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &nwaclid,
+		}
+		if start != "" {
+			listNetworkAclRulesOptions.Start = &start
 		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			return nil, flex.NewAPIError(err, response).Summary("Error Listing network ACL rules").Operation("ListNetworkACLRules").Resource("ibm_is_network_acl", nwaclid)
+		}
+		start = flex.GetNext(rawrules.Next)
+		allrecs = append(allrecs, rawrules.Rules...)
+		if start == "" {
+			break
 		}
 	}
-	return nil
+	return allrecs, nil
+}
+
+// networkACLRuleItemRule returns the ID of a rule returned by the API and the prototype creating it.
+func networkACLRuleItemRule(item interface{}) networkACLRule {
+	var rule networkACLRule
+	prototype := &vpcv1.NetworkACLRulePrototype{}
+	switch rulex := item.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+		prototype.Code, prototype.Type = rulex.Code, rulex.Type
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+		prototype.DestinationPortMin, prototype.DestinationPortMax = rulex.DestinationPortMin, rulex.DestinationPortMax
+		prototype.SourcePortMin, prototype.SourcePortMax = rulex.SourcePortMin, rulex.SourcePortMax
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+		prototype.Code, prototype.Type = rulex.Code, rulex.Type
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+		prototype.DestinationPortMin, prototype.DestinationPortMax = rulex.DestinationPortMin, rulex.DestinationPortMax
+		prototype.SourcePortMin, prototype.SourcePortMax = rulex.SourcePortMin, rulex.SourcePortMax
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		rule.id = *rulex.ID
+		prototype.Name, prototype.Action, prototype.Protocol = rulex.Name, rulex.Action, rulex.Protocol
+		prototype.Source, prototype.Destination, prototype.Direction = rulex.Source, rulex.Destination, rulex.Direction
+	}
+	rule.prototype = prototype
+	return rule
+}
+
+func expandNetworkACLRulePrototype(rulex map[string]interface{}) *vpcv1.NetworkACLRulePrototype {
+	name := rulex[isNetworkACLRuleName].(string)
+	source := rulex[isNetworkACLRuleSource].(string)
+	destination := rulex[isNetworkACLRuleDestination].(string)
+	action := rulex[isNetworkACLRuleAction].(string)
+	direction := rulex[isNetworkACLRuleDirection].(string)
+	icmp := rulex[isNetworkACLRuleICMP].([]interface{})
+	tcp := rulex[isNetworkACLRuleTCP].([]interface{})
+	udp := rulex[isNetworkACLRuleUDP].([]interface{})
+	protocol := "all"
+
+	ruleTemplate := &vpcv1.NetworkACLRulePrototype{
+		Action:      &action,
+		Destination: &destination,
+		Direction:   &direction,
+		Source:      &source,
+		Name:        &name,
+	}
+
+	if len(icmp) > 0 {
+		protocol = "icmp"
+		if !isNil(icmp[0]) {
+			icmpval := icmp[0].(map[string]interface{})
+			if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
+				icmptype := int64(val.(int))
+				ruleTemplate.Type = &icmptype
+			}
+			if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
+				icmpcode := int64(val.(int))
+				ruleTemplate.Code = &icmpcode
+			}
+		}
+	} else if len(tcp) > 0 || len(udp) > 0 {
+		protocol = "tcp"
+		ports := tcp
+		if len(udp) > 0 {
+			protocol = "udp"
+			ports = udp
+		}
+		portval := ports[0].(map[string]interface{})
+		if val, ok := portval[isNetworkACLRulePortMin]; ok {
+			minport := int64(val.(int))
+			ruleTemplate.DestinationPortMin = &minport
+		}
+		if val, ok := portval[isNetworkACLRulePortMax]; ok {
+			maxport := int64(val.(int))
+			ruleTemplate.DestinationPortMax = &maxport
+		}
+		if val, ok := portval[isNetworkACLRuleSourcePortMin]; ok {
+			sourceminport := int64(val.(int))
+			ruleTemplate.SourcePortMin = &sourceminport
+		}
+		if val, ok := portval[isNetworkACLRuleSourcePortMax]; ok {
+			sourcemaxport := int64(val.(int))
+			ruleTemplate.SourcePortMax = &sourcemaxport
+		}
+	}
+	ruleTemplate.Protocol = &protocol
+	return ruleTemplate
 }
 
 func isNil(i interface{}) bool {
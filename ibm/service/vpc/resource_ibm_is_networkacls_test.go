@@ -6,10 +6,14 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fakecloud"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -185,3 +189,97 @@ func testAccCheckIBMISNetworkACLConfig1() string {
 	  }
 	`)
 }
+
+func TestIBMISNetworkACLRulesWithFakeCloud(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	meta := cloud.Meta(t)
+	r := vpc.ResourceIBMISNetworkACL()
+	rule := func(name, action, source string, protocol ...string) map[string]interface{} {
+		rule := map[string]interface{}{
+			"name":        name,
+			"action":      action,
+			"source":      source,
+			"destination": "0.0.0.0/0",
+			"direction":   "inbound",
+		}
+		for _, p := range protocol {
+			rule[p] = []interface{}{map[string]interface{}{}}
+		}
+		return rule
+	}
+	config := func(rules ...map[string]interface{}) map[string]interface{} {
+		list := make([]interface{}, len(rules))
+		for i, rule := range rules {
+			list[i] = rule
+		}
+		return map[string]interface{}{"name": "fake-acl", "vpc": "fake-vpc", "rules": list}
+	}
+	// rules returns the names and the IDs of the rules of the network ACL, in priority order
+	rules := func(id string) ([]string, map[string]string) {
+		var names []string
+		ids := map[string]string{}
+		for _, rule := range cloud.List("/v1/network_acls/" + id + "/rules") {
+			names = append(names, rule["name"].(string))
+			ids[rule["name"].(string)] = rule["id"].(string)
+		}
+		return names, ids
+	}
+
+	state := fakecloud.Apply(t, r, meta, nil, config(
+		rule("a", "allow", "10.0.0.0/8", "tcp"),
+		rule("b", "deny", "0.0.0.0/0"),
+		rule("c", "allow", "10.0.0.0/8", "icmp"),
+	))
+	names, ids := rules(state.ID)
+	if !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Fatalf("Unexpected rules %v", names)
+	}
+
+	requests := len(cloud.Requests())
+	state = fakecloud.Apply(t, r, meta, state, config(
+		rule("c", "allow", "10.0.0.0/8", "icmp"),
+		rule("a", "allow", "10.1.0.0/16", "tcp"),
+		rule("b", "deny", "0.0.0.0/0"),
+		rule("d", "deny", "0.0.0.0/0", "udp"),
+	))
+	names, newIDs := rules(state.ID)
+	if !reflect.DeepEqual(names, []string{"c", "a", "b", "d"}) {
+		t.Fatalf("Unexpected rules %v", names)
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		if newIDs[name] != ids[name] {
+			t.Fatalf("Expected the rule %s to be kept, got %s instead of %s", name, newIDs[name], ids[name])
+		}
+	}
+	var changes []string
+	for _, request := range cloud.Requests()[requests:] {
+		if !strings.HasPrefix(request, "GET ") {
+			changes = append(changes, request)
+		}
+	}
+	rulesPath := "/v1/network_acls/" + state.ID + "/rules"
+	// d is created at the end, a is updated and c is moved before a, b is not changed
+	if want := []string{"POST " + rulesPath, "PATCH " + rulesPath + "/" + ids["a"], "PATCH " + rulesPath + "/" + ids["c"]}; !reflect.DeepEqual(changes, want) {
+		t.Fatalf("Expected %v, got %v", want, changes)
+	}
+	if source := cloud.Object(rulesPath + "/" + ids["a"])["source"]; source != "10.1.0.0/16" {
+		t.Fatalf("Expected the rule a to be updated, got the source %v", source)
+	}
+	for i, name := range []string{"c", "a", "b", "d"} {
+		if got := state.Attributes[fmt.Sprintf("rules.%d.name", i)]; got != name {
+			t.Fatalf("Expected the rule %d of the state to be %s, got %s", i, name, got)
+		}
+	}
+
+	state = fakecloud.Apply(t, r, meta, state, config(
+		rule("c", "allow", "10.0.0.0/8", "icmp"),
+		rule("a", "allow", "10.1.0.0/16", "udp"),
+		rule("d", "deny", "0.0.0.0/0", "udp"),
+	))
+	if names, _ := rules(state.ID); !reflect.DeepEqual(names, []string{"c", "a", "d"}) {
+		t.Fatalf("Unexpected rules %v", names)
+	}
+	if cloud.Object(rulesPath+"/"+ids["a"]) != nil || cloud.Object(rulesPath+"/"+ids["b"]) != nil {
+		t.Fatalf("Expected the rule a to be replaced and the rule b to be deleted")
+	}
+}
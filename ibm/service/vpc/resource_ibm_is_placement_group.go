@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,13 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 			isPlacementGroupAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -193,7 +200,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupTags); ok || flex.HasDefaultTags(meta, isUserTagType) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -203,7 +210,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok || flex.HasDefaultTags(meta, isAccessTagType) {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
@@ -274,6 +281,7 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 	}
 
 	d.Set(isPlacementGroupTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isPlacementGroupAccessTags, accesstags)
 	return nil
 }
@@ -309,7 +317,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
+	if d.HasChange(isPlacementGroupTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
@@ -347,7 +355,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s", err))
 			}
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error deleting PLacementGroup").Operation("DeletePlacementGroupWithContext").Resource("ibm_is_placement_group", d.Id()).Diagnostics()
 		}
 	}
 	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
@@ -376,7 +384,7 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 				} else if response != nil && response.StatusCode == 409 {
 					return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("[ERROR] The  PLacementGroup %s failed to delete: %v", id, err)
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting PLacementGroup: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting PLacementGroup").Operation("GetPlacementGroup").Resource("ibm_is_placement_group", d.Id())
 			}
 			if *placementGroup.LifecycleState == isPlacementGroupFailed {
 				return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("[ERROR] The  PLacementGroup %s failed to delete: %v", id, err)
@@ -408,7 +416,7 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 				} else if response != nil && response.StatusCode == 404 {
 					return response, isPlacementGroupDeleteDone, nil
 				}
-				return response, "", fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response)
+				return response, "", flex.NewAPIError(err, response).Summary("Error deleting PLacementGroup").Operation("DeletePlacementGroup").Resource("ibm_is_placement_group", d.Id())
 			}
 			return response, isPlacementGroupDeleting, nil
 		},
@@ -442,7 +450,7 @@ func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.Re
 		}
 		placementGroup, response, err := vpcClient.GetPlacementGroup(getinsOptions)
 		if placementGroup == nil || err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting placementGroup : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting placementGroup").Operation("GetPlacementGroup").Resource("ibm_is_placement_group", d.Id())
 		}
 
 		d.Set("lifecycle_state", *placementGroup.LifecycleState)
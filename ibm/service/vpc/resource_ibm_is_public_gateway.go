@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -51,7 +50,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -107,6 +106,13 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -216,7 +222,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Public Gateway").Operation("CreatePublicGateway").Resource("ibm_is_public_gateway", d.Id())
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -226,8 +232,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
@@ -260,7 +265,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Public Gateway").Operation("GetPublicGateway").Resource("ibm_is_public_gateway", id)
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -286,7 +291,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Public Gateway").Operation("GetPublicGateway").Resource("ibm_is_public_gateway", d.Id())
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -306,6 +311,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
 	d.Set(isPublicGatewayTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -336,13 +342,13 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange(isPublicGatewayTags) || d.HasChange("tags_all") {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Public Gateway").Operation("GetPublicGateway").Resource("ibm_is_public_gateway", d.Id())
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -365,7 +371,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Public Gateway").Operation("UpdatePublicGateway").Resource("ibm_is_public_gateway", d.Id())
 		}
 	}
 	return resourceIBMISPublicGatewayRead(d, meta)
@@ -387,7 +393,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Public Gateway (%s)", id).Operation("GetPublicGateway").Resource("ibm_is_public_gateway", d.Id())
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -395,7 +401,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Public Gateway").Operation("DeletePublicGateway").Resource("ibm_is_public_gateway", d.Id())
 	}
 	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -431,7 +437,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("The Public Gateway %s failed to delete", id).Operation("GetPublicGateway").Resource("ibm_is_public_gateway", id)
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -451,7 +457,7 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Public Gateway: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Public Gateway").Operation("GetPublicGateway").Resource("ibm_is_public_gateway", d.Id())
 	}
 	return true, nil
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -40,7 +39,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -73,6 +72,13 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isSecurityGroupCRN: {
 				Type:        schema.TypeString,
@@ -176,11 +182,10 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Security Group").Operation("CreateSecurityGroup").Resource("ibm_is_security_group", d.Id())
 	}
 	d.SetId(*sg.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
@@ -207,7 +212,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Security Group").Operation("GetSecurityGroup").Resource("ibm_is_security_group", d.Id())
 	}
 	tags, err := flex.GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -215,6 +220,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error getting Security Group tags : %s\n%s", d.Id(), err)
 	}
 	d.Set(isSecurityGroupTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isSecurityGroupCRN, *group.CRN)
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
@@ -331,7 +337,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
+	if d.HasChange(isSecurityGroupTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string))
 		if err != nil {
@@ -361,7 +367,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Security Group").Operation("UpdateSecurityGroup").Resource("ibm_is_security_group", d.Id())
 		}
 	}
 	return resourceIBMISSecurityGroupRead(d, meta)
@@ -383,7 +389,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group (%s)", id).Operation("GetSecurityGroup").Resource("ibm_is_security_group", d.Id())
 	}
 
 	start := ""
@@ -394,7 +400,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Security Group Targets").Operation("ListSecurityGroupTargets").Resource("ibm_is_security_group", d.Id())
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -428,7 +434,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 							}
 						}
 					} else {
-						return fmt.Errorf("[ERROR] Error deleting security group target binding while deleting security group : %s\n%s", err, response)
+						return flex.NewAPIError(err, response).Summary("Error deleting security group target binding while deleting security group").Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_security_group", d.Id())
 					}
 				}
 
@@ -453,7 +459,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 				}
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Deleting Security Group").Operation("DeleteSecurityGroup").Resource("ibm_is_security_group", d.Id())
 		}
 	}
 	d.SetId("")
@@ -475,7 +481,7 @@ func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Security Group").Operation("GetSecurityGroup").Resource("ibm_is_security_group", d.Id())
 	}
 	return true, nil
 }
@@ -551,7 +557,7 @@ func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv
 		}
 		sgTarget, response, err := client.GetSecurityGroupTarget(targetgetoptions)
 		if err != nil {
-			return target, "", fmt.Errorf("[ERROR] Error getting target(%s): %s\n%s", targetId, err, response)
+			return target, "", flex.NewAPIError(err, response).Summary("Error getting target(%s)", targetId).Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group", "")
 		}
 		if response != nil && response.StatusCode == 404 {
 			return target, "done", nil
@@ -583,7 +589,7 @@ func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGr
 
 			sggroups, response, err := client.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 			if err != nil || sggroups == nil {
-				return groups, "", fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+				return groups, "", flex.NewAPIError(err, response).Summary("Error Getting Security Group Targets").Operation("ListSecurityGroupTargets").Resource("ibm_is_security_group", sgId)
 			}
 			if *sggroups.TotalCount == int64(0) {
 				return groups, "done", nil
@@ -168,7 +168,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.Resour
 	}
 	_, response, err := sess.CreateSecurityGroupTargetBinding(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating SecurityGroup NetworkInterface Binding").Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
 	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d, meta)
@@ -197,7 +197,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting target(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting target(%s) for the SecurityGroup (%s)", nicID, sgID).Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	instance_id := strings.Split(*secGroupTarget.(*vpcv1.SecurityGroupTargetReference).Href, "/")[5]
 	net_interf_id := *secGroupTarget.(*vpcv1.SecurityGroupTargetReference).ID
@@ -207,7 +207,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 	}
 	instanceNic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s %s\n%s", instance_id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting network interfaces attached to the instance %s", instance_id).Operation("GetInstanceNetworkInterface").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -248,7 +248,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.Resource
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group").Operation("GetSecurityGroup").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
 	return nil
@@ -277,7 +277,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID).Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
@@ -286,7 +286,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 	}
 	response, err = sess.DeleteSecurityGroupTargetBinding(removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID).Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -315,7 +315,7 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists(d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID).Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_network_interface_attachment", d.Id())
 	}
 	return true, nil
 }
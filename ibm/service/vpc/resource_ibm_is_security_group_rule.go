@@ -238,8 +238,10 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isSecurityGroupRuleKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isSecurityGroupRuleKey)
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
@@ -248,7 +250,7 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Security Group Rule").Operation("CreateSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -296,7 +298,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group Rule (%s)", ruleID).Operation("GetSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	d.Set(isSecurityGroupID, secgrpID)
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
@@ -304,7 +306,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group").Operation("GetSecurityGroup").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -415,13 +417,15 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isSecurityGroupRuleKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isSecurityGroupRuleKey)
 
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Updating Security Group Rule").Operation("UpdateSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	return resourceIBMISSecurityGroupRuleRead(d, meta)
 }
@@ -437,8 +441,10 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isSecurityGroupRuleKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isSecurityGroupRuleKey)
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
@@ -451,7 +457,7 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group Rule (%s)", ruleID).Operation("GetSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
@@ -460,7 +466,7 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Security Group Rule").Operation("DeleteSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -485,7 +491,7 @@ func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Security Group Rule (%s)", ruleID).Operation("GetSecurityGroupRule").Resource("ibm_is_security_group_rule", d.Id())
 	}
 	return true, nil
 }
@@ -599,7 +605,7 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 				if res != nil && res.StatusCode == 404 {
 					return nil, nil, nil, err
 				}
-				return nil, nil, nil, fmt.Errorf("Error getting Security Group in remote (%s): %s\n%s", parsed.remoteSecGrpID, err, res)
+				return nil, nil, nil, flex.NewAPIError(err, res).Summary("Error getting Security Group in remote (%s)", parsed.remoteSecGrpID).Operation("GetSecurityGroup").Resource("ibm_is_security_group_rule", d.Id())
 			}
 		}
 		sgTemplate.Remote = remoteTemplate
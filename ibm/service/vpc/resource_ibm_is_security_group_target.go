@@ -113,7 +113,7 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 
 	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Security Group Target Binding").Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_security_group_target", d.Id())
 	}
 	sgtarget := sg.(*vpcv1.SecurityGroupTargetReference)
 	d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *sgtarget.ID))
@@ -157,7 +157,7 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Security Group Target").Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_target", d.Id())
 	}
 
 	target := data.(*vpcv1.SecurityGroupTargetReference)
@@ -193,13 +193,13 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Targets (%s): %s\n%s", securityGroupID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Security Group Targets (%s)", securityGroupID).Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_target", d.Id())
 	}
 
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
 	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Security Group Targets").Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_security_group_target", d.Id())
 	}
 	securityGroupTargetReference := sgt.(*vpcv1.SecurityGroupTargetReference)
 	crn := securityGroupTargetReference.CRN
@@ -239,7 +239,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Security Group Target").Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_target", d.Id())
 	}
 	return true, nil
 
@@ -277,7 +277,7 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 				}
 				lb, response, err := sess.GetLoadBalancer(getlboptions)
 				if err != nil {
-					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+					return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_security_group_target", securityGroupTargetID)
 				}
 
 				if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -286,7 +286,7 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 					return sgt, isLBProvisioning, nil
 				}
 			}
-			return nil, isLBProvisioningDone, fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response)
+			return nil, isLBProvisioningDone, flex.NewAPIError(err, response).Summary("Error getting Security Group Target").Operation("GetSecurityGroupTarget").Resource("ibm_is_security_group_target", securityGroupTargetID)
 		}
 		return sgt, isLBProvisioning, nil
 	}
@@ -315,7 +315,7 @@ func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefre
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Load Balancer").Operation("GetLoadBalancer").Resource("ibm_is_security_group_target", "")
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -65,7 +63,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -292,12 +290,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 				userTagStr := userTag.(string)
 				userTagsArray[i] = userTagStr
 			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
+			userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 			snapshotprototypeoptions.UserTags = userTagsArray
 		}
 	}
@@ -306,7 +299,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	options.SnapshotPrototype = snapshotprototypeoptions
 	snapshot, response, err := sess.CreateSnapshot(options)
 	if err != nil || snapshot == nil {
-		return fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error creating Snapshot").Operation("CreateSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 
 	d.SetId(*snapshot.ID)
@@ -318,7 +311,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, ok := d.GetOk(isSnapshotAccessTags); ok {
+	if _, ok := d.GetOk(isSnapshotAccessTags); ok || flex.HasDefaultTags(meta, isAccessTagType) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
@@ -351,7 +344,7 @@ func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, flex.NewAPIError(err, response).Summary("Error getting Snapshot").Operation("GetSnapshot").Resource("ibm_is_snapshot", id)
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable {
@@ -387,7 +380,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Snapshot").Operation("GetSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 
 	d.SetId(*snapshot.ID)
@@ -475,7 +468,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Snapshot : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Snapshot").Operation("GetSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 	eTag := response.Headers.Get("ETag")
 
@@ -496,12 +489,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 					userTagStr := userTag.(string)
 					userTagsArray[i] = userTagStr
 				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
+				userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 				snapshotPatchModel := &vpcv1.SnapshotPatch{}
 				snapshotPatchModel.UserTags = userTagsArray
 				snapshotPatch, err := snapshotPatchModel.AsPatch()
@@ -511,7 +499,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 				updateSnapshotOptions.SnapshotPatch = snapshotPatch
 				_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
 				if err != nil {
-					return fmt.Errorf("Error updating Snapshot : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error updating Snapshot").Operation("UpdateSnapshot").Resource("ibm_is_snapshot", d.Id())
 				}
 				_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
@@ -535,7 +523,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
 		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating Snapshot").Operation("UpdateSnapshot").Resource("ibm_is_snapshot", d.Id())
 		}
 		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -575,7 +563,7 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, flex.NewAPIError(err, response).Summary("Error getting Snapshot").Operation("GetSnapshot").Resource("ibm_is_snapshot", id)
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable || *snapshot.LifecycleState == isSnapshotFailed {
@@ -613,7 +601,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Snapshot (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Snapshot (%s)", id).Operation("GetSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
@@ -621,7 +609,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting Snapshot").Operation("DeleteSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -657,7 +645,7 @@ func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
 			}
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] The Snapshot %s failed to delete: %s\n%s", id, err, response)
+			return nil, isSnapshotFailed, flex.NewAPIError(err, response).Summary("The Snapshot %s failed to delete", id).Operation("GetSnapshot").Resource("ibm_is_snapshot", id)
 		}
 		return snapshot, *snapshot.LifecycleState, nil
 	}
@@ -682,7 +670,7 @@ func snapshotExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Snapshot: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Snapshot").Operation("GetSnapshot").Resource("ibm_is_snapshot", d.Id())
 	}
 	return true, nil
 }
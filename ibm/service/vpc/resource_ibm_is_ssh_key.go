@@ -9,7 +9,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -42,7 +41,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -88,6 +87,13 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -189,13 +195,12 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create SSH Key").Operation("CreateKey").Resource("ibm_is_ssh_key", d.Id())
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if _, ok := d.GetOk(isKeyTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
@@ -231,7 +236,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting SSH Key (%s)", id).Operation("GetKey").Resource("ibm_is_ssh_key", d.Id())
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -244,6 +249,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isKeyTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -282,13 +288,13 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange(isKeyTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting SSH Key").Operation("GetKey").Resource("ibm_is_ssh_key", d.Id())
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
@@ -311,7 +317,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc SSH Key: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating vpc SSH Key").Operation("UpdateKey").Resource("ibm_is_ssh_key", d.Id())
 		}
 	}
 	return nil
@@ -341,7 +347,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting SSH Key (%s)", id).Operation("GetKey").Resource("ibm_is_ssh_key", d.Id())
 	}
 
 	options := &vpcv1.DeleteKeyOptions{
@@ -349,7 +355,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting SSH Key : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting SSH Key").Operation("DeleteKey").Resource("ibm_is_ssh_key", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -375,7 +381,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting SSH Key: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting SSH Key").Operation("GetKey").Resource("ibm_is_ssh_key", d.Id())
 	}
 	return true, nil
 }
@@ -6,12 +6,16 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fakecloud"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -139,3 +143,117 @@ func testAccCheckIBMISKeyNewlineConfig(name, name1 string) string {
 		}
 	`, name, name1)
 }
+
+func TestIBMISSSHKeyWithFakeCloud(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	meta := cloud.Meta(t)
+	r := vpc.ResourceIBMISSSHKey()
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7"
+
+	state := fakecloud.Apply(t, r, meta, nil, map[string]interface{}{
+		"name":       "fake-key",
+		"public_key": publicKey,
+		"tags":       []interface{}{"env:test"},
+	})
+	key := cloud.Object("/v1/keys/" + state.ID)
+	if key == nil {
+		t.Fatalf("SSH Key %s not created", state.ID)
+	}
+	if state.Attributes["fingerprint"] != "SHA256:fake" || state.Attributes["crn"] != key["crn"] {
+		t.Fatalf("Unexpected state: %v", state.Attributes)
+	}
+	if tags := cloud.Tags(key["crn"].(string), "user"); len(tags) != 1 || tags[0] != "env:test" {
+		t.Fatalf("Unexpected tags: %v", tags)
+	}
+
+	state = fakecloud.Apply(t, r, meta, state, map[string]interface{}{
+		"name":       "renamed-key",
+		"public_key": publicKey,
+		"tags":       []interface{}{"env:prod"},
+	})
+	if name := cloud.Object("/v1/keys/" + state.ID)["name"]; name != "renamed-key" {
+		t.Fatalf("Expected the SSH Key to be renamed, got %v", name)
+	}
+	if tags := cloud.Tags(key["crn"].(string), "user"); len(tags) != 1 || tags[0] != "env:prod" {
+		t.Fatalf("Unexpected tags: %v", tags)
+	}
+	if state = fakecloud.Refresh(t, r, meta, state); state.Attributes["name"] != "renamed-key" {
+		t.Fatalf("Unexpected state: %v", state.Attributes)
+	}
+
+	fakecloud.Apply(t, r, meta, state, nil)
+	if cloud.Object("/v1/keys/"+state.ID) != nil {
+		t.Fatalf("SSH Key %s not deleted", state.ID)
+	}
+	if state = fakecloud.Refresh(t, r, meta, state); state != nil {
+		t.Fatalf("Expected the SSH Key to be gone, got %v", state.Attributes)
+	}
+}
+
+func TestIBMISSSHKeyDefaultTagsWithFakeCloud(t *testing.T) {
+	cloud := fakecloud.NewServer(t)
+	config := cloud.Config(t)
+	config.Tags = conns.TagsConfig{DefaultTags: []string{"team:core"}, IgnoreTagKeys: []string{"owner"}}
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring the provider: %s", err)
+	}
+	meta := sess.(conns.ClientSession)
+	r := vpc.ResourceIBMISSSHKey()
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7"
+	sshKey := func(tags ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": "fake-key", "public_key": publicKey, "tags": tags}
+	}
+	cloudTags := func(crn string) string {
+		tags := cloud.Tags(crn, "user")
+		sort.Strings(tags)
+		return strings.Join(tags, ",")
+	}
+
+	diff, err := fakecloud.Plan(t, r, meta, nil, sshKey("env:test"))
+	if err != nil {
+		t.Fatalf("Error planning the SSH Key: %s", err)
+	}
+	if tagsAll := diff.Attributes["tags_all.#"]; tagsAll == nil || tagsAll.New != "2" {
+		t.Fatalf("Expected tags_all to hold the tags and the default tags, got %v", tagsAll)
+	}
+	state := fakecloud.Apply(t, r, meta, nil, sshKey("env:test"))
+	crn := state.Attributes["crn"]
+	if tags := cloudTags(crn); tags != "env:test,team:core" {
+		t.Fatalf("Expected the tags and the default tags to be attached, got %v", tags)
+	}
+
+	// a tag applied outside of Terraform
+	gtClient, err := meta.GlobalTaggingAPIv1()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = gtClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+		Resources: []globaltaggingv1.Resource{{ResourceID: &crn}},
+		TagNames:  []string{"owner:alice"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state = fakecloud.Refresh(t, r, meta, state)
+	if state.Attributes["tags.#"] != "2" || state.Attributes["tags_all.#"] != "2" {
+		t.Fatalf("Expected the ignored tags to be left out of the state, got %v", state.Attributes)
+	}
+	diff, err = fakecloud.Plan(t, r, meta, state, sshKey("env:test"))
+	if err != nil {
+		t.Fatalf("Error planning the SSH Key: %s", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected the diff of the default tags to be suppressed, got %v", diff.Attributes)
+	}
+
+	fakecloud.Apply(t, r, meta, state, sshKey("env:prod"))
+	if tags := cloudTags(crn); tags != "env:prod,owner:alice,team:core" {
+		t.Fatalf("Expected the default and ignored tags to be kept, got %v", tags)
+	}
+	for _, request := range cloud.Requests() {
+		if strings.HasPrefix(request, "DELETE /v3/tags/") && request != "DELETE /v3/tags/env:test" {
+			t.Fatalf("Expected only the removed tag to be detached and deleted, got %s", request)
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -64,7 +63,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -118,6 +117,13 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isSubnetAccessTags: {
 				Type:        schema.TypeSet,
@@ -284,9 +290,17 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	if ipv4cidr != "" && ipv4addrcount != 0 {
 		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
 	}
+	// The address prefixes of the VPC are not changed while its subnets are created
+	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpc
+	if err := conns.Locks.RLockTimeout(d.Timeout(schema.TimeoutCreate), isVPCAddressPrefixKey); err != nil {
+		return err
+	}
+	defer conns.Locks.RUnlock(isVPCAddressPrefixKey)
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	conns.IbmMutexKV.Lock(isSubnetKey)
-	defer conns.IbmMutexKV.Unlock(isSubnetKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isSubnetKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isSubnetKey)
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -364,7 +378,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("[ERROR] Error while creating Subnet %s\n%v", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating Subnet").Operation("CreateSubnet").Resource("ibm_is_subnet", d.Id())
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
@@ -372,8 +386,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
+	if _, ok := d.GetOk(isSubnetTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
@@ -382,7 +395,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		}
 	}
 
-	if _, ok := d.GetOk(isSubnetAccessTags); ok {
+	if _, ok := d.GetOk(isSubnetAccessTags); ok || flex.HasDefaultTags(meta, isAccessTagType) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
 		if err != nil {
@@ -416,7 +429,7 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting Subnet").Operation("GetSubnet").Resource("ibm_is_subnet", id)
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -452,7 +465,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Subnet (%s)", id).Operation("GetSubnet").Resource("ibm_is_subnet", d.Id())
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -494,6 +507,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 
 	d.Set(isSubnetTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isSubnetAccessTags, accesstags)
 	d.Set(isSubnetCRN, *subnet.CRN)
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/subnets")
@@ -510,7 +524,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
+	if d.HasChange(isSubnetTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -566,7 +580,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Detaching the public gateway attached to the subnet").Operation("UnsetSubnetPublicGateway").Resource("ibm_is_subnet", d.Id())
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -581,7 +595,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError(err, response).Summary("Error Attaching public gateway to the subnet").Operation("SetSubnetPublicGateway").Resource("ibm_is_subnet", d.Id())
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -617,7 +631,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating Subnet").Operation("UpdateSubnet").Resource("ibm_is_subnet", d.Id())
 		}
 	}
 	return nil
@@ -648,7 +662,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Subnet (%s)", id).Operation("GetSubnet").Resource("ibm_is_subnet", d.Id())
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
@@ -675,7 +689,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Deleting Subnet").Operation("DeleteSubnet").Resource("ibm_is_subnet", d.Id())
 		}
 	}
 	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
@@ -703,7 +717,7 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 				} else if response != nil && response.StatusCode == 404 {
 					return response, isSubnetDeleted, nil
 				}
-				return response, "", fmt.Errorf("[ERROR] Error deleting subnet: %s\n%s", err, response)
+				return response, "", flex.NewAPIError(err, response).Summary("Error deleting subnet").Operation("DeleteSubnet").Resource("ibm_is_subnet", id)
 			}
 			return response, isSubnetDeleting, nil
 		},
@@ -743,7 +757,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
 				return subnet, isSubnetDeleting, nil
 			}
-			return subnet, "", fmt.Errorf("[ERROR] The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", flex.NewAPIError(err, response).Summary("The Subnet %s failed to delete", id).Operation("GetSubnet").Resource("ibm_is_subnet", id)
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -768,7 +782,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Subnet: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Subnet").Operation("GetSubnet").Resource("ibm_is_subnet", d.Id())
 	}
 	return true, nil
 }
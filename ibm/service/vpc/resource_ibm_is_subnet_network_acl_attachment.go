@@ -4,13 +4,14 @@
 package vpc
 
 import (
-	"fmt"
 	"log"
 	"reflect"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -215,7 +216,7 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-		return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while attaching a network ACL to a subnet").Operation("ReplaceSubnetNetworkACL").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Network ACL : %s", *resultACL.ID)
@@ -241,7 +242,7 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting subnet's (%s) attached network ACL", id).Operation("GetSubnetNetworkACL").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
@@ -356,7 +357,7 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error while attaching a network ACL to a subnet").Operation("ReplaceSubnetNetworkACL").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 		}
 		log.Printf("[INFO] Updated subnet %s with Network ACL : %s", subnet, *resultACL.ID)
 
@@ -383,7 +384,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Subnet (%s)", id).Operation("GetSubnet").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -397,7 +398,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPC").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 	}
 
 	// Fetch default network ACL
@@ -415,7 +416,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error while attaching a network ACL to a subnet").Operation("ReplaceSubnetNetworkACL").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Network ACL : %s", id, *resultACL.ID)
 	} else {
@@ -440,7 +441,7 @@ func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting subnet's attached network ACL: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting subnet's attached network ACL").Operation("GetSubnetNetworkACL").Resource("ibm_is_subnet_network_acl_attachment", d.Id())
 	}
 	return true, nil
 }
@@ -168,7 +168,7 @@ func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	rip, response, err := sess.CreateSubnetReservedIP(options)
 	if err != nil || response == nil || rip == nil {
-		return fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error creating the reserved IP").Operation("CreateSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 	}
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
@@ -285,7 +285,7 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 
 		_, response, err := sess.UpdateSubnetReservedIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the reserved IP %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating the reserved IP").Operation("UpdateSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 		}
 	}
 	return resourceIBMISReservedIPRead(d, meta)
@@ -315,7 +315,7 @@ func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) err
 	deleteOptions := sess.NewDeleteSubnetReservedIPOptions(subnetID, reservedIPID)
 	response, err := sess.DeleteSubnetReservedIP(deleteOptions)
 	if err != nil || response == nil {
-		return fmt.Errorf("[ERROR] Error deleting the reserverd ip %s in subnet %s, %s\n%s", reservedIPID, subnetID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting the reserverd ip %s in subnet %s", reservedIPID, subnetID).Operation("DeleteSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -348,7 +348,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 			d.SetId("")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error Getting Reserved IP : %s\n%s", err, response)
+		return nil, flex.NewAPIError(err, response).Summary("Error Getting Reserved IP").Operation("GetSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 	}
 	return rip, nil
 }
@@ -374,7 +374,7 @@ func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.Re
 		}
 		rsip, response, err := sess.GetSubnetReservedIP(getreservedipOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetid, id, err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting reserved ip(%s/%s)", subnetid, id).Operation("GetSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 		}
 		if rsip.LifecycleState != nil {
 			d.Set(isReservedIPLifecycleState, *rsip.LifecycleState)
@@ -382,7 +382,7 @@ func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.Re
 		d.Set(isReservedIPAddress, *rsip.Address)
 
 		if rsip.LifecycleState != nil && *rsip.LifecycleState == "failed" {
-			return rsip, "failed", fmt.Errorf("[ERROR] Error Reserved ip(%s/%s) creation failed : %s\n%s", subnetid, id, err, response)
+			return rsip, "failed", flex.NewAPIError(err, response).Summary("Error Reserved ip(%s/%s) creation failed", subnetid, id).Operation("GetSubnetReservedIP").Resource("ibm_is_subnet_reserved_ip", d.Id())
 		}
 		if rsip.LifecycleState != nil && *rsip.LifecycleState == "stable" {
 			return rsip, "done", nil
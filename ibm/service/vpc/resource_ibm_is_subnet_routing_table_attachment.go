@@ -5,13 +5,14 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func ResourceIBMISSubnetRoutingTableAttachment() *schema.Resource {
@@ -144,7 +145,7 @@ func resourceIBMISSubnetRoutingTableAttachmentCreate(context context.Context, d
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error while attaching a routing table to a subnet").Operation("ReplaceSubnetRoutingTableWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Routing Table : %s", *resultRT.ID)
@@ -170,7 +171,7 @@ func resourceIBMISSubnetRoutingTableAttachmentRead(context context.Context, d *s
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting subnet's (%s) attached routing table: %s\n%s", id, err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting subnet's (%s) attached routing table", id).Operation("GetSubnetRoutingTableWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 	}
 	d.Set(isRoutingTableName, *subRT.Name)
 	d.Set(isSubnetID, id)
@@ -225,7 +226,7 @@ func resourceIBMISSubnetRoutingTableAttachmentUpdate(context context.Context, d
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error while attaching a routing table to a subnet").Operation("ReplaceSubnetRoutingTableWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 		}
 		log.Printf("[INFO] Updated subnet %s with Routing Table : %s", subnet, *resultRT.ID)
 
@@ -252,7 +253,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response))
+		return flex.NewAPIError(err, response).Summary("Error Getting Subnet (%s)", id).Operation("GetSubnetWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -266,7 +267,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPCWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 	}
 
 	// Fetch default routing table
@@ -284,7 +285,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Summary("Error while attaching a routing table to a subnet").Operation("ReplaceSubnetRoutingTableWithContext").Resource("ibm_is_subnet_routing_table_attachment", d.Id()).Diagnostics()
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Routing Table : %s", id, *resultRT.ID)
 	} else {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -55,7 +54,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -202,6 +201,13 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for VPE",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 		},
 	}
 }
@@ -303,12 +309,11 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	result, response, err := sess.CreateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Create Endpoint Gateway failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create Endpoint Gateway failed").Operation("CreateEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 	}
 
 	d.SetId(*result.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
@@ -336,7 +341,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		_, response, err := sess.UpdateEndpointGateway(opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
-			return fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Update Endpoint Gateway failed").Operation("UpdateEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 		}
 
 	}
@@ -355,7 +360,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error while creating Security Group Target Binding").Operation("CreateSecurityGroupTargetBinding").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 				}
 				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -374,12 +379,12 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("Error Getting Security Group Target for this endpoint gateway (%s): %s\n%s", sgId, err, response)
+					return flex.NewAPIError(err, response).Summary("Error Getting Security Group Target for this endpoint gateway (%s)", sgId).Operation("GetSecurityGroupTarget").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(sgId, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error Deleting Security Group Target for this endpoint gateway").Operation("DeleteSecurityGroupTargetBinding").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 				}
 				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -389,11 +394,11 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 
 	}
-	if d.HasChange(isVirtualEndpointGatewayTags) {
+	if d.HasChange(isVirtualEndpointGatewayTags) || d.HasChange("tags_all") {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting VPE").Operation("GetEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 		}
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
@@ -419,7 +424,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 			return nil
 		}
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Get Endpoint Gateway failed %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Get Endpoint Gateway failed").Operation("GetEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 	}
 	d.Set(isVirtualEndpointGatewayName, result.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, result.HealthState)
@@ -441,6 +446,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 			"Error on get of VPE (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVirtualEndpointGatewayTags, tags)
+	d.Set("tags_all", tags)
 	return nil
 }
 
@@ -477,7 +483,7 @@ func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId st
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
-				return nil, "", fmt.Errorf("Error Getting Virtual Endpoint Gateway : %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Virtual Endpoint Gateway").Operation("GetEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", endPointGatewayId)
 			}
 		}
 		if *result.LifecycleState == "stable" || *result.LifecycleState == "failed" {
@@ -497,7 +503,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta inte
 	response, err := sess.DeleteEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Delete Endpoint Gateway failed : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Delete Endpoint Gateway failed").Operation("DeleteEndpointGateway").Resource("ibm_is_virtual_endpoint_gateway", d.Id())
 	}
 	return nil
 }
@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -64,10 +62,12 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
+				validate.InvokeRules("ibm_is_volume"),
+				validate.InvokeCloudData("ibm_is_volume"),
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeValidate(diff)
 				}),
@@ -94,10 +94,11 @@ func ResourceIBMISVolume() *schema.Resource {
 			},
 
 			isVolumeZone: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Zone name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_volume", isVolumeZone),
+				Description:  "Zone name",
 			},
 
 			isVolumeEncryptionKey: {
@@ -263,10 +264,19 @@ func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isVolumeProfileName,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
 			Type:                       validate.TypeString,
-			Optional:                   true,
+			Required:                   true,
 			AllowedValues:              "general-purpose, 5iops-tier, 10iops-tier, custom",
+			CloudDataType:              validate.CloudDataVolumeProfile,
+		})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVolumeZone,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              validate.CloudDataZone,
 		})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -292,10 +302,62 @@ func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISVolumeResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_volume", Schema: validateSchema}
+	ibmISVolumeResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_volume", Schema: validateSchema,
+		Rules: volumeProfileRules(isVolumeProfileName, isVolumeCapacity, isVolumeIops)}
 	return &ibmISVolumeResourceValidator
 }
 
+// volumeProfileRules returns the rules on the capacity allowed by the tiered profiles and on the
+// iops allowed by the custom profile for a capacity, given the names of the attributes. A volume
+// changed from or to the custom profile is replaced.
+func volumeProfileRules(profile, capacity, iops string) []validate.ValidateRule {
+	rules := []validate.ValidateRule{
+		{
+			Type:          validate.ForceNewWhenChanged,
+			Identifier:    profile,
+			AllowedValues: "custom",
+		},
+		{
+			Type:       validate.IntBetweenWhen,
+			Identifier: capacity,
+			MinValue:   "10",
+			MaxValue:   "9600",
+			When:       []validate.RuleCondition{{Identifier: profile, AllowedValues: "5iops-tier"}},
+		},
+		{
+			Type:       validate.IntBetweenWhen,
+			Identifier: capacity,
+			MinValue:   "10",
+			MaxValue:   "4800",
+			When:       []validate.RuleCondition{{Identifier: profile, AllowedValues: "10iops-tier"}},
+		},
+	}
+	for _, r := range []struct{ minCapacity, maxCapacity, minIops, maxIops string }{
+		{"10", "39", "100", "1000"},
+		{"40", "79", "100", "2000"},
+		{"80", "99", "100", "4000"},
+		{"100", "499", "100", "6000"},
+		{"500", "999", "100", "10000"},
+		{"1000", "1999", "100", "20000"},
+		{"2000", "3999", "200", "40000"},
+		{"4000", "7999", "300", "40000"},
+		{"8000", "9999", "500", "48000"},
+		{"10000", "16000", "1000", "48000"},
+	} {
+		rules = append(rules, validate.ValidateRule{
+			Type:       validate.IntBetweenWhen,
+			Identifier: iops,
+			MinValue:   r.minIops,
+			MaxValue:   r.maxIops,
+			When: []validate.RuleCondition{
+				{Identifier: profile, AllowedValues: "custom"},
+				{Identifier: capacity, MinValue: r.minCapacity, MaxValue: r.maxCapacity},
+			},
+		})
+	}
+	return rules
+}
+
 func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 
 	volName := d.Get(isVolumeName).(string)
@@ -363,19 +425,14 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 				userTagStr := userTag.(string)
 				userTagsArray[i] = userTagStr
 			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
+			userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 			volTemplate.UserTags = userTagsArray
 		}
 	}
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create volume err").Operation("CreateVolume").Resource("ibm_is_volume", d.Id())
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
@@ -384,7 +441,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		return err
 	}
 
-	if _, ok := d.GetOk(isVolumeAccessTags); ok {
+	if _, ok := d.GetOk(isVolumeAccessTags); ok || flex.HasDefaultTags(meta, isVolumeAccessTagType) {
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
@@ -419,7 +476,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -520,7 +577,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Volume").Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 		}
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 
@@ -540,7 +597,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 	}
 	eTag := response.Headers.Get("ETag")
 	options := &vpcv1.UpdateVolumeOptions{
@@ -573,7 +630,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		vol, response, err := sess.GetVolume(getvoloptions)
 		if err != nil || vol == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Volume (%s) details", volId).Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) < 1 {
 			return fmt.Errorf("[ERROR] Error updating Volume profile/iops because the specified volume %s is not attached to a virtual server instance ", volId)
@@ -585,7 +642,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the volume (%s) is attached : %s\n%s", insId, volId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Instance (%s) to which the volume (%s) is attached", insId, volId).Operation("GetInstance").Resource("ibm_is_volume", d.Id())
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -595,7 +652,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error starting Instance (%s) to which the volume (%s) is attached", insId, volId).Operation("CreateInstanceAction").Resource("ibm_is_volume", d.Id())
 			}
 			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -640,7 +697,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).Summary("Error Getting Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].ID == "" {
 			return fmt.Errorf("[ERROR] Error volume capacity can't be updated since volume %s is not attached to any instance for VolumePatch", id)
@@ -651,7 +708,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", *insId, err, response)
+			return flex.NewAPIError(err, response).Summary("Error retrieving Instance (%s)", *insId).Operation("GetInstance").Resource("ibm_is_volume", d.Id())
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -661,7 +718,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
+				return flex.NewAPIError(err, response).Summary("Error starting Instance (%s)", *insId).Operation("CreateInstanceAction").Resource("ibm_is_volume", d.Id())
 			}
 			_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -679,7 +736,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		options.VolumePatch = volumeCapacityPatch
 		_, response, err = sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc volume: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating vpc volume").Operation("UpdateVolume").Resource("ibm_is_volume", d.Id())
 		}
 		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -698,12 +755,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 					userTagStr := userTag.(string)
 					userTagsArray[i] = userTagStr
 				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
+				userTagsArray = append(userTagsArray, flex.DefaultTags(meta, "user")...)
 				volumeNamePatchModel := &vpcv1.VolumePatch{}
 				volumeNamePatchModel.UserTags = userTagsArray
 				volumeNamePatch, err := volumeNamePatchModel.AsPatch()
@@ -714,7 +766,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				options.VolumePatch = volumeNamePatch
 				_, response, err := sess.UpdateVolume(options)
 				if err != nil {
-					return fmt.Errorf("Error updating volume : %s\n%s", err, response)
+					return flex.NewAPIError(err, response).Summary("Error updating volume").Operation("UpdateVolume").Resource("ibm_is_volume", d.Id())
 				}
 				_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
@@ -751,7 +803,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting Volume (%s)", id).Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 	}
 
 	if volDetails.VolumeAttachments != nil {
@@ -777,7 +829,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Volume : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting Volume").Operation("DeleteVolume").Resource("ibm_is_volume", d.Id())
 	}
 	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -812,7 +864,7 @@ func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefres
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("[ERROR] Error getting Volume: %s\n%s", err, response)
+			return vol, "", flex.NewAPIError(err, response).Summary("Error getting Volume").Operation("GetVolume").Resource("ibm_is_volume", id)
 		}
 		return vol, isVolumeDeleting, err
 	}
@@ -839,7 +891,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Volume: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Volume").Operation("GetVolume").Resource("ibm_is_volume", d.Id())
 	}
 	return true, nil
 }
@@ -866,7 +918,7 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		vol, response, err := client.GetVolume(volgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting volume: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error getting volume").Operation("GetVolume").Resource("ibm_is_volume", id)
 		}
 
 		if *vol.Status == "available" {
@@ -882,7 +934,7 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots.SourceVolumeID = &id
 	response, err := sess.DeleteSnapshots(delete_all_snapshots)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting snapshots from volume %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error deleting snapshots from volume").Operation("DeleteSnapshots").Resource("ibm_is_volume", id)
 	}
 	return nil
 }
@@ -11,6 +11,8 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fakecloud"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -507,3 +509,73 @@ func testAccCheckIBMISVolumeUsertagConfig(name, usertag string) string {
 `, name, usertag)
 
 }
+
+func TestIBMISVolumeProfileRules(t *testing.T) {
+	meta := fakecloud.NewServer(t).Meta(t)
+	r := vpc.ResourceIBMISVolume()
+	cases := []struct {
+		profile  string
+		capacity int
+		iops     int
+		err      string
+	}{
+		{"custom", 50, 1500, ""},
+		{"custom", 50, 3000, `ibm_is_volume: "iops" must be between 100 and 2000 when "profile" is one of [custom] and "capacity" is between 40 and 79, got 3000`},
+		{"custom", 2000, 100, `ibm_is_volume: "iops" must be between 200 and 40000 when "profile" is one of [custom] and "capacity" is between 2000 and 3999, got 100`},
+		{"custom", 16000, 48000, ""},
+		{"5iops-tier", 9600, 0, ""},
+		{"10iops-tier", 5000, 0, `ibm_is_volume: "capacity" must be between 10 and 4800 when "profile" is one of [10iops-tier], got 5000`},
+	}
+	for _, c := range cases {
+		config := map[string]interface{}{
+			"name":     "fake-volume",
+			"profile":  c.profile,
+			"zone":     "us-south-1",
+			"capacity": c.capacity,
+		}
+		if c.iops != 0 {
+			config["iops"] = c.iops
+		}
+		_, err := fakecloud.Plan(t, r, meta, nil, config)
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%v: unexpected error: %s", config, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), "* "+c.err+"\n")):
+			t.Errorf("%v: expected the error %q, got %v", config, c.err, err)
+		}
+	}
+
+	state := &terraform.InstanceState{ID: "r006-fake-volume", Attributes: map[string]string{
+		"id":       "r006-fake-volume",
+		"name":     "fake-volume",
+		"profile":  "custom",
+		"zone":     "us-south-1",
+		"capacity": "100",
+		"iops":     "1000",
+	}}
+	for profile, replaced := range map[string]bool{"custom": false, "10iops-tier": true} {
+		diff, err := fakecloud.Plan(t, r, meta, state, map[string]interface{}{
+			"name":     "fake-volume",
+			"profile":  profile,
+			"zone":     "us-south-1",
+			"capacity": 200,
+		})
+		if err != nil {
+			t.Fatalf("Error planning the volume: %s", err)
+		}
+		if diff.RequiresNew() != replaced {
+			t.Errorf("Expected the volume changed from the custom profile to %s to be replaced: %t, got %t", profile, replaced, diff.RequiresNew())
+		}
+	}
+}
+
+func TestIBMISVolumeProfileValidate(t *testing.T) {
+	// the profiles are checked without a provider session, such as by terraform validate
+	validateFunc := vpc.ResourceIBMISVolume().Schema["profile"].ValidateFunc
+	if _, errs := validateFunc("10iops-tier", "profile"); len(errs) != 0 {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+	if _, errs := validateFunc("10iop-tier", "profile"); len(errs) != 1 {
+		t.Errorf("Expected the misspelled profile to be rejected, got %v", errs)
+	}
+}
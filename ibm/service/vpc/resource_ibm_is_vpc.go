@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -76,7 +75,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -182,6 +181,13 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			isVPCCRN: {
 				Type:        schema.TypeString,
@@ -480,12 +486,12 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
+		return flex.NewAPIError(err, response).Summary("Error while creating VPC").Operation("CreateVPC").Resource("ibm_is_vpc", d.Id())
 	}
 	d.SetId(*vpc.ID)
 
 	if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-		sgNameUpdate(sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
+		sgNameUpdate(sess, *vpc.ID, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
 	}
 
 	if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
@@ -493,7 +499,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	}
 
 	if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-		nwaclNameUpdate(sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
+		nwaclNameUpdate(sess, *vpc.ID, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
@@ -501,8 +507,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPCTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
@@ -535,7 +540,7 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPC").Resource("ibm_is_vpc", id)
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -569,7 +574,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPC").Resource("ibm_is_vpc", d.Id())
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -601,6 +606,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPCTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -637,7 +643,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Fetching subnets").Operation("ListSubnets").Resource("ibm_is_vpc", d.Id())
 		}
 		start = flex.GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -805,13 +811,13 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPCTags) {
+	if d.HasChange(isVPCTags) || d.HasChange("tags_all") {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPC").Resource("ibm_is_vpc", d.Id())
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
@@ -823,7 +829,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 
 	if d.HasChange(isVPCDefaultSecurityGroupName) {
 		if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-			sgNameUpdate(sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
+			sgNameUpdate(sess, id, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultRoutingTableName) {
@@ -833,7 +839,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	}
 	if d.HasChange(isVPCDefaultNetworkACLName) {
 		if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-			nwaclNameUpdate(sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
+			nwaclNameUpdate(sess, id, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
 		}
 	}
 
@@ -851,7 +857,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating VPC").Operation("UpdateVPC").Resource("ibm_is_vpc", d.Id())
 		}
 	}
 	return nil
@@ -882,7 +888,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC (%s)", id).Operation("GetVPC").Resource("ibm_is_vpc", d.Id())
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -890,7 +896,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting VPC").Operation("DeleteVPC").Resource("ibm_is_vpc", d.Id())
 	}
 	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -926,7 +932,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response).Summary("The VPC %s failed to delete", id).Operation("GetVPC").Resource("ibm_is_vpc", id)
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -952,7 +958,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting VPC").Operation("GetVPC").Resource("ibm_is_vpc", d.Id())
 	}
 	return true, nil
 }
@@ -964,7 +970,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 // 	return conns.String(buf.String())
 // }
 
-func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func nwaclNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateNetworkACLOptions := &vpcv1.UpdateNetworkACLOptions{
 		ID: &id,
 	}
@@ -978,12 +984,12 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Updating Network ACL(%s) name", id).Operation("UpdateNetworkACL").Resource("ibm_is_vpc", vpcID)
 	}
 	return nil
 }
 
-func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func sgNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateSecurityGroupOptions := &vpcv1.UpdateSecurityGroupOptions{
 		ID: &id,
 	}
@@ -997,7 +1003,7 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Updating Security Group name").Operation("UpdateSecurityGroup").Resource("ibm_is_vpc", vpcID)
 	}
 	return nil
 }
@@ -1015,7 +1021,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Updating Routing table name").Operation("UpdateVPCRoutingTable").Resource("ibm_is_vpc", vpcID)
 	}
 	return nil
 }
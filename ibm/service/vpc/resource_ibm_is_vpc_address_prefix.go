@@ -132,8 +132,10 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutCreate), isVPCAddressPrefixKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isVPCAddressPrefixKey)
 
 	err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 	if err != nil {
@@ -158,7 +160,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC Address Prefix %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating VPC Address Prefix").Operation("CreateVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -198,7 +200,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Address Prefix (%s)", addrPrefixID).Operation("GetVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixDefault, *addrPrefix.IsDefault)
@@ -214,7 +216,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC").Operation("GetVPC").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *vpc.CRN)
 
@@ -236,8 +238,10 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutUpdate), isVPCAddressPrefixKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isVPCAddressPrefixKey)
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -280,7 +284,7 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating VPC Address Prefix").Operation("UpdateVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 		}
 	}
 	return nil
@@ -296,8 +300,10 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.Locks.LockTimeout(d.Timeout(schema.TimeoutDelete), isVPCAddressPrefixKey); err != nil {
+		return err
+	}
+	defer conns.Locks.Unlock(isVPCAddressPrefixKey)
 
 	error := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 	if error != nil {
@@ -323,7 +329,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Address Prefix (%s)", addrPrefixID).Operation("GetVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 
 	deletevpcAddressPrefixOptions := &vpcv1.DeleteVPCAddressPrefixOptions{
@@ -335,7 +341,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting VPC Address Prefix (%s)", addrPrefixID).Operation("DeleteVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 	d.SetId("")
 	return nil
@@ -371,7 +377,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting VPC Address Prefix").Operation("GetVPCAddressPrefix").Resource("ibm_is_vpc_address_prefix", d.Id())
 	}
 	return true, nil
 }
@@ -154,7 +154,7 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 	}
 	route, response, err := sess.CreateVPCRoute(createRouteOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC Route err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error while creating VPC Route err").Operation("CreateVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 	}
 	routeID := *route.ID
 
@@ -179,7 +179,7 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				return route, "", fmt.Errorf("[ERROR] Error Getting VPC Route: %s\n%s", err, response)
+				return route, "", flex.NewAPIError(err, response).Summary("Error Getting VPC Route").Operation("GetVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 			}
 
 			if *route.LifecycleState == "stable" || *route.LifecycleState == "failed" {
@@ -226,7 +226,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Route (%s)", routeID).Operation("GetVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 	}
 	d.Set(isVPCRouteVPCID, vpcID)
 	d.Set(isVPCRouteName, route.Name)
@@ -242,7 +242,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC").Operation("GetVPC").Resource("ibm_is_vpc_route", d.Id())
 	}
 	d.Set(flex.RelatedCRN, *vpc.CRN)
 
@@ -294,7 +294,7 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 		updateVpcRouteOptions.RoutePatch = routePatch
 		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC Route: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error Updating VPC Route").Operation("UpdateVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 		}
 	}
 	return nil
@@ -331,7 +331,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Route (%s)", routeID).Operation("GetVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 	}
 	deleteRouteOptions := &vpcv1.DeleteVPCRouteOptions{
 		VPCID: &vpcID,
@@ -339,7 +339,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	}
 	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC Route: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting VPC Route").Operation("DeleteVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 	}
 	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -365,7 +365,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
 				}
-				return route, isRouteStatusDeleting, fmt.Errorf("[ERROR] The VPC route %s failed to delete: %s\n%s", routeID, err, response)
+				return route, isRouteStatusDeleting, flex.NewAPIError(err, response).Summary("The VPC route %s failed to delete", routeID).Operation("GetVPCRoute").Resource("ibm_is_vpc_route", routeID)
 			}
 			return route, isRouteStatusDeleting, nil
 		},
@@ -405,7 +405,7 @@ func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC Route: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting VPC Route").Operation("GetVPCRoute").Resource("ibm_is_vpc_route", d.Id())
 	}
 	return true, nil
 }
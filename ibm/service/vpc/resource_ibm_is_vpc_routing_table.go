@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -247,7 +248,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Routing table").Operation("GetVPCRoutingTable").Resource("ibm_is_vpc_routing_table", d.Id())
 	}
 
 	d.Set(rtVpcID, idSet[0])
@@ -393,7 +394,7 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting VPC Routing table : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting VPC Routing table").Operation("GetVPCRoutingTable").Resource("ibm_is_vpc_routing_table", d.Id())
 	}
 	return true, nil
 }
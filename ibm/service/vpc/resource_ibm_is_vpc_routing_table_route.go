@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -260,7 +261,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Routing table route: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPC Routing table route").Operation("GetVPCRoutingTableRoute").Resource("ibm_is_vpc_routing_table_route", d.Id())
 	}
 
 	d.Set(rID, *route.ID)
@@ -365,7 +366,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting VPC Routing table route : %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error Getting VPC Routing table route").Operation("GetVPCRoutingTableRoute").Resource("ibm_is_vpc_routing_table_route", d.Id())
 	}
 	return true, nil
 }
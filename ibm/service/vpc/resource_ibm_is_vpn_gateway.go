@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -54,7 +53,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -121,6 +120,13 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "VPN Gateway tags list",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The tags of the resource along with the default tags of the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -324,7 +330,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create vpc VPN Gateway").Operation("CreateVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -336,8 +342,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPNGatewayTags); ok || flex.HasDefaultTags(meta, "user") {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
@@ -370,7 +375,7 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway").Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", id)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -406,7 +411,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway (%s)", id).Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -436,6 +441,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPNGatewayTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -502,13 +508,13 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange(isVPNGatewayTags) || d.HasChange("tags_all") {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error getting Volume").Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -533,7 +539,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating vpc Vpn Gateway").Operation("UpdateVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 		}
 	}
 	return nil
@@ -564,7 +570,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway (%s)", id).Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 	}
 
 	options := &vpcv1.DeleteVPNGatewayOptions{
@@ -572,7 +578,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Vpn Gateway").Operation("DeleteVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 	}
 	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -607,7 +613,7 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway").Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", id)
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
@@ -633,7 +639,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Vpn Gatewa").Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway", d.Id())
 	}
 	return true, nil
 }
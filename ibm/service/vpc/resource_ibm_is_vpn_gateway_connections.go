@@ -337,7 +337,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Create VPN Gateway Connection err").Operation("CreateVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -377,7 +377,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway Connection (%s)", gConnID).Operation("GetVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
@@ -439,7 +439,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting VPN Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting VPN Gateway").Operation("GetVPNGateway").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 	vpngateway := vpngatewayIntf.(*vpcv1.VPNGateway)
 	d.Set(flex.RelatedCRN, *vpngateway.CRN)
@@ -550,7 +550,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).Summary("Error updating Vpn Gateway Connection").Operation("UpdateVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 		}
 	}
 	return nil
@@ -589,7 +589,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return flex.NewAPIError(err, response).Summary("Error Getting Vpn Gateway Connection(%s)", gConnID).Operation("GetVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 
 	deleteVpnGatewayConnectionOptions := &vpcv1.DeleteVPNGatewayConnectionOptions{
@@ -598,7 +598,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).Summary("Error Deleting Vpn Gateway Connection").Operation("DeleteVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 
 	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
@@ -636,7 +636,7 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", flex.NewAPIError(err, response).Summary("The Vpn Gateway Connection %s failed to delete", gConnID).Operation("GetVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", gConnID)
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
@@ -673,7 +673,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).Summary("Error getting Vpn Gateway Connection").Operation("GetVPNGatewayConnection").Resource("ibm_is_vpn_gateway_connections", d.Id())
 	}
 	return true, nil
 }
@@ -477,7 +477,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 	vpnServer, response, err := sess.CreateVPNServerWithContext(context, createVPNServerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id()).Diagnostics()
 	}
 
 	d.SetId(*vpnServer.ID)
@@ -504,7 +504,7 @@ func isWaitForVPNServerStable(context context.Context, sess *vpcv1.VpcV1, d *sch
 			vpnServer, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 			if err != nil {
 				log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-				return vpnServer, "", fmt.Errorf("Error Getting VPC Server: %s\n%s", err, response)
+				return vpnServer, "", flex.NewAPIError(err, response).Summary("Error Getting VPC Server").Operation("GetVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id())
 			}
 
 			if *vpnServer.LifecycleState == "stable" || *vpnServer.LifecycleState == "failed" {
@@ -537,7 +537,7 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("vpn_server", d.Id()); err != nil {
@@ -835,7 +835,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id()).Diagnostics()
 	}
 	eTag := response.Headers.Get("ETag") // Getting Etag from the response headers.
 
@@ -859,7 +859,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := sess.UpdateVPNServerWithContext(context, updateVPNServerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id()).Diagnostics()
 		}
 		_, err = isWaitForVPNServerStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -884,7 +884,7 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 	response, err := sess.DeleteVPNServerWithContext(context, deleteVPNServerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id()).Diagnostics()
 	}
 
 	_, err = isWaitForVPNServerDeleted(context, sess, d)
@@ -911,7 +911,7 @@ func isWaitForVPNServerDeleted(context context.Context, sess *vpcv1.VpcV1, d *sc
 				if response != nil && response.StatusCode == 404 {
 					return vpnServer, isVPNServerStatusDeleted, nil
 				}
-				return vpnServer, isVPNServerStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", d.Id(), err, response)
+				return vpnServer, isVPNServerStatusDeleting, flex.NewAPIError(err, response).Summary("The VPN server %s failed to delete", d.Id()).Operation("GetVPNServerWithContext").Resource("ibm_is_vpn_server", d.Id())
 			}
 			return vpnServer, isVPNServerStatusDeleting, nil
 
@@ -153,7 +153,7 @@ func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.Resour
 	vpnServerRoute, response, err := sess.CreateVPNServerRouteWithContext(context, createVPNServerRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("CreateVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id()).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createVPNServerRouteOptions.VPNServerID, *vpnServerRoute.ID))
@@ -186,7 +186,7 @@ func isWaitForVPNServerRouteStable(context context.Context, sess *vpcv1.VpcV1, d
 			vpnServerRoute, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
 			if err != nil {
 				log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-				return vpnServerRoute, "", fmt.Errorf("Error Getting VPC Server Route: %s\n%s", err, response)
+				return vpnServerRoute, "", flex.NewAPIError(err, response).Summary("Error Getting VPC Server Route").Operation("GetVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id())
 			}
 
 			if *vpnServerRoute.LifecycleState == "stable" || *vpnServerRoute.LifecycleState == "failed" {
@@ -224,7 +224,7 @@ func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("GetVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id()).Diagnostics()
 	}
 
 	if err = d.Set("vpn_server", getVPNServerRouteOptions.VPNServerID); err != nil {
@@ -289,7 +289,7 @@ func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.Resour
 		_, response, err := sess.UpdateVPNServerRouteWithContext(context, updateVPNServerRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVPNServerRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerRouteWithContext failed %s\n%s", err, response))
+			return flex.NewAPIError(err, response).Operation("UpdateVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id()).Diagnostics()
 		}
 		_, err = isWaitForVPNServerRouteStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -319,7 +319,7 @@ func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.Resour
 	response, err := sess.DeleteVPNServerRouteWithContext(context, deleteVPNServerRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewAPIError(err, response).Operation("DeleteVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id()).Diagnostics()
 	}
 	_, err = isWaitForVPNServerRouteDeleted(context, sess, d)
 	if err != nil {
@@ -353,7 +353,7 @@ func isWaitForVPNServerRouteDeleted(context context.Context, sess *vpcv1.VpcV1,
 				if response != nil && response.StatusCode == 404 {
 					return vpnServerRoute, isVPNServerRouteStatusDeleted, nil
 				}
-				return vpnServerRoute, isVPNServerRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", d.Id(), err, response)
+				return vpnServerRoute, isVPNServerRouteStatusDeleting, flex.NewAPIError(err, response).Summary("The VPN server route %s failed to delete", d.Id()).Operation("GetVPNServerRouteWithContext").Resource("ibm_is_vpn_server_route", d.Id())
 			}
 			return vpnServerRoute, isVPNServerRouteStatusDeleting, nil
 
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CloudDataType values which InvokeCloudData checks against live data.
+// The remaining CloudDataType values found in the validator dictionary
+// (resource_instance, cluster, iam ...) only describe the attribute.
+const (
+	CloudDataRegion          = "region"
+	CloudDataZone            = "zone"
+	CloudDataInstanceProfile = "instance_profile"
+	CloudDataVolumeProfile   = "volume_profile"
+	CloudDataResourcePlan    = "resource_plan"
+)
+
+const globalCatalogPageLimit = int64(200)
+
+type cloudDataLookupFunc func(sess conns.ClientSession, cloudDataRange []string) ([]string, error)
+
+var cloudDataLookups = map[string]cloudDataLookupFunc{
+	CloudDataRegion:          lookupRegions,
+	CloudDataZone:            lookupZones,
+	CloudDataInstanceProfile: lookupInstanceProfiles,
+	CloudDataVolumeProfile:   lookupVolumeProfiles,
+	CloudDataResourcePlan:    lookupResourcePlans,
+}
+
+// InvokeCloudData returns the CustomizeDiffFunc checking the ValidateCloudData attributes of the
+// resource against the cloud data of the provider planning it, such as the zones of its region.
+// The checks need the session of the provider, so they are skipped by terraform validate, which
+// only runs the AllowedValues of the attributes, and when the cloud data cannot be looked up. Only
+// the attributes set or changed by the plan are checked.
+func InvokeCloudData(resourceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
+		if resourceItem == nil {
+			return nil
+		}
+		return ValidateCloudDataDiff(resourceName, resourceItem.Schema, diff, meta)
+	}
+}
+
+// cloudDataDiff is the part of *schema.ResourceDiff read by ValidateCloudDataDiff.
+type cloudDataDiff interface {
+	Get(key string) interface{}
+	HasChange(key string) bool
+	NewValueKnown(key string) bool
+}
+
+// ValidateCloudDataDiff checks the ValidateCloudData attributes of validateSchemas set or changed
+// in diff against the cloud data looked up with meta, and returns the error of the first invalid one.
+func ValidateCloudDataDiff(resourceName string, validateSchemas []ValidateSchema, diff cloudDataDiff, meta interface{}) error {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	for _, validateSchema := range validateSchemas {
+		k := validateSchema.Identifier
+		if validateSchema.ValidateFunctionIdentifier != ValidateCloudData || !diff.NewValueKnown(k) || !diff.HasChange(k) {
+			continue
+		}
+		if err := validateCloudData(sess, validateSchema, diff.Get(k), k); err != nil {
+			return fmt.Errorf("%s: %s", resourceName, err)
+		}
+	}
+	return nil
+}
+
+// validateCloudData checks v against the cloud data of validateSchema. The check is skipped when
+// the cloud data type is not looked up, or the lookup fails or finds nothing.
+func validateCloudData(sess conns.ClientSession, validateSchema ValidateSchema, v interface{}, k string) error {
+	lookup, ok := cloudDataLookups[validateSchema.CloudDataType]
+	value, _ := v.(string)
+	if !ok || value == "" {
+		return nil
+	}
+	key := validateSchema.CloudDataType + "|" + strings.Join(validateSchema.CloudDataRange, ",")
+	values, err := sess.CloudDataCache().Get(key, func() ([]string, error) {
+		values, err := lookup(sess, validateSchema.CloudDataRange)
+		sort.Strings(values)
+		return values, err
+	})
+	if err != nil {
+		log.Printf("[WARN] Skipping %s validation of %q: %s", validateSchema.CloudDataType, k, err)
+		return nil
+	}
+	if len(values) != 0 && !stringInSlice(value, values) {
+		return fmt.Errorf("%q must contain a valid %s, got %q. Available values are %s",
+			k, strings.Replace(validateSchema.CloudDataType, "_", " ", -1), value, strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// cloudDataRangeValue returns the value of the "key:value" entry of a CloudDataRange.
+func cloudDataRangeValue(cloudDataRange []string, key string) string {
+	for _, r := range cloudDataRange {
+		if strings.HasPrefix(r, key+":") {
+			return strings.TrimPrefix(r, key+":")
+		}
+	}
+	return ""
+}
+
+func listGlobalCatalogEntries(sess conns.ClientSession, query string) ([]globalcatalogv1.CatalogEntry, error) {
+	globalCatalogClient, err := sess.GlobalCatalogV1API()
+	if err != nil {
+		return nil, err
+	}
+	entries := []globalcatalogv1.CatalogEntry{}
+	offset := int64(0)
+	for {
+		listCatalogEntriesOptions := &globalcatalogv1.ListCatalogEntriesOptions{
+			Q:      core.StringPtr(query),
+			Offset: core.Int64Ptr(offset),
+			Limit:  core.Int64Ptr(globalCatalogPageLimit),
+		}
+		result, response, err := globalCatalogClient.ListCatalogEntries(listCatalogEntriesOptions)
+		if err != nil {
+			return nil, flex.NewAPIError(err, response).Summary("Error listing global catalog entries for %q", query).Operation("ListCatalogEntries")
+		}
+		entries = append(entries, result.Resources...)
+		offset += int64(len(result.Resources))
+		if len(result.Resources) == 0 || result.Count == nil || offset >= *result.Count {
+			break
+		}
+	}
+	return entries, nil
+}
+
+func lookupRegions(sess conns.ClientSession, cloudDataRange []string) ([]string, error) {
+	regions := []string{"global"}
+	for _, kind := range []string{"region", "dc"} {
+		entries, err := listGlobalCatalogEntries(sess, "kind:"+kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.Name != nil {
+				regions = append(regions, *entry.Name)
+			}
+		}
+	}
+	return regions, nil
+}
+
+func lookupResourcePlans(sess conns.ClientSession, cloudDataRange []string) ([]string, error) {
+	serviceName := cloudDataRangeValue(cloudDataRange, "service")
+	if serviceName == "" {
+		return nil, fmt.Errorf("[ERROR] resource_plan cloud data requires a service range")
+	}
+	globalCatalogClient, err := sess.GlobalCatalogV1API()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := listGlobalCatalogEntries(sess, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	var serviceID string
+	for _, entry := range entries {
+		if entry.Name != nil && *entry.Name == serviceName && entry.ID != nil {
+			serviceID = *entry.ID
+			break
+		}
+	}
+	if serviceID == "" {
+		return nil, fmt.Errorf("[ERROR] Service %q not found in the global catalog", serviceName)
+	}
+
+	plans := []string{}
+	offset := int64(0)
+	for {
+		getChildObjectsOptions := globalCatalogClient.NewGetChildObjectsOptions(serviceID, "plan")
+		getChildObjectsOptions.Offset = core.Int64Ptr(offset)
+		getChildObjectsOptions.Limit = core.Int64Ptr(globalCatalogPageLimit)
+		result, response, err := globalCatalogClient.GetChildObjects(getChildObjectsOptions)
+		if err != nil {
+			return nil, flex.NewAPIError(err, response).Summary("Error listing plans of service %q", serviceName).Operation("GetChildObjects")
+		}
+		for _, plan := range result.Resources {
+			if plan.Name != nil {
+				plans = append(plans, *plan.Name)
+			}
+		}
+		offset += int64(len(result.Resources))
+		if len(result.Resources) == 0 || result.Count == nil || offset >= *result.Count {
+			break
+		}
+	}
+	return plans, nil
+}
+
+func lookupZones(sess conns.ClientSession, cloudDataRange []string) ([]string, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	bxSession, err := sess.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bxSession.Config.Region
+	listRegionZonesOptions := &vpcv1.ListRegionZonesOptions{
+		RegionName: &region,
+	}
+	availableZones, response, err := vpcClient.ListRegionZones(listRegionZonesOptions)
+	if err != nil {
+		return nil, flex.NewAPIError(err, response).Summary("Error fetching zones of region %s", region).Operation("ListRegionZones")
+	}
+	zones := []string{}
+	for _, zone := range availableZones.Zones {
+		zones = append(zones, *zone.Name)
+	}
+	return zones, nil
+}
+
+func lookupInstanceProfiles(sess conns.ClientSession, cloudDataRange []string) ([]string, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	availableProfiles, response, err := vpcClient.ListInstanceProfiles(&vpcv1.ListInstanceProfilesOptions{})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response).Summary("Error fetching instance profiles").Operation("ListInstanceProfiles")
+	}
+	profiles := []string{}
+	for _, profile := range availableProfiles.Profiles {
+		profiles = append(profiles, *profile.Name)
+	}
+	return profiles, nil
+}
+
+func lookupVolumeProfiles(sess conns.ClientSession, cloudDataRange []string) ([]string, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	start := ""
+	profiles := []string{}
+	for {
+		listVolumeProfilesOptions := &vpcv1.ListVolumeProfilesOptions{}
+		if start != "" {
+			listVolumeProfilesOptions.Start = &start
+		}
+		availableProfiles, response, err := vpcClient.ListVolumeProfiles(listVolumeProfilesOptions)
+		if err != nil {
+			return nil, flex.NewAPIError(err, response).Summary("Error fetching volume profiles").Operation("ListVolumeProfiles")
+		}
+		for _, profile := range availableProfiles.Profiles {
+			profiles = append(profiles, *profile.Name)
+		}
+		start = flex.GetNext(availableProfiles.Next)
+		if start == "" {
+			break
+		}
+	}
+	return profiles, nil
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fakecloud"
+)
+
+// cloudDataServer serves the VPC and Global Catalog APIs read by the cloud data lookups. The
+// volume profiles and the plans are served one per page, to walk the pagination.
+type cloudDataServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests map[string]int
+	// failing are the paths answered with an error
+	failing map[string]bool
+}
+
+var (
+	testCatalogEntries = map[string][]map[string]interface{}{
+		"kind:region":          {{"id": "us-south", "name": "us-south"}, {"id": "eu-de", "name": "eu-de"}},
+		"kind:dc":              {{"id": "dal10", "name": "dal10"}},
+		"cloud-object-storage": {{"id": "cos-backup", "name": "cloud-object-storage-backup"}, {"id": "cos", "name": "cloud-object-storage"}},
+	}
+	testCatalogPlans = map[string][]map[string]interface{}{
+		"cos": {{"id": "cos-lite", "name": "lite"}, {"id": "cos-standard", "name": "standard"}},
+	}
+	testVolumeProfiles = []string{"general-purpose", "5iops-tier", "10iops-tier", "custom"}
+)
+
+func newCloudDataServer(t *testing.T) *cloudDataServer {
+	s := &cloudDataServer{requests: map[string]int{}, failing: map[string]bool{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *cloudDataServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.requests[r.URL.Path]++
+	failing := s.failing[r.URL.Path]
+	s.lock.Unlock()
+	if failing {
+		writeTestJSON(w, http.StatusForbidden, map[string]interface{}{"errors": []interface{}{map[string]interface{}{"code": "forbidden", "message": "Forbidden"}}})
+		return
+	}
+	query := r.URL.Query()
+	switch path := r.URL.Path; {
+	case path == "/v1/regions/us-south/zones":
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"zones": []interface{}{
+			map[string]interface{}{"name": "us-south-1"}, map[string]interface{}{"name": "us-south-2"},
+		}})
+	case path == "/v1/instance/profiles":
+		writeTestJSON(w, http.StatusOK, map[string]interface{}{"profiles": []interface{}{
+			map[string]interface{}{"name": "bx2-2x8"}, map[string]interface{}{"name": "cx2-2x4"},
+		}})
+	case path == "/v1/volume/profiles":
+		start, _ := strconv.Atoi(query.Get("start"))
+		page := map[string]interface{}{
+			"profiles":    []interface{}{map[string]interface{}{"name": testVolumeProfiles[start]}},
+			"total_count": len(testVolumeProfiles),
+		}
+		if start+1 < len(testVolumeProfiles) {
+			page["next"] = map[string]interface{}{"href": s.URL + path + "?start=" + strconv.Itoa(start+1)}
+		}
+		writeTestJSON(w, http.StatusOK, page)
+	case path == "/api/v1/":
+		writeTestJSON(w, http.StatusOK, catalogPage(testCatalogEntries[query.Get("q")], query.Get("_offset")))
+	case strings.HasPrefix(path, "/api/v1/") && strings.HasSuffix(path, "/plan"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/api/v1/"), "/plan")
+		writeTestJSON(w, http.StatusOK, catalogPage(testCatalogPlans[id], query.Get("_offset")))
+	default:
+		writeTestJSON(w, http.StatusNotFound, nil)
+	}
+}
+
+// catalogPage returns the entry at offset, as a page of the Global Catalog.
+func catalogPage(entries []map[string]interface{}, offset string) map[string]interface{} {
+	i, _ := strconv.Atoi(offset)
+	resources := []interface{}{}
+	if i < len(entries) {
+		resources = append(resources, entries[i])
+	}
+	return map[string]interface{}{"offset": i, "limit": 1, "count": len(entries), "resource_count": len(resources), "resources": resources}
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *cloudDataServer) fail(path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failing[path] = true
+}
+
+func (s *cloudDataServer) requestCount(path string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests[path]
+}
+
+// session returns a client session authenticated by fakecloud, with the VPC and Global Catalog
+// APIs served by s.
+func (s *cloudDataServer) session(t *testing.T) conns.ClientSession {
+	config := fakecloud.NewServer(t).Config(t)
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", s.URL+"/v1")
+	t.Setenv("IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT", s.URL+"/api/v1")
+	sess, err := config.ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring the provider: %s", err)
+	}
+	return sess.(conns.ClientSession)
+}
+
+// testCloudDataDiff is a plan setting the attributes of the map, the unknown ones to nil.
+type testCloudDataDiff map[string]interface{}
+
+func (d testCloudDataDiff) Get(key string) interface{} { return d[key] }
+
+func (d testCloudDataDiff) HasChange(key string) bool {
+	_, ok := d[key]
+	return ok
+}
+
+func (d testCloudDataDiff) NewValueKnown(key string) bool { return d[key] != nil }
+
+func validateTestCloudData(meta interface{}, cloudDataType string, cloudDataRange []string, value string) error {
+	validateSchemas := []ValidateSchema{{
+		Identifier:                 "attribute",
+		ValidateFunctionIdentifier: ValidateCloudData,
+		CloudDataType:              cloudDataType,
+		CloudDataRange:             cloudDataRange,
+	}}
+	return ValidateCloudDataDiff("ibm_test", validateSchemas, testCloudDataDiff{"attribute": value}, meta)
+}
+
+func TestValidateCloudData(t *testing.T) {
+	server := newCloudDataServer(t)
+	sess := server.session(t)
+	cosPlans := []string{"service:cloud-object-storage"}
+	cases := []struct {
+		cloudDataType  string
+		cloudDataRange []string
+		value          string
+		err            string
+	}{
+		{CloudDataRegion, nil, "eu-de", ""},
+		{CloudDataRegion, nil, "dal10", ""},
+		{CloudDataRegion, nil, "global", ""},
+		{CloudDataRegion, nil, "mars", `ibm_test: "attribute" must contain a valid region, got "mars". Available values are dal10, eu-de, global, us-south`},
+		{CloudDataZone, nil, "us-south-2", ""},
+		{CloudDataZone, nil, "eu-de-1", `ibm_test: "attribute" must contain a valid zone, got "eu-de-1". Available values are us-south-1, us-south-2`},
+		{CloudDataInstanceProfile, nil, "bx2-2x8", ""},
+		{CloudDataInstanceProfile, nil, "bx2-2x4", "must contain a valid instance profile"},
+		{CloudDataVolumeProfile, nil, "custom", ""},
+		{CloudDataVolumeProfile, nil, "3iops-tier", "Available values are 10iops-tier, 5iops-tier, custom, general-purpose"},
+		{CloudDataResourcePlan, cosPlans, "standard", ""},
+		{CloudDataResourcePlan, cosPlans, "gold", `ibm_test: "attribute" must contain a valid resource plan, got "gold". Available values are lite, standard`},
+		{CloudDataZone, nil, "", ""},
+		{"cluster", nil, "anything", ""},
+	}
+	for _, c := range cases {
+		err := validateTestCloudData(sess, c.cloudDataType, c.cloudDataRange, c.value)
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s %q: unexpected error: %s", c.cloudDataType, c.value, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s %q: expected the error %q, got %v", c.cloudDataType, c.value, c.err, err)
+		}
+	}
+	if n := server.requestCount("/v1/volume/profiles"); n != len(testVolumeProfiles) {
+		t.Errorf("Expected %d pages of volume profiles, got %d", len(testVolumeProfiles), n)
+	}
+	if n := server.requestCount("/api/v1/cos/plan"); n != len(testCatalogPlans["cos"]) {
+		t.Errorf("Expected %d pages of plans, got %d", len(testCatalogPlans["cos"]), n)
+	}
+	if n := server.requestCount("/api/v1/cos-backup/plan"); n != 0 {
+		t.Errorf("Expected the plans of the service with the exact name only, got %d requests for another service", n)
+	}
+}
+
+func TestValidateCloudDataCache(t *testing.T) {
+	server := newCloudDataServer(t)
+	sess := server.session(t)
+	for _, value := range []string{"us-south-1", "us-south-2", "us-south-3"} {
+		validateTestCloudData(sess, CloudDataZone, nil, value)
+	}
+	if n := server.requestCount("/v1/regions/us-south/zones"); n != 1 {
+		t.Fatalf("Expected the zones to be looked up once, got %d lookups", n)
+	}
+
+	// the lookup failures are cached too
+	server.fail("/v1/instance/profiles")
+	validateTestCloudData(sess, CloudDataInstanceProfile, nil, "bx2-2x8")
+	validateTestCloudData(sess, CloudDataInstanceProfile, nil, "bx2-2x8")
+	if n := server.requestCount("/v1/instance/profiles"); n != 1 {
+		t.Fatalf("Expected the instance profiles to be looked up once, got %d lookups", n)
+	}
+
+	// every provider, such as an aliased provider of another region, has its own cloud data
+	validateTestCloudData(server.session(t), CloudDataZone, nil, "us-south-1")
+	if n := server.requestCount("/v1/regions/us-south/zones"); n != 2 {
+		t.Fatalf("Expected the zones to be looked up again for another session, got %d lookups", n)
+	}
+}
+
+func TestValidateCloudDataDiff(t *testing.T) {
+	server := newCloudDataServer(t)
+	sess := server.session(t)
+	validateSchemas := []ValidateSchema{
+		{Identifier: "zone", ValidateFunctionIdentifier: ValidateCloudData, CloudDataType: CloudDataZone},
+		{Identifier: "profile", ValidateFunctionIdentifier: ValidateCloudData, CloudDataType: CloudDataInstanceProfile},
+	}
+	// the attributes unknown or not changed by the plan are not checked
+	diff := testCloudDataDiff{"zone": nil}
+	if err := ValidateCloudDataDiff("ibm_test", validateSchemas, diff, sess); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if n := server.requestCount("/v1/regions/us-south/zones") + server.requestCount("/v1/instance/profiles"); n != 0 {
+		t.Fatalf("Expected no lookups, got %d", n)
+	}
+	diff = testCloudDataDiff{"zone": "us-south-1", "profile": "bx2-2x4"}
+	if err := ValidateCloudDataDiff("ibm_test", validateSchemas, diff, sess); err == nil || !strings.HasPrefix(err.Error(), `ibm_test: "profile" must contain a valid instance profile`) {
+		t.Fatalf("Expected the invalid profile error, got %v", err)
+	}
+}
+
+func TestValidateCloudDataWithoutSession(t *testing.T) {
+	for _, cloudDataType := range []string{CloudDataRegion, CloudDataZone, CloudDataInstanceProfile, CloudDataVolumeProfile, CloudDataResourcePlan} {
+		if err := validateTestCloudData(nil, cloudDataType, nil, "anything"); err != nil {
+			t.Errorf("Expected the %s validation to be skipped without a session, got %s", cloudDataType, err)
+		}
+	}
+
+	// the AllowedValues are checked without a session, such as by terraform validate
+	validateSchema := ValidateSchema{
+		Identifier:                 "profile",
+		ValidateFunctionIdentifier: ValidateCloudData,
+		Type:                       TypeString,
+		AllowedValues:              "general-purpose, custom",
+		CloudDataType:              CloudDataVolumeProfile,
+	}
+	validateFunc := invokeValidatorInternal(validateSchema)
+	if _, errs := validateFunc("custom", "profile"); len(errs) != 0 {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+	if _, errs := validateFunc("3iops-tier", "profile"); len(errs) != 1 {
+		t.Errorf("Expected an error for a value not allowed, got %v", errs)
+	}
+	validateSchema.AllowedValues = ""
+	if invokeValidatorInternal(validateSchema) != nil {
+		t.Error("Expected no validation without a session and AllowedValues")
+	}
+}
+
+func TestValidateCloudDataLookupFailure(t *testing.T) {
+	server := newCloudDataServer(t)
+	server.fail("/v1/regions/us-south/zones")
+	sess := server.session(t)
+	cases := []struct {
+		cloudDataType  string
+		cloudDataRange []string
+	}{
+		{CloudDataZone, nil},
+		{CloudDataResourcePlan, nil},
+		{CloudDataResourcePlan, []string{"service:unknown"}},
+	}
+	for _, c := range cases {
+		if err := validateTestCloudData(sess, c.cloudDataType, c.cloudDataRange, "anything"); err != nil {
+			t.Errorf("Expected the %s %v validation to be skipped on a lookup failure, got %s", c.cloudDataType, c.cloudDataRange, err)
+		}
+	}
+	if n := server.requestCount("/v1/regions/us-south/zones"); n == 0 {
+		t.Error("Expected the zones to be looked up")
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RuleType is an enum of the cross-field rules supported by this tool. The rules are evaluated at
+// plan time by the CustomizeDiff function returned by InvokeRules.
+type RuleType int
+
+const (
+	// ConflictsWith rejects the attribute when any of the Attributes is set as well.
+	ConflictsWith RuleType = iota
+	// RequiredWith requires all of the Attributes when the attribute is set.
+	RequiredWith
+	// RequiredWhen requires the attribute when all of the When conditions hold.
+	RequiredWhen
+	// ForbiddenWhen rejects the attribute when all of the When conditions hold.
+	ForbiddenWhen
+	// AllowedValuesWhen restricts the attribute to AllowedValues when all of the When conditions hold.
+	AllowedValuesWhen
+	// IntBetweenWhen restricts the attribute to [MinValue, MaxValue] when all of the When conditions hold.
+	IntBetweenWhen
+	// ForceNewWhenChanged replaces the resource when the attribute is updated from a value matching
+	// AllowedValues or Regexp to one that does not, or the other way around.
+	ForceNewWhenChanged
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (rt RuleType) MarshalText() ([]byte, error) {
+	return []byte(rt.String()), nil
+}
+
+// Use stringer tool to generate this later.
+func (i RuleType) String() string {
+	return [...]string{"ConflictsWith", "RequiredWith", "RequiredWhen", "ForbiddenWhen", "AllowedValuesWhen", "IntBetweenWhen", "ForceNewWhenChanged"}[i]
+}
+
+// RuleCondition is a condition on the planned value of an attribute.
+type RuleCondition struct {
+	// This is the attribute name, or the path of a nested attribute.
+	// Ex: encryption_algorithm, volume_attachments.0.volume
+	Identifier string
+
+	// The condition holds when the attribute is one of AllowedValues (comma separated list), or an
+	// integer in [MinValue, MaxValue]. Without any of them, it holds when the attribute is set.
+	AllowedValues string
+	MinValue      string
+	MaxValue      string
+
+	// Not negates the condition.
+	Not bool
+}
+
+// ValidateRule is used to describe a rule between the attributes of a resource.
+type ValidateRule struct {
+	// Type of the rule.
+	// Ex: ConflictsWith, RequiredWhen, AllowedValuesWhen
+	Type RuleType
+
+	// This is the attribute constrained by the rule.
+	Identifier string
+
+	// The other attributes, for ConflictsWith and RequiredWith.
+	Attributes []string
+
+	// The conditions, all of which must hold, for the rules applying "when".
+	When []RuleCondition
+
+	AllowedValues string //Comma separated list of strings, for AllowedValuesWhen and ForceNewWhenChanged.
+	MinValue      string // For IntBetweenWhen
+	MaxValue      string // For IntBetweenWhen
+	Regexp        string // For ForceNewWhenChanged
+
+	// Message replaces the error message built from the rule.
+	Message string
+}
+
+// RuleDiff is the planned state the rules are evaluated against, satisfied by *schema.ResourceDiff.
+type RuleDiff interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+	NewValueKnown(key string) bool
+}
+
+// ruleChangeDiff is the change planned for an existing resource, satisfied by *schema.ResourceDiff.
+// The ForceNewWhenChanged rules only apply to it.
+type ruleChangeDiff interface {
+	Id() string
+	HasChange(key string) bool
+	GetChange(key string) (interface{}, interface{})
+	ForceNew(key string) error
+}
+
+// InvokeRules returns the CustomizeDiff function evaluating the rules of resourceName in the
+// validator dictionary, to be added to the CustomizeDiff of the resource.
+func InvokeRules(resourceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
+		if resourceItem == nil {
+			return nil
+		}
+		return ValidateRules(resourceName, resourceItem.Rules, diff)
+	}
+}
+
+// ValidateRules evaluates rules against diff, and returns the error of the first rule broken. A
+// rule depending on a value not known until apply is skipped.
+func ValidateRules(resourceName string, rules []ValidateRule, diff RuleDiff) error {
+	for _, rule := range rules {
+		if err := rule.validate(diff); err != nil {
+			if rule.Message != "" {
+				return fmt.Errorf("%s: %s", resourceName, rule.Message)
+			}
+			return fmt.Errorf("%s: %s", resourceName, err)
+		}
+	}
+	return nil
+}
+
+func (rule ValidateRule) validate(diff RuleDiff) error {
+	if !diff.NewValueKnown(rule.Identifier) {
+		return nil
+	}
+	for _, attribute := range rule.Attributes {
+		if !diff.NewValueKnown(attribute) {
+			return nil
+		}
+	}
+	for _, condition := range rule.When {
+		if !diff.NewValueKnown(condition.Identifier) {
+			return nil
+		}
+		if !condition.holds(diff) {
+			return nil
+		}
+	}
+
+	value, set := diff.GetOk(rule.Identifier)
+	switch rule.Type {
+	case ConflictsWith:
+		if !set {
+			return nil
+		}
+		for _, attribute := range rule.Attributes {
+			if _, ok := diff.GetOk(attribute); ok {
+				return fmt.Errorf("%q conflicts with %q", rule.Identifier, attribute)
+			}
+		}
+	case RequiredWith:
+		if !set {
+			return nil
+		}
+		for _, attribute := range rule.Attributes {
+			if _, ok := diff.GetOk(attribute); !ok {
+				return fmt.Errorf("%q is required with %q", attribute, rule.Identifier)
+			}
+		}
+	case RequiredWhen:
+		if !set {
+			return fmt.Errorf("%q is required when %s", rule.Identifier, conditionsString(rule.When))
+		}
+	case ForbiddenWhen:
+		if set {
+			return fmt.Errorf("%q is not allowed when %s", rule.Identifier, conditionsString(rule.When))
+		}
+	case AllowedValuesWhen:
+		allowedValues := splitValues(rule.AllowedValues)
+		if !stringInSlice(fmt.Sprint(diff.Get(rule.Identifier)), allowedValues) {
+			return fmt.Errorf("%q must be one of %v when %s", rule.Identifier, allowedValues, conditionsString(rule.When))
+		}
+	case IntBetweenWhen:
+		if !set {
+			value = 0
+		}
+		if !intBetween(value, rule.MinValue, rule.MaxValue) {
+			return fmt.Errorf("%q must be %s when %s, got %v", rule.Identifier, boundsString(rule.MinValue, rule.MaxValue), conditionsString(rule.When), value)
+		}
+	case ForceNewWhenChanged:
+		change, ok := diff.(ruleChangeDiff)
+		if !ok || change.Id() == "" || !change.HasChange(rule.Identifier) {
+			return nil
+		}
+		o, n := change.GetChange(rule.Identifier)
+		if rule.matches(o) != rule.matches(n) {
+			return change.ForceNew(rule.Identifier)
+		}
+	default:
+		return fmt.Errorf("unknown rule %s on %q", rule.Type, rule.Identifier)
+	}
+	return nil
+}
+
+// matches returns whether value is one of the AllowedValues or matches the Regexp of the rule.
+func (rule ValidateRule) matches(value interface{}) bool {
+	s := fmt.Sprint(value)
+	if rule.AllowedValues != "" && stringInSlice(s, splitValues(rule.AllowedValues)) {
+		return true
+	}
+	if rule.Regexp != "" {
+		matched, _ := regexp.MatchString(rule.Regexp, s)
+		return matched
+	}
+	return false
+}
+
+func (condition RuleCondition) holds(diff RuleDiff) bool {
+	var holds bool
+	switch {
+	case condition.AllowedValues != "":
+		holds = stringInSlice(fmt.Sprint(diff.Get(condition.Identifier)), splitValues(condition.AllowedValues))
+	case condition.MinValue != "" || condition.MaxValue != "":
+		holds = intBetween(diff.Get(condition.Identifier), condition.MinValue, condition.MaxValue)
+	default:
+		_, holds = diff.GetOk(condition.Identifier)
+	}
+	return holds != condition.Not
+}
+
+func (condition RuleCondition) String() string {
+	var s string
+	switch {
+	case condition.AllowedValues != "" && condition.Not:
+		s = fmt.Sprintf("%q is not one of %v", condition.Identifier, splitValues(condition.AllowedValues))
+	case condition.AllowedValues != "":
+		s = fmt.Sprintf("%q is one of %v", condition.Identifier, splitValues(condition.AllowedValues))
+	case condition.MinValue != "" || condition.MaxValue != "":
+		s = fmt.Sprintf("%q is %s", condition.Identifier, boundsString(condition.MinValue, condition.MaxValue))
+		if condition.Not {
+			s = fmt.Sprintf("%q is not %s", condition.Identifier, boundsString(condition.MinValue, condition.MaxValue))
+		}
+	case condition.Not:
+		s = fmt.Sprintf("%q is not set", condition.Identifier)
+	default:
+		s = fmt.Sprintf("%q is set", condition.Identifier)
+	}
+	return s
+}
+
+func conditionsString(conditions []RuleCondition) string {
+	s := make([]string, len(conditions))
+	for i, condition := range conditions {
+		s[i] = condition.String()
+	}
+	return strings.Join(s, " and ")
+}
+
+// boundsString describes the range [min, max], an empty bound being unbounded.
+func boundsString(min, max string) string {
+	switch {
+	case min == "":
+		return "at most " + max
+	case max == "":
+		return "at least " + min
+	}
+	return fmt.Sprintf("between %s and %s", min, max)
+}
+
+func splitValues(values string) []string {
+	arr := strings.Split(values, ",")
+	for i, ele := range arr {
+		arr[i] = strings.TrimSpace(ele)
+	}
+	return arr
+}
+
+// intBetween returns whether value is an integer in [min, max], an empty bound being unbounded.
+func intBetween(value interface{}, min, max string) bool {
+	i, ok := value.(int)
+	if !ok {
+		return false
+	}
+	if min != "" {
+		if m, err := strconv.Atoi(min); err != nil || i < m {
+			return false
+		}
+	}
+	if max != "" {
+		if m, err := strconv.Atoi(max); err != nil || i > m {
+			return false
+		}
+	}
+	return true
+}
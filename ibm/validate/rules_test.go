@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testRuleDiff is a planned state, where the attributes of unknown are not known until apply.
+type testRuleDiff struct {
+	values  map[string]interface{}
+	unknown map[string]bool
+}
+
+func (d testRuleDiff) Get(key string) interface{} {
+	return d.values[key]
+}
+
+func (d testRuleDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok && v != "" && v != 0 && v != false
+}
+
+func (d testRuleDiff) NewValueKnown(key string) bool {
+	return !d.unknown[key]
+}
+
+func TestValidateRules(t *testing.T) {
+	appCookie := []RuleCondition{{Identifier: "type", AllowedValues: "app_cookie"}}
+	cases := []struct {
+		name    string
+		rule    ValidateRule
+		values  map[string]interface{}
+		unknown map[string]bool
+		err     string
+	}{
+		{
+			name:   "conflicts",
+			rule:   ValidateRule{Type: ConflictsWith, Identifier: "a", Attributes: []string{"b", "c"}},
+			values: map[string]interface{}{"a": "x", "c": "y"},
+			err:    `"a" conflicts with "c"`,
+		},
+		{
+			name:   "no conflict",
+			rule:   ValidateRule{Type: ConflictsWith, Identifier: "a", Attributes: []string{"b", "c"}},
+			values: map[string]interface{}{"b": "x", "c": "y"},
+		},
+		{
+			name:   "required with",
+			rule:   ValidateRule{Type: RequiredWith, Identifier: "a", Attributes: []string{"b"}},
+			values: map[string]interface{}{"a": "x"},
+			err:    `"b" is required with "a"`,
+		},
+		{
+			name:   "required when",
+			rule:   ValidateRule{Type: RequiredWhen, Identifier: "name", When: appCookie},
+			values: map[string]interface{}{"type": "app_cookie"},
+			err:    `"name" is required when "type" is one of [app_cookie]`,
+		},
+		{
+			name:   "not required",
+			rule:   ValidateRule{Type: RequiredWhen, Identifier: "name", When: appCookie},
+			values: map[string]interface{}{"type": "source_ip"},
+		},
+		{
+			name:   "forbidden unless",
+			rule:   ValidateRule{Type: ForbiddenWhen, Identifier: "name", When: []RuleCondition{{Identifier: "type", AllowedValues: "app_cookie", Not: true}}},
+			values: map[string]interface{}{"type": "source_ip", "name": "cookie"},
+			err:    `"name" is not allowed when "type" is not one of [app_cookie]`,
+		},
+		{
+			name:   "allowed values",
+			rule:   ValidateRule{Type: AllowedValuesWhen, Identifier: "type", AllowedValues: "private", When: []RuleCondition{{Identifier: "route_mode", AllowedValues: "true"}}},
+			values: map[string]interface{}{"type": "public", "route_mode": true},
+			err:    `"type" must be one of [private] when "route_mode" is one of [true]`,
+		},
+		{
+			name:   "int between",
+			rule:   ValidateRule{Type: IntBetweenWhen, Identifier: "iops", MinValue: "100", MaxValue: "1000", When: []RuleCondition{{Identifier: "profile", AllowedValues: "custom"}, {Identifier: "capacity", MinValue: "10", MaxValue: "39"}}},
+			values: map[string]interface{}{"profile": "custom", "capacity": 20, "iops": 2000},
+			err:    `"iops" must be between 100 and 1000 when "profile" is one of [custom] and "capacity" is between 10 and 39, got 2000`,
+		},
+		{
+			name:   "int between outside of the conditions",
+			rule:   ValidateRule{Type: IntBetweenWhen, Identifier: "iops", MinValue: "100", MaxValue: "1000", When: []RuleCondition{{Identifier: "profile", AllowedValues: "custom"}, {Identifier: "capacity", MinValue: "10", MaxValue: "39"}}},
+			values: map[string]interface{}{"profile": "custom", "capacity": 50, "iops": 2000},
+		},
+		{
+			name:   "int at most",
+			rule:   ValidateRule{Type: IntBetweenWhen, Identifier: "capacity", MaxValue: "4800", When: []RuleCondition{{Identifier: "profile", AllowedValues: "10iops-tier"}}},
+			values: map[string]interface{}{"profile": "10iops-tier", "capacity": 5000},
+			err:    `"capacity" must be at most 4800 when "profile" is one of [10iops-tier], got 5000`,
+		},
+		{
+			name:   "int at least",
+			rule:   ValidateRule{Type: IntBetweenWhen, Identifier: "iops", MinValue: "100", When: []RuleCondition{{Identifier: "capacity", MinValue: "10"}}},
+			values: map[string]interface{}{"capacity": 20, "iops": 50},
+			err:    `"iops" must be at least 100 when "capacity" is at least 10, got 50`,
+		},
+		{
+			name:    "unknown",
+			rule:    ValidateRule{Type: RequiredWhen, Identifier: "name", When: appCookie},
+			values:  map[string]interface{}{},
+			unknown: map[string]bool{"type": true},
+		},
+		{
+			name:   "message",
+			rule:   ValidateRule{Type: RequiredWhen, Identifier: "name", When: appCookie, Message: "name is required for app_cookie"},
+			values: map[string]interface{}{"type": "app_cookie"},
+			err:    "name is required for app_cookie",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateRules("ibm_test", []ValidateRule{c.rule}, testRuleDiff{values: c.values, unknown: c.unknown})
+			switch {
+			case c.err == "" && err != nil:
+				t.Fatalf("Unexpected error: %s", err)
+			case c.err != "" && err == nil:
+				t.Fatalf("Expected error %q", c.err)
+			case c.err != "" && !strings.HasSuffix(err.Error(), c.err):
+				t.Fatalf("Expected error %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+// testChangeDiff is the change of an existing resource, from the old values.
+type testChangeDiff struct {
+	testRuleDiff
+	old      map[string]interface{}
+	forceNew []string
+}
+
+func (d *testChangeDiff) Id() string {
+	return "id"
+}
+
+func (d *testChangeDiff) HasChange(key string) bool {
+	return d.old[key] != d.values[key]
+}
+
+func (d *testChangeDiff) GetChange(key string) (interface{}, interface{}) {
+	return d.old[key], d.values[key]
+}
+
+func (d *testChangeDiff) ForceNew(key string) error {
+	d.forceNew = append(d.forceNew, key)
+	return nil
+}
+
+func TestValidateRulesForceNew(t *testing.T) {
+	rules := []ValidateRule{
+		{Type: ForceNewWhenChanged, Identifier: "profile", AllowedValues: "custom"},
+		{Type: ForceNewWhenChanged, Identifier: "instance_profile", Regexp: "d"},
+	}
+	cases := []struct {
+		old, new map[string]interface{}
+		forceNew []string
+	}{
+		{map[string]interface{}{"profile": "custom"}, map[string]interface{}{"profile": "10iops-tier"}, []string{"profile"}},
+		{map[string]interface{}{"profile": "5iops-tier"}, map[string]interface{}{"profile": "custom"}, []string{"profile"}},
+		{map[string]interface{}{"profile": "5iops-tier"}, map[string]interface{}{"profile": "10iops-tier"}, nil},
+		{map[string]interface{}{"instance_profile": "bx2-2x8"}, map[string]interface{}{"instance_profile": "bx2d-2x8"}, []string{"instance_profile"}},
+		{map[string]interface{}{"instance_profile": "bx2-2x8"}, map[string]interface{}{"instance_profile": "cx2-2x4"}, nil},
+	}
+	for _, c := range cases {
+		diff := &testChangeDiff{testRuleDiff: testRuleDiff{values: c.new}, old: c.old}
+		if err := ValidateRules("ibm_test", rules, diff); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(diff.forceNew, c.forceNew) {
+			t.Errorf("Expected %v to replace %v, got %v", c.new, c.forceNew, diff.forceNew)
+		}
+	}
+	// a new resource is not replaced
+	if err := ValidateRules("ibm_test", rules, testRuleDiff{values: map[string]interface{}{"profile": "custom"}}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
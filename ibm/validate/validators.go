@@ -19,6 +19,7 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
@@ -352,6 +353,20 @@ func ValidateURLPath(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateEndpointsFile checks the endpoints file at the given path, so that a malformed
+// file is reported by terraform validate rather than when the provider is configured.
+func ValidateEndpointsFile(v interface{}, k string) (ws []string, errors []error) {
+	path := v.(string)
+	if path == "" {
+		return
+	}
+	_, ws, errs := conns.LoadEndpointsFile(path)
+	for _, err := range errs {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
 func ValidateSecurityRuleDirection(v interface{}, k string) (ws []string, errors []error) {
 	validDirections := map[string]bool{
 		"ingress": true,
@@ -1158,7 +1173,7 @@ type ValidateSchema struct {
 	Required       bool
 	Default        interface{}
 	ForceNew       bool
-	CloudDataType  string
+	CloudDataType  string // Used by ValidateCloudData. Ex: resource_plan, with CloudDataRange service:internet-svcs
 	CloudDataRange []string
 }
 
@@ -1169,6 +1184,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of rules between the parameters, evaluated at plan time through InvokeRules.
+	Rules []ValidateRule
 }
 
 type ValidatorDict struct {
@@ -1280,7 +1298,12 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 	case ValidateOverlappingAddress:
 		return validateOverlappingAddress()
 	case ValidateCloudData:
-		return nil
+		// the cloud data is checked at plan time by InvokeCloudData, the AllowedValues are the
+		// check done without a provider session, such as by terraform validate
+		if schema.AllowedValues == "" {
+			return nil
+		}
+		return ValidateAllowedStringValues(schema.GetValue(AllowedValues).([]string))
 
 	default:
 		return nil
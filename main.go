@@ -2,7 +2,10 @@ package main
 
 import (
 	"log"
+	"os"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/discover"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -10,6 +13,18 @@ import (
 
 func main() {
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
+	if len(os.Args) > 1 && os.Args[1] == "discover" {
+		if err := discover.Main(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Main(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: provider.Provider,
 	})